@@ -3,20 +3,19 @@
 package tgbotapi
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"mime/multipart"
 	"net/http"
-	"net/url"
-	"os"
+	"path"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
-
-	"github.com/technoweenie/multipartstreamer"
 )
 
 type HttpClient interface {
@@ -26,13 +25,62 @@ type HttpClient interface {
 // BotAPI allows you to interact with the Telegram Bot API.
 type BotAPI struct {
 	Token  string `json:"token"`
+	Debug  bool   `json:"debug"`
 	Buffer int    `json:"buffer"`
 
-	Self            *User      `json:"-"`
-	Client          HttpClient `json:"-"`
-	shutdownChannel chan interface{}
-
-	apiEndpoint string
+	Self   *User      `json:"-"`
+	Client HttpClient `json:"-"`
+	// Logger receives errors and debug output of the bot.
+	// The logger set with SetLogger is used if it is nil.
+	Logger Logger `json:"-"`
+	// RetryPolicy controls how requests failed with transient errors
+	// are retried. Requests are not retried if it is nil.
+	RetryPolicy *RetryPolicy `json:"-"`
+	// AutoRetryFloodWait makes requests rejected with 429 Too Many Requests
+	// wait for the returned retry_after and repeat, as long as it doesn't
+	// exceed MaxFloodWait, up to MaxFloodWaitRetries times. These repeats
+	// don't count against RetryPolicy.
+	AutoRetryFloodWait bool `json:"-"`
+	// MaxFloodWait is the longest retry_after that is waited out.
+	//
+	// optional, DefaultMaxFloodWait is used if zero
+	MaxFloodWait time.Duration `json:"-"`
+	// MaxFloodWaitRetries is how many times a request is repeated
+	// after waiting out the flood control, before its error is returned.
+	//
+	// optional, DefaultMaxFloodWaitRetries is used if zero
+	MaxFloodWaitRetries int `json:"-"`
+	// Observer is notified about every API call and received update.
+	Observer RequestObserver `json:"-"`
+	// RateLimiter paces requests to stay within the flood limits.
+	// Requests are not paced if it is nil.
+	RateLimiter RateLimiter `json:"-"`
+	// FollowChatMigration makes requests rejected because the group was
+	// upgraded to a supergroup repeat with the chat_id of the supergroup.
+	// Uploads from a FileReader are not repeated.
+	FollowChatMigration bool `json:"-"`
+	// OnChatMigrated is called when a request is rejected because the
+	// group was upgraded to a supergroup, so the stored chat_id can be updated.
+	OnChatMigrated func(oldChatID, newChatID int64) `json:"-"`
+	// WebhookSecretToken is the secret token of the webhook. If it is set,
+	// HandleUpdate rejects requests without it. SetWebhook sets it
+	// to the SecretToken of the webhook.
+	WebhookSecretToken string `json:"-"`
+	// Codec decodes the responses of the API and the webhook updates.
+	//
+	// optional, encoding/json is used if nil
+	Codec Codec `json:"-"`
+
+	middleware []Middleware
+
+	shutdownOnce sync.Once
+	shutdownCtx  context.Context
+	shutdown     context.CancelFunc
+	pollers      sync.WaitGroup
+
+	apiEndpoint     string
+	localMode       bool
+	testEnvironment bool
 }
 
 // NewBotAPI creates a new BotAPI instance.
@@ -55,13 +103,25 @@ func NewBotAPIWithAPIEndpoint(token, apiEndpoint string) (*BotAPI, error) {
 //
 // It requires a token, provided by @BotFather on Telegram and API endpoint.
 func NewBotAPIWithClient(token, apiEndpoint string, client HttpClient) (*BotAPI, error) {
+	return newBotAPI(token, apiEndpoint, client, false)
+}
+
+// NewBotAPIInTestEnvironment creates a new BotAPI instance working
+// in Telegram's test environment, see UseTestEnvironment.
+//
+// It requires a token, provided by @BotFather of the test environment.
+func NewBotAPIInTestEnvironment(token string) (*BotAPI, error) {
+	return newBotAPI(token, APIEndpoint, &http.Client{}, true)
+}
+
+func newBotAPI(token, apiEndpoint string, client HttpClient, testEnvironment bool) (*BotAPI, error) {
 	bot := &BotAPI{
-		Token:           token,
-		Client:          client,
-		Buffer:          100,
-		shutdownChannel: make(chan interface{}),
+		Token:  token,
+		Client: client,
+		Buffer: 100,
 
-		apiEndpoint: apiEndpoint,
+		apiEndpoint:     apiEndpoint,
+		testEnvironment: testEnvironment,
 	}
 
 	self, err := bot.GetMe()
@@ -79,29 +139,135 @@ func (bot *BotAPI) SetAPIEndpoint(apiEndpoint string) {
 	bot.apiEndpoint = apiEndpoint
 }
 
+// UseTestEnvironment makes the bot call the methods in Telegram's test
+// environment, which has separate users, chats and bots. It allows
+// testing payments and logins without real money or accounts.
+//
+// Tokens of the test environment are rejected by the production one,
+// so such bots should be created with NewBotAPIInTestEnvironment.
+func (bot *BotAPI) UseTestEnvironment(enabled bool) {
+	bot.testEnvironment = enabled
+}
+
+// methodURL returns the URL to call an API method.
+func (bot *BotAPI) methodURL(method string) string {
+	if bot.testEnvironment {
+		method = "test/" + method
+	}
+
+	return fmt.Sprintf(bot.apiEndpoint, bot.Token, method)
+}
+
+// LocalMode tells the bot it works with a local Bot API server,
+// set with SetAPIEndpoint. Such a server allows uploads of up to
+// MaxLocalUploadSize and returns files as absolute paths on its disk,
+// so GetFileDirectURL returns file:// URLs.
+func (bot *BotAPI) LocalMode(enabled bool) {
+	bot.localMode = enabled
+}
+
+// maxUploadSize returns the largest file the API server accepts.
+func (bot *BotAPI) maxUploadSize() int64 {
+	if bot.localMode {
+		return MaxLocalUploadSize
+	}
+	return MaxUploadSize
+}
+
+// checkUploadSize returns an error if a file of size bytes is too large
+// to be uploaded.
+func (bot *BotAPI) checkUploadSize(size int64) error {
+	if limit := bot.maxUploadSize(); size > limit {
+		return fmt.Errorf("%s: %d bytes exceeds the limit of %d bytes", ErrFileTooLarge, size, limit)
+	}
+	return nil
+}
+
 // MakeRequest makes a request to a specific endpoint with our token.
 func (bot *BotAPI) MakeRequest(
 	endpoint string,
-	params url.Values,
+	params Params,
+	result interface{},
+) (*APIResponse, error) {
+	return bot.MakeRequestWithContext(context.Background(), endpoint, params, result)
+}
+
+// MakeRequestWithContext makes a request to a specific endpoint with our token.
+//
+// The request is aborted as soon as ctx is done.
+func (bot *BotAPI) MakeRequestWithContext(
+	ctx context.Context,
+	endpoint string,
+	params Params,
 	result interface{},
 ) (*APIResponse, error) {
-	method := fmt.Sprintf(bot.apiEndpoint, bot.Token, endpoint)
+	method := bot.methodURL(endpoint)
+	body := params.toValues().Encode()
+	ctx = withChatID(withAPIMethod(ctx, endpoint), params["chat_id"])
+
+	if bot.Debug {
+		bot.debug("Request", "method", endpoint, "params", params)
+	}
+
+	apiResp, err := bot.withRetry(ctx, func() (*APIResponse, error) {
+		if err := bot.waitRateLimit(ctx, params["chat_id"]); err != nil {
+			return nil, err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, "POST", method, strings.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
-	req, err := http.NewRequest("POST", method, strings.NewReader(params.Encode()))
+		return bot.doRequest(req)
+	})
 	if err != nil {
-		return nil, err
+		if chatID, ok := bot.migratedChatID(params["chat_id"], err); ok {
+			return bot.MakeRequestWithContext(ctx, endpoint, params.with("chat_id", chatID), result)
+		}
+		return apiResp, err
+	}
+
+	if result != nil {
+		err = bot.codec().Unmarshal(apiResp.Result, result)
 	}
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return apiResp, err
+}
+
+// doRequest sends a prepared request and checks the API response for errors.
+func (bot *BotAPI) doRequest(req *http.Request) (*APIResponse, error) {
+	start := time.Now()
+	apiResp, statusCode, err := bot.sendRequest(req)
+	bot.observeRequest(APIMethodFromContext(req.Context()), statusCode, start, err)
+
+	return apiResp, err
+}
 
-	resp, err := bot.Client.Do(req)
+// sendRequest sends a prepared request and decodes the API response.
+// It also returns the HTTP status code of the response, if there is one.
+func (bot *BotAPI) sendRequest(req *http.Request) (*APIResponse, int, error) {
+	resp, err := bot.requester().Do(req)
 	if err != nil {
-		return nil, err
+		if bot.Debug {
+			bot.debug("Request failed", "method", APIMethodFromContext(req.Context()), "error", err)
+		}
+		return nil, 0, err
 	}
 	defer resp.Body.Close()
 
 	var apiResp APIResponse
-	if err := bot.decodeAPIResponse(resp.Body, &apiResp); err != nil {
-		return &apiResp, err
+	data, err := bot.decodeAPIResponse(resp.Body, &apiResp)
+	if bot.Debug {
+		bot.debug("Response", "method", APIMethodFromContext(req.Context()),
+			"status", resp.StatusCode, "body", string(data))
+	}
+	if err != nil {
+		if resp.StatusCode >= http.StatusInternalServerError {
+			// Proxies in front of the API may answer with a non-JSON body.
+			return &apiResp, resp.StatusCode, Error{Code: resp.StatusCode, Message: resp.Status}
+		}
+		return &apiResp, resp.StatusCode, err
 	}
 
 	if !apiResp.Ok {
@@ -109,30 +275,84 @@ func (bot *BotAPI) MakeRequest(
 		if apiResp.Parameters != nil {
 			parameters = *apiResp.Parameters
 		}
-		return &apiResp, Error{
+		return &apiResp, resp.StatusCode, Error{
 			Code:               apiResp.ErrorCode,
 			Message:            apiResp.Description,
 			ResponseParameters: parameters,
 		}
 	}
 
-	if result != nil {
-		err = json.Unmarshal(apiResp.Result, result)
+	return &apiResp, resp.StatusCode, nil
+}
+
+// waitRateLimit blocks until the RateLimiter allows a request to the chat.
+func (bot *BotAPI) waitRateLimit(ctx context.Context, chatID string) error {
+	if bot.RateLimiter == nil {
+		return nil
+	}
+
+	return bot.RateLimiter.Wait(ctx, chatID)
+}
+
+// migratedChatID checks if a request to the chat failed with err because
+// the group was upgraded to a supergroup. It notifies OnChatMigrated and
+// returns the chat_id of the supergroup if the request should be repeated.
+func (bot *BotAPI) migratedChatID(chatID string, err error) (string, bool) {
+	apiErr, ok := AsError(err)
+	if !ok || apiErr.MigrateToChatID == 0 {
+		return "", false
+	}
+
+	oldChatID, parseErr := strconv.ParseInt(chatID, 10, 64)
+	if parseErr != nil || oldChatID == apiErr.MigrateToChatID {
+		return "", false
+	}
+
+	if bot.OnChatMigrated != nil {
+		bot.OnChatMigrated(oldChatID, apiErr.MigrateToChatID)
 	}
-	return &apiResp, err
+
+	return strconv.FormatInt(apiErr.MigrateToChatID, 10), bot.FollowChatMigration
 }
 
 // decodeAPIResponse decode response and return slice of bytes if debug enabled.
-// If debug disabled, just decode http.Response.Body stream to APIResponse struct
-// for efficient memory usage
-func (bot *BotAPI) decodeAPIResponse(responseBody io.Reader, resp *APIResponse) error {
-	return json.NewDecoder(responseBody).Decode(resp)
+// If debug disabled and no Codec is set, just decode http.Response.Body stream
+// to APIResponse struct for efficient memory usage
+func (bot *BotAPI) decodeAPIResponse(responseBody io.Reader, resp *APIResponse) ([]byte, error) {
+	if !bot.Debug && bot.Codec == nil {
+		return nil, json.NewDecoder(responseBody).Decode(resp)
+	}
+
+	data, err := ioutil.ReadAll(responseBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, bot.codec().Unmarshal(data, resp)
+}
+
+// debug logs a debug message with the bot token redacted from the values.
+func (bot *BotAPI) debug(msg string, keysAndValues ...interface{}) {
+	redacted := make([]interface{}, len(keysAndValues))
+	for i, v := range keysAndValues {
+		redacted[i] = bot.redactToken(fmt.Sprint(v))
+	}
+
+	bot.logger().Debug(msg, redacted...)
+}
+
+// redactToken hides the bot token in s.
+func (bot *BotAPI) redactToken(s string) string {
+	if bot.Token == "" {
+		return s
+	}
+	return strings.Replace(s, bot.Token, "<token>", -1)
 }
 
 // makeMessageRequest makes a request to a method that returns a Message.
-func (bot *BotAPI) makeMessageRequest(endpoint string, params url.Values) (*Message, error) {
+func (bot *BotAPI) makeMessageRequest(ctx context.Context, endpoint string, params Params) (*Message, error) {
 	var message Message
-	_, err := bot.MakeRequest(endpoint, params, &message)
+	_, err := bot.MakeRequestWithContext(ctx, endpoint, params, &message)
 	return &message, err
 }
 
@@ -140,121 +360,224 @@ func (bot *BotAPI) makeMessageRequest(endpoint string, params url.Values) (*Mess
 //
 // Requires the parameter to hold the file not be in the params.
 // File should be a string to a file path, a FileBytes struct,
-// a FileReader struct, or a url.URL.
+// a FileReader struct, a url.URL or any RequestFileData.
 //
-// Note that if your FileReader has a size set to -1, it will read
-// the file into memory to calculate a size.
+// The file is streamed to the API without reading it into memory,
+// even if your FileReader has a size set to -1.
+//
+// Uploads from a FileReader are never retried, as the reader
+// can only be consumed once.
 func (bot *BotAPI) UploadFile(
 	endpoint string,
-	params map[string]string,
+	params Params,
 	fieldname string,
 	file interface{},
 ) (*APIResponse, error) {
-	ms := multipartstreamer.New()
+	return bot.UploadFileWithContext(context.Background(), endpoint, params, fieldname, file)
+}
 
-	switch f := file.(type) {
-	case string:
-		if err := ms.WriteFields(params); err != nil {
-			return nil, err
-		}
+// UploadFileWithContext makes a request to the API with a file.
+//
+// It behaves like UploadFile, but the upload is aborted as soon as ctx is done.
+func (bot *BotAPI) UploadFileWithContext(
+	ctx context.Context,
+	endpoint string,
+	params Params,
+	fieldname string,
+	file interface{},
+) (*APIResponse, error) {
+	data, err := fileData(file)
+	if err != nil {
+		return nil, err
+	}
 
-		fileHandle, err := os.Open(f)
-		if err != nil {
-			return nil, err
-		}
-		defer fileHandle.Close()
+	return bot.UploadFilesWithContext(ctx, endpoint, params, []RequestFile{{Name: fieldname, Data: data}})
+}
 
-		fi, err := os.Stat(f)
-		if err != nil {
-			return nil, err
-		}
+// UploadFiles makes a request to the API with several files
+// in one multipart request.
+//
+// Files which need to be uploaded are sent in their own multipart
+// fields, the others are sent in the params under their names.
+// Files referenced from JSON params, such as the media of InputMediaPhoto,
+// are uploaded under the name given in its "attach://<name>" reference:
+//
+//	params["media"] = `[{"type":"photo","media":"attach://photo"}]`
+//	bot.UploadFiles("sendMediaGroup", params, []tgbotapi.RequestFile{{
+//		Name: "photo",
+//		Data: tgbotapi.FilePath("image.jpg"),
+//	}})
+//
+// Like UploadFile, requests uploading a FileReader are never retried.
+func (bot *BotAPI) UploadFiles(endpoint string, params Params, files []RequestFile) (*APIResponse, error) {
+	return bot.UploadFilesWithContext(context.Background(), endpoint, params, files)
+}
 
-		if err := ms.WriteReader(fieldname, fileHandle.Name(), fi.Size(), fileHandle); err != nil {
-			return nil, err
-		}
-	case FileBytes:
-		if err := ms.WriteFields(params); err != nil {
-			return nil, err
-		}
+// UploadFilesWithContext makes a request to the API with several files.
+//
+// It behaves like UploadFiles, but the upload is aborted as soon as ctx is done.
+func (bot *BotAPI) UploadFilesWithContext(
+	ctx context.Context,
+	endpoint string,
+	params Params,
+	files []RequestFile,
+) (*APIResponse, error) {
+	ctx = withChatID(withAPIMethod(ctx, endpoint), params["chat_id"])
+	upload := func() (*APIResponse, error) {
+		return bot.uploadFiles(ctx, endpoint, params, files)
+	}
 
-		buf := bytes.NewBuffer(f.Bytes)
-		if err := ms.WriteReader(fieldname, f.Name, int64(len(f.Bytes)), buf); err != nil {
-			return nil, err
-		}
-	case FileReader:
-		if err := ms.WriteFields(params); err != nil {
-			return nil, err
-		}
+	canRepeat := repeatable(files)
 
-		if f.Size != -1 {
-			if err := ms.WriteReader(fieldname, f.Name, f.Size, f.Reader); err != nil {
-				return nil, err
+	var (
+		resp *APIResponse
+		err  error
+	)
+	if canRepeat {
+		resp, err = bot.withRetry(ctx, upload)
+	} else {
+		resp, err = upload()
+	}
+
+	if chatID, ok := bot.migratedChatID(params["chat_id"], err); ok && canRepeat {
+		return bot.UploadFilesWithContext(ctx, endpoint, params.with("chat_id", chatID), files)
+	}
+
+	return resp, err
+}
+
+// uploadFiles makes a single multipart request to the API with files.
+func (bot *BotAPI) uploadFiles(
+	ctx context.Context,
+	endpoint string,
+	params Params,
+	files []RequestFile,
+) (*APIResponse, error) {
+	if err := bot.waitRateLimit(ctx, params["chat_id"]); err != nil {
+		return nil, err
+	}
+
+	fields := make(Params, len(params))
+	for key, value := range params {
+		fields[key] = value
+	}
+
+	var uploads []upload
+	defer func() {
+		for _, u := range uploads {
+			if closer, ok := u.reader.(io.Closer); ok {
+				closer.Close()
 			}
+		}
+	}()
 
-			break
+	for _, file := range files {
+		if file.Data == nil {
+			return nil, errors.New(ErrBadFileType)
 		}
 
-		data, err := ioutil.ReadAll(f.Reader)
-		if err != nil {
-			return nil, err
+		if !file.Data.NeedsUpload() {
+			fields[file.Name] = file.Data.SendData()
+			continue
 		}
 
-		buf := bytes.NewBuffer(data)
+		// The file is sent in its own multipart field.
+		delete(fields, file.Name)
 
-		if err := ms.WriteReader(fieldname, f.Name, int64(len(data)), buf); err != nil {
+		size, err := knownSize(file.Data)
+		if err != nil {
 			return nil, err
 		}
-	case url.URL:
-		params[fieldname] = f.String()
+		if size != -1 {
+			if err := bot.checkUploadSize(size); err != nil {
+				return nil, err
+			}
+		}
 
-		if err := ms.WriteFields(params); err != nil {
+		filename, reader, err := file.Data.UploadData()
+		if err != nil {
 			return nil, err
 		}
-	default:
-		return nil, errors.New(ErrBadFileType)
+		uploads = append(uploads, upload{fieldname: file.Name, filename: filename, reader: reader})
 	}
 
-	method := fmt.Sprintf(bot.apiEndpoint, bot.Token, endpoint)
-
-	req, err := http.NewRequest("POST", method, nil)
-	if err != nil {
-		return nil, err
+	if bot.Debug {
+		names := make([]string, len(uploads))
+		for i, u := range uploads {
+			names[i] = u.fieldname
+		}
+		bot.debug("Upload", "method", endpoint, "params", fields, "files", names)
 	}
 
-	ms.SetupRequest(req)
+	// The body is streamed, so the files are never held in memory.
+	body, pw := io.Pipe()
+	defer body.Close()
 
-	res, err := bot.Client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		pw.CloseWithError(bot.writeMultipart(mw, fields, uploads))
+	}()
 
-	bytes, err := ioutil.ReadAll(res.Body)
+	req, err := http.NewRequestWithContext(ctx, "POST", bot.methodURL(endpoint), body)
 	if err != nil {
 		return nil, err
 	}
+	req.Header.Set("Content-Type", mw.FormDataContentType())
 
-	var apiResp APIResponse
+	return bot.doRequest(req)
+}
 
-	err = json.Unmarshal(bytes, &apiResp)
-	if err != nil {
-		return nil, err
+// upload is a file to write in a multipart form.
+type upload struct {
+	fieldname string
+	filename  string
+	reader    io.Reader
+}
+
+// writeMultipart writes the params and the files as a multipart form.
+func (bot *BotAPI) writeMultipart(mw *multipart.Writer, params Params, uploads []upload) error {
+	for key, value := range params {
+		if err := mw.WriteField(key, value); err != nil {
+			return err
+		}
 	}
 
-	if !apiResp.Ok {
-		parameters := ResponseParameters{}
-		if apiResp.Parameters != nil {
-			parameters = *apiResp.Parameters
+	for _, u := range uploads {
+		part, err := mw.CreateFormFile(u.fieldname, u.filename)
+		if err != nil {
+			return err
+		}
+
+		// The size of the file may be unknown,
+		// so it is also checked as the file is read.
+		if _, err := io.Copy(part, &uploadLimitReader{bot: bot, r: u.reader}); err != nil {
+			return err
 		}
-		return &apiResp, Error{Code: apiResp.ErrorCode, Message: apiResp.Description, ResponseParameters: parameters}
 	}
 
-	return &apiResp, nil
+	return mw.Close()
+}
+
+// uploadLimitReader fails once more bytes are read than can be uploaded.
+type uploadLimitReader struct {
+	bot  *BotAPI
+	r    io.Reader
+	read int64
+}
+
+func (r *uploadLimitReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.read += int64(n)
+	if sizeErr := r.bot.checkUploadSize(r.read); sizeErr != nil {
+		return n, sizeErr
+	}
+	return n, err
 }
 
 // GetFileDirectURL returns direct URL to file
 //
-// It requires the FileID.
+// It requires the FileID. In LocalMode, it is a file:// URL
+// of the file on the disk of the local Bot API server.
 func (bot *BotAPI) GetFileDirectURL(fileID string) (string, error) {
 	file, err := bot.GetFile(FileConfig{fileID})
 
@@ -262,7 +585,10 @@ func (bot *BotAPI) GetFileDirectURL(fileID string) (string, error) {
 		return "", err
 	}
 
-	return file.Link(bot.Token), nil
+	if path.IsAbs(file.FilePath) {
+		return file.Link(bot.Token), nil
+	}
+	return bot.fileURL(file.FilePath), nil
 }
 
 // GetMe fetches the currently authenticated bot.
@@ -271,11 +597,31 @@ func (bot *BotAPI) GetFileDirectURL(fileID string) (string, error) {
 // and so you may get this data from BotAPI.Self without the need for
 // another request.
 func (bot *BotAPI) GetMe() (*User, error) {
+	return bot.GetMeWithContext(context.Background())
+}
+
+// GetMeWithContext fetches the currently authenticated bot.
+func (bot *BotAPI) GetMeWithContext(ctx context.Context) (*User, error) {
 	var user User
-	_, err := bot.MakeRequest("getMe", nil, &user)
+	_, err := bot.MakeRequestWithContext(ctx, "getMe", nil, &user)
 	return &user, err
 }
 
+// LogOut logs the bot out of the cloud Bot API server, which must be done
+// before running it on a local Bot API server. The bot can't log in to
+// the cloud server again for 10 minutes.
+func (bot *BotAPI) LogOut() (*APIResponse, error) {
+	return bot.MakeRequest("logOut", nil, nil)
+}
+
+// Close closes the bot instance on the Bot API server, which must be done
+// before moving it from a local server to another. Any webhook must be
+// deleted first, so the bot isn't launched again after the restart.
+// The method fails for the first 10 minutes after the bot is launched.
+func (bot *BotAPI) Close() (*APIResponse, error) {
+	return bot.MakeRequest("close", nil, nil)
+}
+
 // IsMessageToMe returns true if message directed to this bot.
 //
 // It requires the Message.
@@ -287,45 +633,59 @@ func (bot *BotAPI) IsMessageToMe(message *Message) bool {
 //
 // It requires the Chattable to send.
 func (bot *BotAPI) Send(c Chattable) (*Message, error) {
-	fielable, ok := c.(Fileable)
-	if !ok {
-		return bot.sendChattable(c)
-	}
-	return bot.sendFile(fielable)
+	return bot.SendWithContext(context.Background(), c)
 }
 
-// sendExisting will send a Message with an existing file to Telegram.
-func (bot *BotAPI) sendExisting(method string, config Fileable) (*Message, error) {
-	v, err := config.values()
-
-	if err != nil {
-		return nil, err
+// SendWithContext will send a Chattable item to Telegram.
+//
+// It requires the Chattable to send. The request is aborted
+// as soon as ctx is done.
+//
+// Only the first message of a media group is returned,
+// SendMediaGroup returns all of them.
+func (bot *BotAPI) SendWithContext(ctx context.Context, c Chattable) (*Message, error) {
+	switch config := c.(type) {
+	case MediaGroupConfig:
+		return bot.sendMediaGroup(ctx, config)
+	case *MediaGroupConfig:
+		return bot.sendMediaGroup(ctx, *config)
+	case MultiFileable:
+		return bot.sendFiles(ctx, config)
+	case Fileable:
+		return bot.sendFile(ctx, config)
+	default:
+		return bot.sendChattable(ctx, c)
 	}
+}
 
-	message, err := bot.makeMessageRequest(method, v)
+// sendExisting will send a Message with an existing file to Telegram.
+func (bot *BotAPI) sendExisting(ctx context.Context, method string, config Fileable) (*Message, error) {
+	params, err := config.params()
 	if err != nil {
 		return nil, err
 	}
 
-	return message, nil
+	return bot.makeMessageRequest(ctx, method, params)
 }
 
 // uploadAndSend will send a Message with a new file to Telegram.
-func (bot *BotAPI) uploadAndSend(method string, config Fileable) (*Message, error) {
+func (bot *BotAPI) uploadAndSend(ctx context.Context, method string, config Fileable) (*Message, error) {
 	params, err := config.params()
 	if err != nil {
 		return nil, err
 	}
+	// The file is sent in its own multipart field.
+	delete(params, config.name())
 
 	file := config.getFile()
 
-	resp, err := bot.UploadFile(method, params, config.name(), file)
+	resp, err := bot.UploadFileWithContext(ctx, method, params, config.name(), file)
 	if err != nil {
 		return nil, err
 	}
 
 	var message Message
-	if err := json.Unmarshal(resp.Result, &message); err != nil {
+	if err := bot.codec().Unmarshal(resp.Result, &message); err != nil {
 		return nil, err
 	}
 
@@ -334,594 +694,1714 @@ func (bot *BotAPI) uploadAndSend(method string, config Fileable) (*Message, erro
 
 // sendFile determines if the file is using an existing file or uploading
 // a new file, then sends it as needed.
-func (bot *BotAPI) sendFile(config Fileable) (*Message, error) {
+func (bot *BotAPI) sendFile(ctx context.Context, config Fileable) (*Message, error) {
 	if config.useExistingFile() {
-		return bot.sendExisting(config.method(), config)
+		return bot.sendExisting(ctx, config.method(), config)
 	}
 
-	return bot.uploadAndSend(config.method(), config)
+	return bot.uploadAndSend(ctx, config.method(), config)
 }
 
-// sendChattable sends a Chattable.
-func (bot *BotAPI) sendChattable(config Chattable) (*Message, error) {
-	v, err := config.values()
-	if err != nil {
+// sendFiles sends a Message with several files in one request.
+func (bot *BotAPI) sendFiles(ctx context.Context, config MultiFileable) (*Message, error) {
+	var message Message
+	if _, err := bot.requestFiles(ctx, config, &message); err != nil {
 		return nil, err
 	}
 
-	message, err := bot.makeMessageRequest(config.method(), v)
+	return &message, nil
+}
 
+// sendMediaGroup sends a media group and returns its first message.
+func (bot *BotAPI) sendMediaGroup(ctx context.Context, config MediaGroupConfig) (*Message, error) {
+	messages, err := bot.SendMediaGroupWithContext(ctx, config)
 	if err != nil {
 		return nil, err
 	}
+	if len(messages) == 0 {
+		return &Message{}, nil
+	}
 
-	return message, nil
+	return &messages[0], nil
 }
 
-// GetUserProfilePhotos gets a user's profile photos.
-//
-// It requires UserID.
-// Offset and Limit are optional.
-func (bot *BotAPI) GetUserProfilePhotos(config UserProfilePhotosConfig) (*UserProfilePhotos, error) {
-	v := make(url.Values)
-	v.Add("user_id", strconv.Itoa(config.UserID))
-	if config.Offset != 0 {
-		v.Add("offset", strconv.Itoa(config.Offset))
+// requestFiles makes a request with the files of config, uploading them
+// in one multipart request if any has to be uploaded, and decodes its
+// result into result if it is not nil.
+func (bot *BotAPI) requestFiles(ctx context.Context, config MultiFileable, result interface{}) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := config.files()
+	if err != nil {
+		return nil, err
+	}
+
+	if !needsUpload(files) {
+		for _, file := range files {
+			params.AddNonEmpty(file.Name, file.Data.SendData())
+		}
+		return bot.MakeRequestWithContext(ctx, config.method(), params, result)
 	}
-	if config.Limit != 0 {
-		v.Add("limit", strconv.Itoa(config.Limit))
+
+	resp, err := bot.UploadFilesWithContext(ctx, config.method(), params, files)
+	if err != nil || result == nil {
+		return resp, err
 	}
 
-	var profilePhotos UserProfilePhotos
-	_, err := bot.MakeRequest("getUserProfilePhotos", v, &profilePhotos)
-	return &profilePhotos, err
+	return resp, bot.codec().Unmarshal(resp.Result, result)
 }
 
-// GetFile returns a File which can download a file from Telegram.
+// SendMediaGroup sends a group of photos, videos, audios or documents
+// as an album and returns the sent messages.
 //
-// Requires FileID.
-func (bot *BotAPI) GetFile(config FileConfig) (*File, error) {
-	v := url.Values{}
-	v.Add("file_id", config.FileID)
-
-	var file File
-	_, err := bot.MakeRequest("getFile", v, &file)
-	return &file, err
+// Send only returns the first message of the group.
+func (bot *BotAPI) SendMediaGroup(config MediaGroupConfig) ([]Message, error) {
+	return bot.SendMediaGroupWithContext(context.Background(), config)
 }
 
-// GetUpdates fetches updates.
-// If a WebHook is set, this will not return any data!
+// SendMediaGroupWithContext sends a group of media as an album.
 //
-// Offset, Limit, and Timeout are optional.
-// To avoid stale items, set Offset to one higher than the previous item.
-// Set Timeout to a large number to reduce requests so you can get updates
-// instantly instead of having to wait between requests.
-func (bot *BotAPI) GetUpdates(config UpdateConfig) ([]Update, error) {
-	v := url.Values{}
-	if config.Offset != 0 {
-		v.Add("offset", strconv.Itoa(config.Offset))
-	}
-	if config.Limit > 0 {
-		v.Add("limit", strconv.Itoa(config.Limit))
-	}
-	if config.Timeout > 0 {
-		v.Add("timeout", strconv.Itoa(config.Timeout))
+// It behaves like SendMediaGroup, but the request is aborted
+// as soon as ctx is done.
+func (bot *BotAPI) SendMediaGroupWithContext(ctx context.Context, config MediaGroupConfig) ([]Message, error) {
+	var messages []Message
+	if _, err := bot.requestFiles(ctx, config, &messages); err != nil {
+		return nil, err
 	}
 
-	var updates []Update
-	_, err := bot.MakeRequest("getUpdates", v, &updates)
-	return updates, err
+	return messages, nil
 }
 
-// RemoveWebhook unsets the webhook.
-func (bot *BotAPI) RemoveWebhook() (*APIResponse, error) {
-	return bot.MakeRequest("deleteWebhook", url.Values{}, nil)
-}
+// ForwardMessages forwards several messages at once, and returns the IDs
+// of the forwarded messages. Messages which can't be forwarded are skipped.
+func (bot *BotAPI) ForwardMessages(config ForwardMessagesConfig) ([]MessageID, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
 
-// SetWebhook sets a webhook.
-//
-// If this is set, GetUpdates will not get any data!
-//
-// If you do not have a legitimate TLS certificate, you need to include
-// your self signed certificate with the config.
-func (bot *BotAPI) SetWebhook(config WebhookConfig) (*APIResponse, error) {
-	if config.Certificate == nil {
-		v := url.Values{}
-		v.Add("url", config.URL.String())
-		if config.MaxConnections != 0 {
-			v.Add("max_connections", strconv.Itoa(config.MaxConnections))
-		}
+	var messageIDs []MessageID
+	_, err = bot.MakeRequest(config.method(), params, &messageIDs)
+	return messageIDs, err
+}
 
-		return bot.MakeRequest("setWebhook", v, nil)
+// CopyMessage copies a message without a link to the original one,
+// and returns the ID of the copy.
+func (bot *BotAPI) CopyMessage(config CopyMessageConfig) (MessageID, error) {
+	params, err := config.params()
+	if err != nil {
+		return MessageID{}, err
 	}
 
-	params := make(map[string]string)
-	params["url"] = config.URL.String()
-	if config.MaxConnections != 0 {
-		params["max_connections"] = strconv.Itoa(config.MaxConnections)
-	}
+	var messageID MessageID
+	_, err = bot.MakeRequest(config.method(), params, &messageID)
+	return messageID, err
+}
 
-	resp, err := bot.UploadFile("setWebhook", params, "certificate", config.Certificate)
+// CopyMessages copies several messages without links to the original
+// ones, and returns the IDs of the copies. Messages which can't be
+// copied are skipped.
+func (bot *BotAPI) CopyMessages(config CopyMessagesConfig) ([]MessageID, error) {
+	params, err := config.params()
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	var messageIDs []MessageID
+	_, err = bot.MakeRequest(config.method(), params, &messageIDs)
+	return messageIDs, err
 }
 
-// GetWebhookInfo allows you to fetch information about a webhook and if
-// one currently is set, along with pending update count and error messages.
-func (bot *BotAPI) GetWebhookInfo() (*WebhookInfo, error) {
-	var info WebhookInfo
-	_, err := bot.MakeRequest("getWebhookInfo", nil, &info)
-	return &info, err
+// Edit edits a message with one of the EditMessage configs, and returns the
+// edited message for messages of a chat. Send can't be used to edit inline
+// messages, for which only true is returned.
+func (bot *BotAPI) Edit(c Chattable) (EditResult, error) {
+	return bot.EditWithContext(context.Background(), c)
+}
+
+// EditWithContext edits a message like Edit. The request is aborted
+// as soon as ctx is done.
+func (bot *BotAPI) EditWithContext(ctx context.Context, c Chattable) (EditResult, error) {
+	var result EditResult
+
+	if config, ok := c.(MultiFileable); ok {
+		_, err := bot.requestFiles(ctx, config, &result)
+		return result, err
+	}
+
+	params, err := c.params()
+	if err != nil {
+		return result, err
+	}
+
+	_, err = bot.MakeRequestWithContext(ctx, c.method(), params, &result)
+	return result, err
+}
+
+// sendChattable sends a Chattable.
+func (bot *BotAPI) sendChattable(ctx context.Context, config Chattable) (*Message, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.makeMessageRequest(ctx, config.method(), params)
+}
+
+// GetUserProfilePhotos gets a user's profile photos.
+//
+// It requires UserID.
+// Offset and Limit are optional.
+func (bot *BotAPI) GetUserProfilePhotos(config UserProfilePhotosConfig) (*UserProfilePhotos, error) {
+	params := make(Params)
+	params.AddNonZero("user_id", config.UserID)
+	params.AddNonZero("offset", config.Offset)
+	params.AddNonZero("limit", config.Limit)
+
+	var profilePhotos UserProfilePhotos
+	_, err := bot.MakeRequest("getUserProfilePhotos", params, &profilePhotos)
+	return &profilePhotos, err
+}
+
+// GetFile returns a File which can download a file from Telegram.
+//
+// Requires FileID.
+func (bot *BotAPI) GetFile(config FileConfig) (*File, error) {
+	return bot.GetFileWithContext(context.Background(), config)
+}
+
+// GetFileWithContext returns a File which can download a file from Telegram.
+//
+// Requires FileID.
+func (bot *BotAPI) GetFileWithContext(ctx context.Context, config FileConfig) (*File, error) {
+	params := Params{"file_id": config.FileID}
+
+	var file File
+	_, err := bot.MakeRequestWithContext(ctx, "getFile", params, &file)
+	return &file, err
+}
+
+// GetUpdates fetches updates.
+// If a WebHook is set, this will not return any data!
+//
+// Offset, Limit, and Timeout are optional.
+// To avoid stale items, set Offset to one higher than the previous item.
+// Set Timeout to a large number to reduce requests so you can get updates
+// instantly instead of having to wait between requests.
+//
+// The request is given Timeout plus LongPollMargin to complete. If Client
+// is an *http.Client with a shorter Timeout, the long poll is shortened to
+// fit in it, so it isn't killed by the client.
+func (bot *BotAPI) GetUpdates(config UpdateConfig) ([]Update, error) {
+	return bot.GetUpdatesWithContext(context.Background(), config)
+}
+
+// GetUpdatesWithContext fetches updates.
+//
+// It behaves like GetUpdates, but the long poll is aborted
+// as soon as ctx is done.
+func (bot *BotAPI) GetUpdatesWithContext(ctx context.Context, config UpdateConfig) ([]Update, error) {
+	params := make(Params)
+	params.AddNonZero("offset", config.Offset)
+	if config.Limit > 0 {
+		params.AddNonZero("limit", config.Limit)
+	}
+	if err := params.AddNonEmptyStrings("allowed_updates", config.AllowedUpdates); err != nil {
+		return nil, err
+	}
+
+	if timeout := bot.pollTimeout(config.Timeout); timeout > 0 {
+		params.AddNonZero("timeout", timeout)
+
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(timeout)*time.Second+LongPollMargin)
+		defer cancel()
+	}
+
+	var updates []Update
+	_, err := bot.MakeRequestWithContext(ctx, "getUpdates", params, &updates)
+	return updates, err
+}
+
+// pollTimeout returns the long poll timeout in seconds, shortened to
+// complete within the timeout of the client.
+func (bot *BotAPI) pollTimeout(timeout int) int {
+	client, ok := bot.Client.(*http.Client)
+	if !ok || client.Timeout <= 0 {
+		return timeout
+	}
+
+	if limit := int((client.Timeout - LongPollMargin) / time.Second); timeout > limit {
+		if limit < 0 {
+			return 0
+		}
+		return limit
+	}
+
+	return timeout
+}
+
+// RemoveWebhook unsets the webhook.
+func (bot *BotAPI) RemoveWebhook() (*APIResponse, error) {
+	return bot.DeleteWebhook(DeleteWebhookConfig{})
+}
+
+// DeleteWebhook unsets the webhook, optionally dropping pending updates.
+func (bot *BotAPI) DeleteWebhook(config DeleteWebhookConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// SetWebhook sets a webhook.
+//
+// If this is set, GetUpdates will not get any data!
+//
+// If you do not have a legitimate TLS certificate, you need to include
+// your self signed certificate with the config.
+func (bot *BotAPI) SetWebhook(config WebhookConfig) (*APIResponse, error) {
+	params := make(Params)
+	params["url"] = config.URL.String()
+	params.AddNonZero("max_connections", config.MaxConnections)
+	params.AddBool("drop_pending_updates", config.DropPendingUpdates)
+	params.AddNonEmpty("ip_address", config.IPAddress)
+	params.AddNonEmpty("secret_token", config.SecretToken)
+	if err := params.AddNonEmptyStrings("allowed_updates", config.AllowedUpdates); err != nil {
+		return nil, err
+	}
+
+	var (
+		resp *APIResponse
+		err  error
+	)
+	if config.Certificate == nil {
+		resp, err = bot.MakeRequest("setWebhook", params, nil)
+	} else {
+		resp, err = bot.UploadFile("setWebhook", params, "certificate", config.Certificate)
+	}
+
+	if err == nil && config.SecretToken != "" {
+		bot.WebhookSecretToken = config.SecretToken
+	}
+
+	return resp, err
+}
+
+// GetWebhookInfo allows you to fetch information about a webhook and if
+// one currently is set, along with pending update count and error messages.
+func (bot *BotAPI) GetWebhookInfo() (*WebhookInfo, error) {
+	var info WebhookInfo
+	_, err := bot.MakeRequest("getWebhookInfo", nil, &info)
+	return &info, err
 }
 
 // GetUpdatesChan starts and returns a channel for getting updates.
+//
+// Updates are fetched until Shutdown or StopReceivingUpdates is called,
+// then the channel is closed after the already received updates.
 func (bot *BotAPI) GetUpdatesChan(config UpdateConfig) (UpdatesChannel, error) {
+	store := config.OffsetStore
+	if config.Committer != nil {
+		if config.Committer.Store == nil {
+			return nil, errors.New(ErrNoOffsetStore)
+		}
+		store = config.Committer.Store
+	}
+	if store != nil {
+		lastUpdateID, err := store.Get()
+		if err != nil {
+			return nil, err
+		}
+		if lastUpdateID >= config.Offset {
+			config.Offset = lastUpdateID + 1
+		}
+	}
+
+	ctx := bot.shutdownContext()
+	ch := make(chan Update, bot.Buffer)
+
+	bot.pollers.Add(1)
+	go func() {
+		defer bot.pollers.Done()
+		defer close(ch)
+
+		failures := 0
+		for ctx.Err() == nil {
+			updates, err := bot.GetUpdatesWithContext(ctx, config)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+
+				failures++
+				delay := config.pollingBackoff(failures, err)
+				if !bot.handlePollingError(config, err, failures, delay) {
+					return
+				}
+				if sleepContext(ctx, delay) != nil {
+					return
+				}
+
+				continue
+			}
+			failures = 0
+
+			for _, update := range updates {
+				if update.UpdateID < config.Offset {
+					continue
+				}
+
+				config.Offset = update.UpdateID + 1
+				bot.observeUpdate(update)
+
+				if config.Committer != nil {
+					config.Committer.receive(update.UpdateID)
+				}
+
+				select {
+				case ch <- update:
+				case <-ctx.Done():
+					return
+				}
+
+				if config.Committer == nil && config.OffsetStore != nil {
+					if err := config.OffsetStore.Set(update.UpdateID); err != nil {
+						bot.logger().Error("Failed to store update offset", "update_id", update.UpdateID, "error", err)
+					}
+				}
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// handlePollingError reports an error of GetUpdatesChan and
+// returns false if updates should stop being received.
+func (bot *BotAPI) handlePollingError(config UpdateConfig, err error, failures int, delay time.Duration) bool {
+	if config.OnError != nil {
+		return config.OnError(err, failures, delay)
+	}
+
+	bot.logger().Error("Failed to get updates", "error", err, "retry_in", delay)
+	return true
+}
+
+// StopReceivingUpdates stops the go routine which receives updates.
+//
+// It doesn't wait for the go routine to exit, see Shutdown.
+func (bot *BotAPI) StopReceivingUpdates() {
+	bot.shutdownContext()
+	bot.shutdown()
+}
+
+// Shutdown stops receiving updates and waits until the go routines
+// started by GetUpdatesChan exit, or ctx is done. Pending long polls
+// are aborted, and the update channels are closed after the updates
+// already in them, so the consumers can drain them.
+//
+// The bot can't receive updates with GetUpdatesChan after Shutdown.
+func (bot *BotAPI) Shutdown(ctx context.Context) error {
+	bot.StopReceivingUpdates()
+
+	done := make(chan struct{})
+	go func() {
+		bot.pollers.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// shutdownContext returns the context canceled when the bot is shut down.
+func (bot *BotAPI) shutdownContext() context.Context {
+	bot.shutdownOnce.Do(func() {
+		bot.shutdownCtx, bot.shutdown = context.WithCancel(context.Background())
+	})
+	return bot.shutdownCtx
+}
+
+// ListenForWebhook registers a http handler for a webhook
+// on http.DefaultServeMux.
+func (bot *BotAPI) ListenForWebhook(pattern string) UpdatesChannel {
+	handler, ch := bot.WebhookHandler()
+	http.Handle(pattern, handler)
+
+	return ch
+}
+
+// WebhookHandler returns a http handler for a webhook, which may be mounted
+// on any router, and the channel receiving the updates it handles.
+//
+// The handler answers Telegram only after the update is put into the
+// channel, so a full channel makes Telegram wait and retry later.
+func (bot *BotAPI) WebhookHandler() (http.Handler, UpdatesChannel) {
 	ch := make(chan Update, bot.Buffer)
 
-	go func() {
-		for {
-			select {
-			case <-bot.shutdownChannel:
-				close(ch)
-				return
-			default:
-			}
+	return bot.webhookHandler(ch), ch
+}
+
+// webhookHandler returns a http handler for a webhook sending updates to ch.
+func (bot *BotAPI) webhookHandler(ch chan<- Update) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		update, err := bot.HandleUpdate(r)
+		if err != nil {
+			writeWebhookError(w, err)
+			return
+		}
+
+		select {
+		case ch <- *update:
+		case <-r.Context().Done():
+		}
+	})
+}
+
+// HandleUpdate parses and returns update received via webhook
+//
+// If the bot has a WebhookSecretToken, requests without it are rejected.
+func (bot *BotAPI) HandleUpdate(r *http.Request) (*Update, error) {
+	if r.Method != http.MethodPost {
+		err := errors.New("wrong HTTP method required POST")
+		return nil, err
+	}
+
+	if err := bot.checkSecretToken(r); err != nil {
+		return nil, err
+	}
+
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var update Update
+	if err := bot.codec().Unmarshal(data, &update); err != nil {
+		return nil, err
+	}
+
+	bot.observeUpdate(update)
+
+	return &update, nil
+}
+
+// AnswerInlineQuery sends a response to an inline query.
+//
+// Note that you must respond to an inline query within 30 seconds.
+func (bot *BotAPI) AnswerInlineQuery(config InlineConfig) (*APIResponse, error) {
+	params := make(Params)
+
+	params["inline_query_id"] = config.InlineQueryID
+	params["cache_time"] = strconv.Itoa(config.CacheTime)
+	params.AddBool("is_personal", config.IsPersonal)
+	params.AddNonEmpty("next_offset", config.NextOffset)
+	if err := params.AddInterface("results", config.Results); err != nil {
+		return nil, err
+	}
+	params.AddNonEmpty("switch_pm_text", config.SwitchPMText)
+	params.AddNonEmpty("switch_pm_parameter", config.SwitchPMParameter)
+
+	return bot.MakeRequest("answerInlineQuery", params, nil)
+}
+
+// SavePreparedInlineMessage stores a message that can be sent by a user
+// of a Mini App, and returns the prepared message.
+func (bot *BotAPI) SavePreparedInlineMessage(config SavePreparedInlineMessageConfig) (PreparedInlineMessage, error) {
+	params, err := config.params()
+	if err != nil {
+		return PreparedInlineMessage{}, err
+	}
+
+	var message PreparedInlineMessage
+	_, err = bot.MakeRequest(config.method(), params, &message)
+	return message, err
+}
+
+// AnswerWebAppQuery sets the result of an interaction with a Web App,
+// sending a message on behalf of the user to the chat the query came from.
+func (bot *BotAPI) AnswerWebAppQuery(config AnswerWebAppQueryConfig) (SentWebAppMessage, error) {
+	params, err := config.params()
+	if err != nil {
+		return SentWebAppMessage{}, err
+	}
+
+	var message SentWebAppMessage
+	_, err = bot.MakeRequest(config.method(), params, &message)
+	return message, err
+}
+
+// AnswerCallbackQuery sends a response to an inline query callback.
+func (bot *BotAPI) AnswerCallbackQuery(config CallbackConfig) (*APIResponse, error) {
+	params := make(Params)
+
+	params["callback_query_id"] = config.CallbackQueryID
+	params.AddNonEmpty("text", config.Text)
+	params.AddBool("show_alert", config.ShowAlert)
+	params.AddNonEmpty("url", config.URL)
+	params.AddNonZero("cache_time", config.CacheTime)
+
+	return bot.MakeRequest("answerCallbackQuery", params, nil)
+}
+
+// KickChatMember kicks a user from a chat. Note that this only will work
+// in supergroups, and requires the bot to be an admin. Also note they
+// will be unable to rejoin until they are unbanned.
+func (bot *BotAPI) KickChatMember(config KickChatMemberConfig) (*APIResponse, error) {
+	params, err := config.ChatMemberConfig.params()
+	if err != nil {
+		return nil, err
+	}
+	params.AddNonZero64("until_date", config.UntilDate)
+
+	return bot.MakeRequest("kickChatMember", params, nil)
+}
+
+// BanChatSenderChat bans a channel chat in a supergroup or channel, so its
+// owner can't send messages on behalf of any of their channels.
+// The bot must be an administrator in the chat, allowed to restrict members.
+func (bot *BotAPI) BanChatSenderChat(config BanChatSenderChatConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// UnbanChatSenderChat unbans a previously banned channel chat
+// in a supergroup or channel.
+func (bot *BotAPI) UnbanChatSenderChat(config UnbanChatSenderChatConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// ApproveChatJoinRequest approves a chat join request. The bot must be an
+// administrator in the chat, allowed to invite users.
+func (bot *BotAPI) ApproveChatJoinRequest(config ApproveChatJoinRequestConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// DeclineChatJoinRequest declines a chat join request. The bot must be an
+// administrator in the chat, allowed to invite users.
+func (bot *BotAPI) DeclineChatJoinRequest(config DeclineChatJoinRequestConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// LeaveChat makes the bot leave the chat.
+func (bot *BotAPI) LeaveChat(config ChatConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest("leaveChat", params, nil)
+}
+
+// GetChat gets full information about a chat.
+func (bot *BotAPI) GetChat(config ChatConfig) (*ChatFullInfo, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	var chat ChatFullInfo
+	_, err = bot.MakeRequest("getChat", params, &chat)
+	return &chat, err
+}
+
+// GetChatAdministrators gets a list of administrators in the chat.
+//
+// If none have been appointed, only the creator will be returned.
+// Bots are not shown, even if they are an administrator.
+func (bot *BotAPI) GetChatAdministrators(config ChatConfig) ([]ChatMember, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	var members []ChatMember
+	_, err = bot.MakeRequest("getChatAdministrators", params, &members)
+	return members, err
+}
+
+// GetChatMembersCount gets the number of users in a chat.
+func (bot *BotAPI) GetChatMembersCount(config ChatConfig) (int, error) {
+	params, err := config.params()
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	_, err = bot.MakeRequest("getChatMembersCount", params, &count)
+	return count, err
+}
+
+// GetChatMember gets a specific chat member.
+func (bot *BotAPI) GetChatMember(config ChatConfigWithUser) (*ChatMember, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	var member ChatMember
+	_, err = bot.MakeRequest("getChatMember", params, &member)
+	return &member, err
+}
+
+// UnbanChatMember unbans a user from a chat. Note that this only will work
+// in supergroups and channels, and requires the bot to be an admin.
+//
+// A user who isn't banned is removed from the chat, use
+// UnbanChatMemberWithConfig with OnlyIfBanned to avoid it.
+func (bot *BotAPI) UnbanChatMember(config ChatMemberConfig) (*APIResponse, error) {
+	return bot.UnbanChatMemberWithConfig(UnbanChatMemberConfig{ChatMemberConfig: config})
+}
+
+// UnbanChatMemberWithConfig unbans a user from a chat with the options
+// of config.
+func (bot *BotAPI) UnbanChatMemberWithConfig(config UnbanChatMemberConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// RestrictChatMember to restrict a user in a supergroup. The bot must be an
+// administrator in the supergroup for this to work and must have the
+// appropriate admin rights. Pass True for all boolean parameters to lift
+// restrictions from a user. Returns True on success.
+func (bot *BotAPI) RestrictChatMember(config RestrictChatMemberConfig) (*APIResponse, error) {
+	params, err := config.ChatMemberConfig.params()
+	if err != nil {
+		return nil, err
+	}
+
+	params.AddBoolPtr("can_send_messages", config.CanSendMessages)
+	params.AddBoolPtr("can_send_media_messages", config.CanSendMediaMessages)
+	params.AddBoolPtr("can_send_other_messages", config.CanSendOtherMessages)
+	params.AddBoolPtr("can_add_web_page_previews", config.CanAddWebPagePreviews)
+	params.AddNonZero64("until_date", config.UntilDate)
+
+	return bot.MakeRequest("restrictChatMember", params, nil)
+}
+
+// PromoteChatMember add admin rights to user
+func (bot *BotAPI) PromoteChatMember(config PromoteChatMemberConfig) (*APIResponse, error) {
+	params, err := config.ChatMemberConfig.params()
+	if err != nil {
+		return nil, err
+	}
+
+	params.AddBoolPtr("can_change_info", config.CanChangeInfo)
+	params.AddBoolPtr("can_post_messages", config.CanPostMessages)
+	params.AddBoolPtr("can_edit_messages", config.CanEditMessages)
+	params.AddBoolPtr("can_delete_messages", config.CanDeleteMessages)
+	params.AddBoolPtr("can_invite_users", config.CanInviteUsers)
+	params.AddBoolPtr("can_restrict_members", config.CanRestrictMembers)
+	params.AddBoolPtr("can_pin_messages", config.CanPinMessages)
+	params.AddBoolPtr("can_promote_members", config.CanPromoteMembers)
+	params.AddBoolPtr("is_anonymous", config.IsAnonymous)
+	params.AddBoolPtr("can_manage_chat", config.CanManageChat)
+	params.AddBoolPtr("can_manage_video_chats", config.CanManageVideoChats)
+	params.AddBoolPtr("can_manage_topics", config.CanManageTopics)
+	params.AddBoolPtr("can_post_stories", config.CanPostStories)
+	params.AddBoolPtr("can_edit_stories", config.CanEditStories)
+	params.AddBoolPtr("can_delete_stories", config.CanDeleteStories)
+
+	return bot.MakeRequest("promoteChatMember", params, nil)
+}
+
+// SetGameScore sets the score of a user in a game. The message with the
+// game is edited to show the new score unless DisableEditMessage is set.
+func (bot *BotAPI) SetGameScore(config SetGameScoreConfig) (EditResult, error) {
+	return bot.Edit(config)
+}
+
+// GetGameHighScores allows you to get the high scores for a game.
+func (bot *BotAPI) GetGameHighScores(config GetGameHighScoresConfig) ([]GameHighScore, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	var highScores []GameHighScore
+	_, err = bot.MakeRequest(config.method(), params, &highScores)
+	return highScores, err
+}
+
+// AnswerShippingQuery allows you to reply to Update with shipping_query parameter.
+func (bot *BotAPI) AnswerShippingQuery(config ShippingConfig) (*APIResponse, error) {
+	params := make(Params)
+
+	params["shipping_query_id"] = config.ShippingQueryID
+	params["ok"] = strconv.FormatBool(config.OK)
+	if config.OK {
+		if err := params.AddInterface("shipping_options", config.ShippingOptions); err != nil {
+			return nil, err
+		}
+	} else {
+		params["error_message"] = config.ErrorMessage
+	}
+
+	return bot.MakeRequest("answerShippingQuery", params, nil)
+}
+
+// AnswerPreCheckoutQuery allows you to reply to Update with pre_checkout_query.
+func (bot *BotAPI) AnswerPreCheckoutQuery(config PreCheckoutConfig) (*APIResponse, error) {
+	params := make(Params)
+
+	params["pre_checkout_query_id"] = config.PreCheckoutQueryID
+	params["ok"] = strconv.FormatBool(config.OK)
+	if !config.OK {
+		params["error_message"] = config.ErrorMessage
+	}
+
+	return bot.MakeRequest("answerPreCheckoutQuery", params, nil)
+}
+
+// RefundStarPayment refunds a successful payment in Telegram Stars.
+func (bot *BotAPI) RefundStarPayment(config RefundStarPaymentConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// CreateInvoiceLink creates a link for an invoice, and returns it.
+func (bot *BotAPI) CreateInvoiceLink(config InvoiceLinkConfig) (string, error) {
+	params, err := config.params()
+	if err != nil {
+		return "", err
+	}
+
+	var link string
+	_, err = bot.MakeRequest(config.method(), params, &link)
+	return link, err
+}
+
+// EditUserStarSubscription cancels or re-enables the extension
+// of a subscription paid in Telegram Stars.
+func (bot *BotAPI) EditUserStarSubscription(config EditUserStarSubscriptionConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// GetStarTransactions gets a page of the Telegram Star transactions of the bot.
+func (bot *BotAPI) GetStarTransactions(config GetStarTransactionsConfig) (StarTransactions, error) {
+	params, err := config.params()
+	if err != nil {
+		return StarTransactions{}, err
+	}
+
+	var transactions StarTransactions
+	_, err = bot.MakeRequest(config.method(), params, &transactions)
+	return transactions, err
+}
+
+// GetMyStarBalance gets the amount of Telegram Stars owned by the bot.
+func (bot *BotAPI) GetMyStarBalance() (StarAmount, error) {
+	var balance StarAmount
+	_, err := bot.MakeRequest("getMyStarBalance", nil, &balance)
+	return balance, err
+}
+
+// GetAvailableGifts gets the gifts that can be sent by the bot.
+func (bot *BotAPI) GetAvailableGifts() (Gifts, error) {
+	var gifts Gifts
+	_, err := bot.MakeRequest("getAvailableGifts", nil, &gifts)
+	return gifts, err
+}
+
+// SendGift sends a gift to a user or a channel chat.
+func (bot *BotAPI) SendGift(config SendGiftConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// GiftPremiumSubscription gifts a Telegram Premium subscription to a user.
+func (bot *BotAPI) GiftPremiumSubscription(config GiftPremiumSubscriptionConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// DeleteMessage deletes a message in a chat
+func (bot *BotAPI) DeleteMessage(config DeleteMessageConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// DeleteMessages deletes up to 100 messages in a chat at once,
+// counting as a single request against the rate limits.
+func (bot *BotAPI) DeleteMessages(config DeleteMessagesConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// StopPoll stops a poll sent by the bot, and returns its final results.
+func (bot *BotAPI) StopPoll(config StopPollConfig) (Poll, error) {
+	params, err := config.params()
+	if err != nil {
+		return Poll{}, err
+	}
+
+	var poll Poll
+	_, err = bot.MakeRequest(config.method(), params, &poll)
+	return poll, err
+}
+
+// GetInviteLink get InviteLink for a chat
+func (bot *BotAPI) GetInviteLink(config ChatConfig) (string, error) {
+	params, err := config.params()
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := bot.MakeRequest("exportChatInviteLink", params, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var inviteLink string
+	err = bot.codec().Unmarshal(resp.Result, &inviteLink)
+
+	return inviteLink, err
+}
+
+// CreateChatInviteLink creates an additional invite link for a chat.
+// The bot must be an administrator in the chat, allowed to invite users.
+func (bot *BotAPI) CreateChatInviteLink(config CreateChatInviteLinkConfig) (ChatInviteLink, error) {
+	return bot.requestInviteLink(config)
+}
+
+// EditChatInviteLink edits a non-primary invite link created by the bot.
+func (bot *BotAPI) EditChatInviteLink(config EditChatInviteLinkConfig) (ChatInviteLink, error) {
+	return bot.requestInviteLink(config)
+}
+
+// CreateChatSubscriptionInviteLink creates a subscription invite link
+// for a channel chat. The bot must be an administrator in the chat,
+// allowed to invite users.
+func (bot *BotAPI) CreateChatSubscriptionInviteLink(config CreateChatSubscriptionInviteLinkConfig) (ChatInviteLink, error) {
+	return bot.requestInviteLink(config)
+}
+
+// EditChatSubscriptionInviteLink edits a subscription invite link
+// created by the bot.
+func (bot *BotAPI) EditChatSubscriptionInviteLink(config EditChatSubscriptionInviteLinkConfig) (ChatInviteLink, error) {
+	return bot.requestInviteLink(config)
+}
+
+// RevokeChatInviteLink revokes an invite link created by the bot,
+// and returns the revoked link.
+func (bot *BotAPI) RevokeChatInviteLink(config RevokeChatInviteLinkConfig) (ChatInviteLink, error) {
+	return bot.requestInviteLink(config)
+}
+
+// requestInviteLink makes a request to a method that returns a ChatInviteLink.
+func (bot *BotAPI) requestInviteLink(config Chattable) (ChatInviteLink, error) {
+	params, err := config.params()
+	if err != nil {
+		return ChatInviteLink{}, err
+	}
+
+	var link ChatInviteLink
+	_, err = bot.MakeRequest(config.method(), params, &link)
+	return link, err
+}
+
+// PinChatMessage pin message in supergroup
+func (bot *BotAPI) PinChatMessage(config PinChatMessageConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// UnpinChatMessage unpin message in supergroup
+func (bot *BotAPI) UnpinChatMessage(config UnpinChatMessageConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// UnpinAllChatMessages clears the list of pinned messages in a chat.
+func (bot *BotAPI) UnpinAllChatMessages(config UnpinAllChatMessagesConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// SetChatPermissions changes the default permissions of all the members
+// of a group or supergroup. The bot must be an administrator in the chat,
+// allowed to restrict members.
+func (bot *BotAPI) SetChatPermissions(config SetChatPermissionsConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// SetChatTitle change title of chat.
+func (bot *BotAPI) SetChatTitle(config SetChatTitleConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// SetChatDescription change description of chat.
+func (bot *BotAPI) SetChatDescription(config SetChatDescriptionConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// SetChatPhoto change photo of chat.
+func (bot *BotAPI) SetChatPhoto(config SetChatPhotoConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+	delete(params, config.name())
+
+	file := config.getFile()
+
+	return bot.UploadFile(config.method(), params, config.name(), file)
+}
+
+// DeleteChatPhoto delete photo of chat.
+func (bot *BotAPI) DeleteChatPhoto(config DeleteChatPhotoConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// CreateForumTopic creates a topic in a forum supergroup chat. The bot must
+// be an administrator in the chat, allowed to manage topics.
+func (bot *BotAPI) CreateForumTopic(config CreateForumTopicConfig) (ForumTopic, error) {
+	params, err := config.params()
+	if err != nil {
+		return ForumTopic{}, err
+	}
+
+	var topic ForumTopic
+	_, err = bot.MakeRequest(config.method(), params, &topic)
+	return topic, err
+}
+
+// EditForumTopic edits the name and icon of a forum topic.
+func (bot *BotAPI) EditForumTopic(config EditForumTopicConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// CloseForumTopic closes an open forum topic.
+func (bot *BotAPI) CloseForumTopic(config CloseForumTopicConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// ReopenForumTopic reopens a closed forum topic.
+func (bot *BotAPI) ReopenForumTopic(config ReopenForumTopicConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// DeleteForumTopic deletes a forum topic along with all its messages.
+func (bot *BotAPI) DeleteForumTopic(config DeleteForumTopicConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// UnpinAllForumTopicMessages clears the list of pinned messages in a forum topic.
+func (bot *BotAPI) UnpinAllForumTopicMessages(config UnpinAllForumTopicMessagesConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// EditGeneralForumTopic renames the General topic of a forum.
+func (bot *BotAPI) EditGeneralForumTopic(config EditGeneralForumTopicConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// CloseGeneralForumTopic closes the General topic of a forum.
+func (bot *BotAPI) CloseGeneralForumTopic(config CloseGeneralForumTopicConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// ReopenGeneralForumTopic reopens the General topic of a forum.
+func (bot *BotAPI) ReopenGeneralForumTopic(config ReopenGeneralForumTopicConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// HideGeneralForumTopic hides the General topic of a forum.
+func (bot *BotAPI) HideGeneralForumTopic(config HideGeneralForumTopicConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// UnhideGeneralForumTopic unhides the General topic of a forum.
+func (bot *BotAPI) UnhideGeneralForumTopic(config UnhideGeneralForumTopicConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// UnpinAllGeneralForumTopicMessages clears the list of pinned messages
+// in the General topic of a forum.
+func (bot *BotAPI) UnpinAllGeneralForumTopicMessages(config UnpinAllGeneralForumTopicMessagesConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// GetForumTopicIconStickers gets the custom emoji stickers
+// which can be used as a forum topic icon by any user.
+func (bot *BotAPI) GetForumTopicIconStickers() ([]Sticker, error) {
+	var stickers []Sticker
+	_, err := bot.MakeRequest("getForumTopicIconStickers", nil, &stickers)
+	return stickers, err
+}
+
+// SetMessageReaction changes the chosen reactions of the bot on a message.
+func (bot *BotAPI) SetMessageReaction(config SetMessageReactionConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// GetUserChatBoosts gets the list of boosts added to a chat by a user.
+// The bot must be an administrator in the chat.
+func (bot *BotAPI) GetUserChatBoosts(config GetUserChatBoostsConfig) (UserChatBoosts, error) {
+	params, err := config.params()
+	if err != nil {
+		return UserChatBoosts{}, err
+	}
+
+	var boosts UserChatBoosts
+	_, err = bot.MakeRequest(config.method(), params, &boosts)
+	return boosts, err
+}
 
-			updates, err := bot.GetUpdates(config)
-			if err != nil {
-				log.Println(err)
-				log.Println("Failed to get updates, retrying in 3 seconds...")
-				time.Sleep(time.Second * 3)
+// GetBusinessConnection gets information about the connection
+// of the bot with a business account.
+func (bot *BotAPI) GetBusinessConnection(config GetBusinessConnectionConfig) (BusinessConnection, error) {
+	params, err := config.params()
+	if err != nil {
+		return BusinessConnection{}, err
+	}
 
-				continue
-			}
+	var connection BusinessConnection
+	_, err = bot.MakeRequest(config.method(), params, &connection)
+	return connection, err
+}
 
-			for _, update := range updates {
-				if update.UpdateID >= config.Offset {
-					config.Offset = update.UpdateID + 1
-					ch <- update
-				}
-			}
-		}
-	}()
+// ReadBusinessMessage marks a message as read on behalf of a business account.
+func (bot *BotAPI) ReadBusinessMessage(config ReadBusinessMessageConfig) (*APIResponse, error) {
+	return bot.requestBusiness(config)
+}
 
-	return ch, nil
+// DeleteBusinessMessages deletes messages on behalf of a business account.
+func (bot *BotAPI) DeleteBusinessMessages(config DeleteBusinessMessagesConfig) (*APIResponse, error) {
+	return bot.requestBusiness(config)
 }
 
-// StopReceivingUpdates stops the go routine which receives updates
-func (bot *BotAPI) StopReceivingUpdates() {
-	close(bot.shutdownChannel)
+// SetBusinessAccountName changes the name of a managed business account.
+func (bot *BotAPI) SetBusinessAccountName(config SetBusinessAccountNameConfig) (*APIResponse, error) {
+	return bot.requestBusiness(config)
 }
 
-// ListenForWebhook registers a http handler for a webhook.
-func (bot *BotAPI) ListenForWebhook(pattern string) UpdatesChannel {
-	ch := make(chan Update, bot.Buffer)
+// SetBusinessAccountUsername changes the username of a managed business account.
+func (bot *BotAPI) SetBusinessAccountUsername(config SetBusinessAccountUsernameConfig) (*APIResponse, error) {
+	return bot.requestBusiness(config)
+}
 
-	http.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
-		update, err := bot.HandleUpdate(r)
-		if err != nil {
-			errMsg, _ := json.Marshal(map[string]string{"error": err.Error()})
-			w.WriteHeader(http.StatusBadRequest)
-			w.Header().Set("Content-Type", "application/json")
-			_, _ = w.Write(errMsg)
-			return
-		}
+// SetBusinessAccountBio changes the bio of a managed business account.
+func (bot *BotAPI) SetBusinessAccountBio(config SetBusinessAccountBioConfig) (*APIResponse, error) {
+	return bot.requestBusiness(config)
+}
 
-		ch <- *update
-	})
+// SetBusinessAccountProfilePhoto changes the profile photo
+// of a managed business account, uploading it if needed.
+func (bot *BotAPI) SetBusinessAccountProfilePhoto(config SetBusinessAccountProfilePhotoConfig) (*APIResponse, error) {
+	return bot.SetBusinessAccountProfilePhotoWithContext(context.Background(), config)
+}
 
-	return ch
+// SetBusinessAccountProfilePhotoWithContext changes the profile photo
+// of a managed business account, uploading it if needed.
+//
+// It behaves like SetBusinessAccountProfilePhoto, but the request
+// is aborted as soon as ctx is done.
+func (bot *BotAPI) SetBusinessAccountProfilePhotoWithContext(ctx context.Context, config SetBusinessAccountProfilePhotoConfig) (*APIResponse, error) {
+	return bot.requestFiles(ctx, config, nil)
 }
 
-// HandleUpdate parses and returns update received via webhook
-func (bot *BotAPI) HandleUpdate(r *http.Request) (*Update, error) {
-	if r.Method != http.MethodPost {
-		err := errors.New("wrong HTTP method required POST")
-		return nil, err
-	}
+// RemoveBusinessAccountProfilePhoto removes the current profile photo
+// of a managed business account.
+func (bot *BotAPI) RemoveBusinessAccountProfilePhoto(config RemoveBusinessAccountProfilePhotoConfig) (*APIResponse, error) {
+	return bot.requestBusiness(config)
+}
 
-	var update Update
-	err := json.NewDecoder(r.Body).Decode(&update)
+// GetBusinessAccountStarBalance gets the amount of stars owned
+// by a managed business account.
+func (bot *BotAPI) GetBusinessAccountStarBalance(config GetBusinessAccountStarBalanceConfig) (StarAmount, error) {
+	params, err := config.params()
 	if err != nil {
-		return nil, err
+		return StarAmount{}, err
 	}
 
-	return &update, nil
+	var balance StarAmount
+	_, err = bot.MakeRequest(config.method(), params, &balance)
+	return balance, err
 }
 
-// AnswerInlineQuery sends a response to an inline query.
-//
-// Note that you must respond to an inline query within 30 seconds.
-func (bot *BotAPI) AnswerInlineQuery(config InlineConfig) (*APIResponse, error) {
-	v := url.Values{}
+// TransferBusinessAccountStars transfers stars from a managed business
+// account to the bot.
+func (bot *BotAPI) TransferBusinessAccountStars(config TransferBusinessAccountStarsConfig) (*APIResponse, error) {
+	return bot.requestBusiness(config)
+}
+
+// SetBusinessAccountGiftSettings changes the privacy settings
+// of the incoming gifts of a managed business account.
+func (bot *BotAPI) SetBusinessAccountGiftSettings(config SetBusinessAccountGiftSettingsConfig) (*APIResponse, error) {
+	return bot.requestBusiness(config)
+}
 
-	v.Add("inline_query_id", config.InlineQueryID)
-	v.Add("cache_time", strconv.Itoa(config.CacheTime))
-	v.Add("is_personal", strconv.FormatBool(config.IsPersonal))
-	v.Add("next_offset", config.NextOffset)
-	data, err := json.Marshal(config.Results)
+// GetBusinessAccountGifts gets a page of the gifts received and owned
+// by a managed business account.
+func (bot *BotAPI) GetBusinessAccountGifts(config GetBusinessAccountGiftsConfig) (OwnedGifts, error) {
+	params, err := config.params()
 	if err != nil {
-		return nil, err
+		return OwnedGifts{}, err
 	}
-	v.Add("results", string(data))
-	v.Add("switch_pm_text", config.SwitchPMText)
-	v.Add("switch_pm_parameter", config.SwitchPMParameter)
 
-	return bot.MakeRequest("answerInlineQuery", v, nil)
+	var gifts OwnedGifts
+	_, err = bot.MakeRequest(config.method(), params, &gifts)
+	return gifts, err
 }
 
-// AnswerCallbackQuery sends a response to an inline query callback.
-func (bot *BotAPI) AnswerCallbackQuery(config CallbackConfig) (*APIResponse, error) {
-	v := url.Values{}
-
-	v.Add("callback_query_id", config.CallbackQueryID)
-	if config.Text != "" {
-		v.Add("text", config.Text)
-	}
-	v.Add("show_alert", strconv.FormatBool(config.ShowAlert))
-	if config.URL != "" {
-		v.Add("url", config.URL)
-	}
-	v.Add("cache_time", strconv.Itoa(config.CacheTime))
+// ConvertGiftToStars converts a regular gift of a managed business account
+// to stars.
+func (bot *BotAPI) ConvertGiftToStars(config ConvertGiftToStarsConfig) (*APIResponse, error) {
+	return bot.requestBusiness(config)
+}
 
-	return bot.MakeRequest("answerCallbackQuery", v, nil)
+// UpgradeGift upgrades a regular gift of a managed business account
+// to a unique gift.
+func (bot *BotAPI) UpgradeGift(config UpgradeGiftConfig) (*APIResponse, error) {
+	return bot.requestBusiness(config)
 }
 
-// KickChatMember kicks a user from a chat. Note that this only will work
-// in supergroups, and requires the bot to be an admin. Also note they
-// will be unable to rejoin until they are unbanned.
-func (bot *BotAPI) KickChatMember(config KickChatMemberConfig) (*APIResponse, error) {
-	v := url.Values{}
+// TransferGift transfers a unique gift of a managed business account
+// to another user.
+func (bot *BotAPI) TransferGift(config TransferGiftConfig) (*APIResponse, error) {
+	return bot.requestBusiness(config)
+}
 
-	if config.SuperGroupUsername == "" {
-		v.Add("chat_id", strconv.FormatInt(config.ChatID, 10))
-	} else {
-		v.Add("chat_id", config.SuperGroupUsername)
+// requestBusiness makes a request managing a business account,
+// which is answered with true.
+func (bot *BotAPI) requestBusiness(config Chattable) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
 	}
-	v.Add("user_id", strconv.Itoa(config.UserID))
 
-	if config.UntilDate != 0 {
-		v.Add("until_date", strconv.FormatInt(config.UntilDate, 10))
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// VerifyUser verifies a user on behalf of the organization
+// represented by the bot.
+func (bot *BotAPI) VerifyUser(config VerifyUserConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
 	}
 
-	return bot.MakeRequest("kickChatMember", v, nil)
+	return bot.MakeRequest(config.method(), params, nil)
 }
 
-// LeaveChat makes the bot leave the chat.
-func (bot *BotAPI) LeaveChat(config ChatConfig) (*APIResponse, error) {
-	v := url.Values{}
-
-	if config.SuperGroupUsername == "" {
-		v.Add("chat_id", strconv.FormatInt(config.ChatID, 10))
-	} else {
-		v.Add("chat_id", config.SuperGroupUsername)
+// VerifyChat verifies a chat on behalf of the organization
+// represented by the bot.
+func (bot *BotAPI) VerifyChat(config VerifyChatConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
 	}
 
-	return bot.MakeRequest("leaveChat", v, nil)
+	return bot.MakeRequest(config.method(), params, nil)
 }
 
-// GetChat gets information about a chat.
-func (bot *BotAPI) GetChat(config ChatConfig) (*Chat, error) {
-	v := url.Values{}
+// RemoveUserVerification removes the verification of a user
+// verified by the organization represented by the bot.
+func (bot *BotAPI) RemoveUserVerification(config RemoveUserVerificationConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
 
-	if config.SuperGroupUsername == "" {
-		v.Add("chat_id", strconv.FormatInt(config.ChatID, 10))
-	} else {
-		v.Add("chat_id", config.SuperGroupUsername)
+// RemoveChatVerification removes the verification of a chat
+// verified by the organization represented by the bot.
+func (bot *BotAPI) RemoveChatVerification(config RemoveChatVerificationConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
 	}
 
-	var chat Chat
-	_, err := bot.MakeRequest("getChat", v, &chat)
-	return &chat, err
+	return bot.MakeRequest(config.method(), params, nil)
 }
 
-// GetChatAdministrators gets a list of administrators in the chat.
-//
-// If none have been appointed, only the creator will be returned.
-// Bots are not shown, even if they are an administrator.
-func (bot *BotAPI) GetChatAdministrators(config ChatConfig) ([]ChatMember, error) {
-	v := url.Values{}
+// SetUserEmojiStatus changes the emoji status of a user
+// that allowed the bot to do so.
+func (bot *BotAPI) SetUserEmojiStatus(config SetUserEmojiStatusConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
+	}
 
-	if config.SuperGroupUsername == "" {
-		v.Add("chat_id", strconv.FormatInt(config.ChatID, 10))
-	} else {
-		v.Add("chat_id", config.SuperGroupUsername)
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// SetPassportDataErrors informs a user that some of the Telegram Passport
+// elements they provided contains errors.
+func (bot *BotAPI) SetPassportDataErrors(config SetPassportDataErrorsConfig) (*APIResponse, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
 	}
 
-	var members []ChatMember
-	_, err := bot.MakeRequest("getChatAdministrators", v, &members)
-	return members, err
+	return bot.MakeRequest(config.method(), params, nil)
 }
 
-// GetChatMembersCount gets the number of users in a chat.
-func (bot *BotAPI) GetChatMembersCount(config ChatConfig) (int, error) {
-	v := url.Values{}
+// UploadStickerFile uploads a sticker file, to add it to sticker sets later,
+// and returns the uploaded file.
+func (bot *BotAPI) UploadStickerFile(config UploadStickerFileConfig) (File, error) {
+	return bot.UploadStickerFileWithContext(context.Background(), config)
+}
 
-	if config.SuperGroupUsername == "" {
-		v.Add("chat_id", strconv.FormatInt(config.ChatID, 10))
-	} else {
-		v.Add("chat_id", config.SuperGroupUsername)
-	}
+// UploadStickerFileWithContext uploads a sticker file, to add it to sticker
+// sets later, and returns the uploaded file.
+//
+// It behaves like UploadStickerFile, but the request is aborted
+// as soon as ctx is done.
+func (bot *BotAPI) UploadStickerFileWithContext(ctx context.Context, config UploadStickerFileConfig) (File, error) {
+	var file File
+	_, err := bot.requestFiles(ctx, config, &file)
+	return file, err
+}
 
-	var count int
-	_, err := bot.MakeRequest("getChatMembersCount", v, &count)
-	return count, err
+// CreateNewStickerSet creates a new sticker set owned by a user.
+// The bot can then edit the set.
+func (bot *BotAPI) CreateNewStickerSet(config CreateNewStickerSetConfig) (*APIResponse, error) {
+	return bot.CreateNewStickerSetWithContext(context.Background(), config)
 }
 
-// GetChatMember gets a specific chat member.
-func (bot *BotAPI) GetChatMember(config ChatConfigWithUser) (*ChatMember, error) {
-	v := url.Values{}
+// CreateNewStickerSetWithContext creates a new sticker set owned by a user.
+//
+// It behaves like CreateNewStickerSet, but the request is aborted
+// as soon as ctx is done.
+func (bot *BotAPI) CreateNewStickerSetWithContext(ctx context.Context, config CreateNewStickerSetConfig) (*APIResponse, error) {
+	return bot.requestStickers(ctx, config)
+}
 
-	if config.SuperGroupUsername == "" {
-		v.Add("chat_id", strconv.FormatInt(config.ChatID, 10))
-	} else {
-		v.Add("chat_id", config.SuperGroupUsername)
-	}
-	v.Add("user_id", strconv.Itoa(config.UserID))
+// AddStickerToSet adds a sticker to a set created by the bot.
+func (bot *BotAPI) AddStickerToSet(config AddStickerToSetConfig) (*APIResponse, error) {
+	return bot.AddStickerToSetWithContext(context.Background(), config)
+}
 
-	var member ChatMember
-	_, err := bot.MakeRequest("getChatMember", v, &member)
-	return &member, err
+// AddStickerToSetWithContext adds a sticker to a set created by the bot.
+//
+// It behaves like AddStickerToSet, but the request is aborted
+// as soon as ctx is done.
+func (bot *BotAPI) AddStickerToSetWithContext(ctx context.Context, config AddStickerToSetConfig) (*APIResponse, error) {
+	return bot.requestStickers(ctx, config)
 }
 
-func chatIDFromChatMemberConfig(config *ChatMemberConfig) string {
-	switch {
-	case config.SuperGroupUsername != "":
-		return config.SuperGroupUsername
-	case config.ChannelUsername != "":
-		return config.ChannelUsername
-	default:
-		return strconv.FormatInt(config.ChatID, 10)
-	}
+// SetStickerPositionInSet moves a sticker in a set created by the bot.
+func (bot *BotAPI) SetStickerPositionInSet(config SetStickerPositionInSetConfig) (*APIResponse, error) {
+	return bot.requestStickers(context.Background(), config)
 }
 
-// UnbanChatMember unbans a user from a chat. Note that this only will work
-// in supergroups and channels, and requires the bot to be an admin.
-func (bot *BotAPI) UnbanChatMember(config ChatMemberConfig) (*APIResponse, error) {
-	v := url.Values{}
-	v.Add("chat_id", chatIDFromChatMemberConfig(&config))
-	v.Add("user_id", strconv.Itoa(config.UserID))
+// DeleteStickerFromSet deletes a sticker from a set created by the bot.
+func (bot *BotAPI) DeleteStickerFromSet(config DeleteStickerFromSetConfig) (*APIResponse, error) {
+	return bot.requestStickers(context.Background(), config)
+}
 
-	return bot.MakeRequest("unbanChatMember", v, nil)
+// ReplaceStickerInSet replaces a sticker in a set created by the bot.
+func (bot *BotAPI) ReplaceStickerInSet(config ReplaceStickerInSetConfig) (*APIResponse, error) {
+	return bot.ReplaceStickerInSetWithContext(context.Background(), config)
 }
 
-// RestrictChatMember to restrict a user in a supergroup. The bot must be an
-// administrator in the supergroup for this to work and must have the
-// appropriate admin rights. Pass True for all boolean parameters to lift
-// restrictions from a user. Returns True on success.
-func (bot *BotAPI) RestrictChatMember(config RestrictChatMemberConfig) (*APIResponse, error) {
-	v := url.Values{}
-	v.Add("chat_id", chatIDFromChatMemberConfig(&config.ChatMemberConfig))
-	v.Add("user_id", strconv.Itoa(config.UserID))
+// ReplaceStickerInSetWithContext replaces a sticker in a set created by the bot.
+//
+// It behaves like ReplaceStickerInSet, but the request is aborted
+// as soon as ctx is done.
+func (bot *BotAPI) ReplaceStickerInSetWithContext(ctx context.Context, config ReplaceStickerInSetConfig) (*APIResponse, error) {
+	return bot.requestStickers(ctx, config)
+}
 
-	if config.CanSendMessages != nil {
-		v.Add("can_send_messages", strconv.FormatBool(*config.CanSendMessages))
-	}
-	if config.CanSendMediaMessages != nil {
-		v.Add("can_send_media_messages", strconv.FormatBool(*config.CanSendMediaMessages))
-	}
-	if config.CanSendOtherMessages != nil {
-		v.Add("can_send_other_messages", strconv.FormatBool(*config.CanSendOtherMessages))
-	}
-	if config.CanAddWebPagePreviews != nil {
-		v.Add("can_add_web_page_previews", strconv.FormatBool(*config.CanAddWebPagePreviews))
-	}
-	if config.UntilDate != 0 {
-		v.Add("until_date", strconv.FormatInt(config.UntilDate, 10))
-	}
+// SetStickerEmojiList changes the emoji associated with a sticker
+// in a set created by the bot.
+func (bot *BotAPI) SetStickerEmojiList(config SetStickerEmojiListConfig) (*APIResponse, error) {
+	return bot.requestStickers(context.Background(), config)
+}
 
-	return bot.MakeRequest("restrictChatMember", v, nil)
+// SetStickerKeywords changes the search keywords of a sticker
+// in a set created by the bot.
+func (bot *BotAPI) SetStickerKeywords(config SetStickerKeywordsConfig) (*APIResponse, error) {
+	return bot.requestStickers(context.Background(), config)
 }
 
-// PromoteChatMember add admin rights to user
-func (bot *BotAPI) PromoteChatMember(config PromoteChatMemberConfig) (*APIResponse, error) {
-	v := url.Values{}
-	v.Add("chat_id", chatIDFromChatMemberConfig(&config.ChatMemberConfig))
-	v.Add("user_id", strconv.Itoa(config.UserID))
+// SetStickerMaskPosition changes the mask position of a mask sticker
+// in a set created by the bot.
+func (bot *BotAPI) SetStickerMaskPosition(config SetStickerMaskPositionConfig) (*APIResponse, error) {
+	return bot.requestStickers(context.Background(), config)
+}
 
-	if config.CanChangeInfo != nil {
-		v.Add("can_change_info", strconv.FormatBool(*config.CanChangeInfo))
-	}
-	if config.CanPostMessages != nil {
-		v.Add("can_post_messages", strconv.FormatBool(*config.CanPostMessages))
-	}
-	if config.CanEditMessages != nil {
-		v.Add("can_edit_messages", strconv.FormatBool(*config.CanEditMessages))
-	}
-	if config.CanDeleteMessages != nil {
-		v.Add("can_delete_messages", strconv.FormatBool(*config.CanDeleteMessages))
-	}
-	if config.CanInviteUsers != nil {
-		v.Add("can_invite_users", strconv.FormatBool(*config.CanInviteUsers))
-	}
-	if config.CanRestrictMembers != nil {
-		v.Add("can_restrict_members", strconv.FormatBool(*config.CanRestrictMembers))
-	}
-	if config.CanPinMessages != nil {
-		v.Add("can_pin_messages", strconv.FormatBool(*config.CanPinMessages))
-	}
-	if config.CanPromoteMembers != nil {
-		v.Add("can_promote_members", strconv.FormatBool(*config.CanPromoteMembers))
-	}
+// SetStickerSetTitle changes the title of a set created by the bot.
+func (bot *BotAPI) SetStickerSetTitle(config SetStickerSetTitleConfig) (*APIResponse, error) {
+	return bot.requestStickers(context.Background(), config)
+}
 
-	return bot.MakeRequest("promoteChatMember", v, nil)
+// SetStickerSetThumbnail changes the thumbnail of a set created by the bot.
+func (bot *BotAPI) SetStickerSetThumbnail(config SetStickerSetThumbnailConfig) (*APIResponse, error) {
+	return bot.SetStickerSetThumbnailWithContext(context.Background(), config)
 }
 
-// GetGameHighScores allows you to get the high scores for a game.
-func (bot *BotAPI) GetGameHighScores(config GetGameHighScoresConfig) ([]GameHighScore, error) {
-	v, _ := config.values()
+// SetStickerSetThumbnailWithContext changes the thumbnail of a set created by the bot.
+//
+// It behaves like SetStickerSetThumbnail, but the request is aborted
+// as soon as ctx is done.
+func (bot *BotAPI) SetStickerSetThumbnailWithContext(ctx context.Context, config SetStickerSetThumbnailConfig) (*APIResponse, error) {
+	return bot.requestStickers(ctx, config)
+}
 
-	var highScores []GameHighScore
-	_, err := bot.MakeRequest(config.method(), v, &highScores)
-	return highScores, err
+// DeleteStickerSet deletes a sticker set created by the bot.
+func (bot *BotAPI) DeleteStickerSet(config DeleteStickerSetConfig) (*APIResponse, error) {
+	return bot.requestStickers(context.Background(), config)
 }
 
-// AnswerShippingQuery allows you to reply to Update with shipping_query parameter.
-func (bot *BotAPI) AnswerShippingQuery(config ShippingConfig) (*APIResponse, error) {
-	v := url.Values{}
+// requestStickers makes a request managing sticker sets, uploading the files
+// of config if it has any, which is answered with true.
+func (bot *BotAPI) requestStickers(ctx context.Context, config Chattable) (*APIResponse, error) {
+	if config, ok := config.(MultiFileable); ok {
+		return bot.requestFiles(ctx, config, nil)
+	}
 
-	v.Add("shipping_query_id", config.ShippingQueryID)
-	v.Add("ok", strconv.FormatBool(config.OK))
-	if config.OK {
-		data, err := json.Marshal(config.ShippingOptions)
-		if err != nil {
-			return nil, err
-		}
-		v.Add("shipping_options", string(data))
-	} else {
-		v.Add("error_message", config.ErrorMessage)
+	params, err := config.params()
+	if err != nil {
+		return nil, err
 	}
 
-	return bot.MakeRequest("answerShippingQuery", v, nil)
+	return bot.MakeRequestWithContext(ctx, config.method(), params, nil)
 }
 
-// AnswerPreCheckoutQuery allows you to reply to Update with pre_checkout_query.
-func (bot *BotAPI) AnswerPreCheckoutQuery(config PreCheckoutConfig) (*APIResponse, error) {
-	v := url.Values{}
-
-	v.Add("pre_checkout_query_id", config.PreCheckoutQueryID)
-	v.Add("ok", strconv.FormatBool(config.OK))
-	if !config.OK {
-		v.Add("error_message", config.ErrorMessage)
+// GetStickerSet get a sticker set.
+func (bot *BotAPI) GetStickerSet(config GetStickerSetConfig) (*StickerSet, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
 	}
 
-	return bot.MakeRequest("answerPreCheckoutQuery", v, nil)
+	var stickerSet StickerSet
+	_, err = bot.MakeRequest(config.method(), params, &stickerSet)
+	return &stickerSet, err
 }
 
-// DeleteMessage deletes a message in a chat
-func (bot *BotAPI) DeleteMessage(config DeleteMessageConfig) (*APIResponse, error) {
-	v, err := config.values()
+// GetCustomEmojiStickers gets the stickers of custom emoji
+// by their identifiers, as found in MessageEntity.CustomEmojiID.
+func (bot *BotAPI) GetCustomEmojiStickers(customEmojiIDs []string) ([]Sticker, error) {
+	config := GetCustomEmojiStickersConfig{CustomEmojiIDs: customEmojiIDs}
+	params, err := config.params()
 	if err != nil {
 		return nil, err
 	}
 
-	return bot.MakeRequest(config.method(), v, nil)
+	var stickers []Sticker
+	_, err = bot.MakeRequest(config.method(), params, &stickers)
+	return stickers, err
 }
 
-// GetInviteLink get InviteLink for a chat
-func (bot *BotAPI) GetInviteLink(config ChatConfig) (string, error) {
-	v := url.Values{}
+// GetMyCommands gets the current list of the bot's commands
+// of the default scope.
+func (bot *BotAPI) GetMyCommands() ([]BotCommand, error) {
+	return bot.GetMyCommandsWithConfig(GetMyCommandsConfig{})
+}
 
-	if config.SuperGroupUsername == "" {
-		v.Add("chat_id", strconv.FormatInt(config.ChatID, 10))
-	} else {
-		v.Add("chat_id", config.SuperGroupUsername)
+// GetMyCommandsWithConfig gets the current list of the bot's commands
+// for the scope and language of config.
+func (bot *BotAPI) GetMyCommandsWithConfig(config GetMyCommandsConfig) ([]BotCommand, error) {
+	params, err := config.params()
+	if err != nil {
+		return nil, err
 	}
 
-	resp, err := bot.MakeRequest("exportChatInviteLink", v, nil)
+	var commands []BotCommand
+	_, err = bot.MakeRequest(config.method(), params, &commands)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
+	return commands, nil
+}
 
-	var inviteLink string
-	err = json.Unmarshal(resp.Result, &inviteLink)
-
-	return inviteLink, err
+// SetMyCommands changes the list of the bot's commands
+// of the default scope.
+func (bot *BotAPI) SetMyCommands(commands []BotCommand) error {
+	_, err := bot.SetMyCommandsWithConfig(SetMyCommandsConfig{Commands: commands})
+	return err
 }
 
-// PinChatMessage pin message in supergroup
-func (bot *BotAPI) PinChatMessage(config PinChatMessageConfig) (*APIResponse, error) {
-	v, err := config.values()
+// SetMyCommandsWithConfig changes the list of the bot's commands
+// for the scope and language of config.
+func (bot *BotAPI) SetMyCommandsWithConfig(config SetMyCommandsConfig) (*APIResponse, error) {
+	params, err := config.params()
 	if err != nil {
 		return nil, err
 	}
 
-	return bot.MakeRequest(config.method(), v, nil)
+	return bot.MakeRequest(config.method(), params, nil)
 }
 
-// UnpinChatMessage unpin message in supergroup
-func (bot *BotAPI) UnpinChatMessage(config UnpinChatMessageConfig) (*APIResponse, error) {
-	v, err := config.values()
+// DeleteMyCommands deletes the list of the bot's commands for the scope
+// and language of config, so the commands of a broader scope apply.
+func (bot *BotAPI) DeleteMyCommands(config DeleteMyCommandsConfig) (*APIResponse, error) {
+	params, err := config.params()
 	if err != nil {
 		return nil, err
 	}
 
-	return bot.MakeRequest(config.method(), v, nil)
+	return bot.MakeRequest(config.method(), params, nil)
 }
 
-// SetChatTitle change title of chat.
-func (bot *BotAPI) SetChatTitle(config SetChatTitleConfig) (*APIResponse, error) {
-	v, err := config.values()
+// SetMyName changes the bot's name for the language of config.
+func (bot *BotAPI) SetMyName(config SetMyNameConfig) (*APIResponse, error) {
+	params, err := config.params()
 	if err != nil {
 		return nil, err
 	}
 
-	return bot.MakeRequest(config.method(), v, nil)
+	return bot.MakeRequest(config.method(), params, nil)
 }
 
-// SetChatDescription change description of chat.
-func (bot *BotAPI) SetChatDescription(config SetChatDescriptionConfig) (*APIResponse, error) {
-	v, err := config.values()
+// GetMyName gets the bot's name for the language of config.
+func (bot *BotAPI) GetMyName(config GetMyNameConfig) (BotName, error) {
+	params, err := config.params()
 	if err != nil {
-		return nil, err
+		return BotName{}, err
 	}
 
-	return bot.MakeRequest(config.method(), v, nil)
+	var result BotName
+	_, err = bot.MakeRequest(config.method(), params, &result)
+	return result, err
 }
 
-// SetChatPhoto change photo of chat.
-func (bot *BotAPI) SetChatPhoto(config SetChatPhotoConfig) (*APIResponse, error) {
+// SetMyDescription changes the bot's description for the language of config.
+func (bot *BotAPI) SetMyDescription(config SetMyDescriptionConfig) (*APIResponse, error) {
 	params, err := config.params()
 	if err != nil {
 		return nil, err
 	}
 
-	file := config.getFile()
+	return bot.MakeRequest(config.method(), params, nil)
+}
 
-	return bot.UploadFile(config.method(), params, config.name(), file)
+// GetMyDescription gets the bot's description for the language of config.
+func (bot *BotAPI) GetMyDescription(config GetMyDescriptionConfig) (BotDescription, error) {
+	params, err := config.params()
+	if err != nil {
+		return BotDescription{}, err
+	}
+
+	var result BotDescription
+	_, err = bot.MakeRequest(config.method(), params, &result)
+	return result, err
 }
 
-// DeleteChatPhoto delete photo of chat.
-func (bot *BotAPI) DeleteChatPhoto(config DeleteChatPhotoConfig) (*APIResponse, error) {
-	v, err := config.values()
+// SetMyShortDescription changes the bot's short description for the language of config.
+func (bot *BotAPI) SetMyShortDescription(config SetMyShortDescriptionConfig) (*APIResponse, error) {
+	params, err := config.params()
 	if err != nil {
 		return nil, err
 	}
 
-	return bot.MakeRequest(config.method(), v, nil)
+	return bot.MakeRequest(config.method(), params, nil)
 }
 
-// GetStickerSet get a sticker set.
-func (bot *BotAPI) GetStickerSet(config GetStickerSetConfig) (*StickerSet, error) {
-	v, err := config.values()
+// GetMyShortDescription gets the bot's short description for the language of config.
+func (bot *BotAPI) GetMyShortDescription(config GetMyShortDescriptionConfig) (BotShortDescription, error) {
+	params, err := config.params()
 	if err != nil {
-		return nil, err
+		return BotShortDescription{}, err
 	}
-	var stickerSet StickerSet
-	_, err = bot.MakeRequest(config.method(), v, &stickerSet)
-	return &stickerSet, err
+
+	var result BotShortDescription
+	_, err = bot.MakeRequest(config.method(), params, &result)
+	return result, err
 }
 
-// GetMyCommands gets the current list of the bot's commands.
-func (bot *BotAPI) GetMyCommands() ([]BotCommand, error) {
-	res, err := bot.MakeRequest("getMyCommands", nil)
+// SetChatMenuButton changes the bot's menu button in a private chat,
+// or the default menu button.
+func (bot *BotAPI) SetChatMenuButton(config SetChatMenuButtonConfig) (*APIResponse, error) {
+	params, err := config.params()
 	if err != nil {
 		return nil, err
 	}
-	var commands []BotCommand
-	err = json.Unmarshal(res.Result, &commands)
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// GetChatMenuButton gets the bot's menu button in a private chat,
+// or the default menu button.
+func (bot *BotAPI) GetChatMenuButton(config GetChatMenuButtonConfig) (MenuButton, error) {
+	params, err := config.params()
 	if err != nil {
-		return nil, err
+		return MenuButton{}, err
 	}
-	return commands, nil
+
+	var button MenuButton
+	_, err = bot.MakeRequest(config.method(), params, &button)
+	return button, err
 }
 
-// SetMyCommands changes the list of the bot's commands.
-func (bot *BotAPI) SetMyCommands(commands []BotCommand) error {
-	v := url.Values{}
-	data, err := json.Marshal(commands)
+// SetMyDefaultAdministratorRights changes the default administrator rights
+// requested by the bot when it is added to groups or channels.
+func (bot *BotAPI) SetMyDefaultAdministratorRights(config SetMyDefaultAdministratorRightsConfig) (*APIResponse, error) {
+	params, err := config.params()
 	if err != nil {
-		return err
+		return nil, err
 	}
-	v.Add("commands", string(data))
-	_, err = bot.MakeRequest("setMyCommands", v)
+
+	return bot.MakeRequest(config.method(), params, nil)
+}
+
+// GetMyDefaultAdministratorRights gets the default administrator rights
+// of the bot.
+func (bot *BotAPI) GetMyDefaultAdministratorRights(config GetMyDefaultAdministratorRightsConfig) (ChatAdministratorRights, error) {
+	params, err := config.params()
 	if err != nil {
-		return err
+		return ChatAdministratorRights{}, err
 	}
-	return nil
+
+	var rights ChatAdministratorRights
+	_, err = bot.MakeRequest(config.method(), params, &rights)
+	return rights, err
 }
 
 // EscapeText takes an input text and escape Telegram markup symbols.
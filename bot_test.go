@@ -1,14 +1,21 @@
 package tgbotapi_test
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
 	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/Feresey/telegram-bot-api/v5/tgbotapitest"
 	"github.com/stretchr/testify/require"
 )
 
@@ -32,11 +39,266 @@ func getBot(t *testing.T) *tgbotapi.BotAPI {
 	return bot
 }
 
+// newTestBot creates a bot talking to a local server. The server answers
+// getMe itself and passes every other method to handler.
+func newTestBot(t *testing.T, handler http.HandlerFunc) *tgbotapi.BotAPI {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/getMe") {
+			_, _ = w.Write([]byte(`{"ok":true,"result":{"id":1,"is_bot":true,"first_name":"Test","username":"test_bot"}}`))
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	bot, err := tgbotapi.NewBotAPIWithAPIEndpoint(TestToken, server.URL+"/bot%s/%s")
+	require.NoError(t, err)
+	return bot
+}
+
+// newServerBot creates a bot talking to a tgbotapitest.Server,
+// which is closed when the test ends.
+func newServerBot(t *testing.T) (*tgbotapi.BotAPI, *tgbotapitest.Server) {
+	server := tgbotapitest.NewServer()
+	t.Cleanup(server.Close)
+
+	bot, err := server.NewBot()
+	require.NoError(t, err)
+	return bot, server
+}
+
 func TestNewBotAPI_notoken(t *testing.T) {
 	_, err := tgbotapi.NewBotAPI("")
 	require.Error(t, err)
 }
 
+func TestSendWithContextCanceled(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := bot.SendWithContext(ctx, tgbotapi.NewMessage(ChatID, "test"))
+	require.True(t, errors.Is(err, context.DeadlineExceeded), err)
+}
+
+func TestUploadSizeLimit(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("file exceeding the limit was uploaded")
+	})
+
+	file := tgbotapi.FileReader{Name: "video.mp4", Reader: strings.NewReader(""), Size: tgbotapi.MaxUploadSize + 1}
+	_, err := bot.Send(tgbotapi.NewVideoUpload(ChatID, file))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), tgbotapi.ErrFileTooLarge)
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
+
+func TestUploadStreaming(t *testing.T) {
+	var (
+		chunked bool
+		size    int64
+	)
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		chunked = r.ContentLength == -1
+
+		file, _, err := r.FormFile("document")
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		size, _ = io.Copy(ioutil.Discard, file)
+		_, _ = w.Write([]byte(`{"ok":true,"result":{"message_id":1}}`))
+	})
+
+	file := tgbotapi.FileReader{Name: "data.bin", Reader: io.LimitReader(zeroReader{}, 3<<20), Size: -1}
+	_, err := bot.Send(tgbotapi.NewDocumentUpload(ChatID, file))
+	require.NoError(t, err)
+	require.True(t, chunked)
+	require.Equal(t, int64(3<<20), size)
+
+	file = tgbotapi.FileReader{Name: "endless.bin", Reader: zeroReader{}, Size: -1}
+	_, err = bot.Send(tgbotapi.NewDocumentUpload(ChatID, file))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), tgbotapi.ErrFileTooLarge)
+}
+
+func TestLocalModeFileDirectURL(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ok":true,"result":{"file_id":"id","file_path":"/data/videos/file_0.mp4"}}`))
+	})
+	bot.LocalMode(true)
+
+	link, err := bot.GetFileDirectURL("id")
+	require.NoError(t, err)
+	require.Equal(t, "file:///data/videos/file_0.mp4", link)
+}
+
+func TestUseTestEnvironment(t *testing.T) {
+	var path string
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		_, _ = w.Write([]byte(`{"ok":true,"result":{"message_id":1}}`))
+	})
+	bot.UseTestEnvironment(true)
+
+	_, err := bot.Send(tgbotapi.NewMessage(ChatID, "test"))
+	require.NoError(t, err)
+	require.Equal(t, "/bot"+TestToken+"/test/sendMessage", path)
+}
+
+func TestShutdown(t *testing.T) {
+	polls := make(chan struct{}, 10)
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		polls <- struct{}{}
+		if r.FormValue("offset") == "" {
+			_, _ = w.Write([]byte(`{"ok":true,"result":[{"update_id":1},{"update_id":2}]}`))
+			return
+		}
+		// Long poll without updates.
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	})
+	bot.Buffer = 10
+
+	updates, err := bot.GetUpdatesChan(tgbotapi.NewUpdate(0))
+	require.NoError(t, err)
+
+	<-polls
+	<-polls
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	require.NoError(t, bot.Shutdown(ctx))
+
+	var ids []int
+	for update := range updates {
+		ids = append(ids, update.UpdateID)
+	}
+	require.Equal(t, []int{1, 2}, ids)
+
+	// Stopping again must not panic.
+	bot.StopReceivingUpdates()
+}
+
+func TestGetUpdatesChanErrorBackoff(t *testing.T) {
+	calls := 0
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls <= 2 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok":true,"result":[{"update_id":1}]}`))
+	})
+
+	// OnError runs on the polling goroutine, so its arguments are
+	// recorded and checked once the update is received.
+	var (
+		errs     []error
+		failures []int
+		delays   []time.Duration
+	)
+	config := tgbotapi.NewUpdate(0)
+	config.ErrorBackoff = &tgbotapi.RetryPolicy{MinBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+	config.OnError = func(err error, failed int, retryIn time.Duration) bool {
+		errs = append(errs, err)
+		failures = append(failures, failed)
+		delays = append(delays, retryIn)
+		return true
+	}
+
+	updates, err := bot.GetUpdatesChan(config)
+	require.NoError(t, err)
+
+	update := <-updates
+	bot.StopReceivingUpdates()
+	require.Equal(t, 1, update.UpdateID)
+	require.Equal(t, []int{1, 2}, failures)
+	require.Equal(t, []time.Duration{time.Millisecond, 2 * time.Millisecond}, delays)
+	for _, err := range errs {
+		require.True(t, tgbotapi.IsTransientError(err))
+	}
+}
+
+func TestGetUpdatesChanStopOnError(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"ok":false,"error_code":401,"description":"Unauthorized"}`))
+	})
+
+	config := tgbotapi.NewUpdate(0)
+	config.OnError = func(err error, failures int, retryIn time.Duration) bool {
+		apiErr, ok := tgbotapi.AsError(err)
+		return !ok || apiErr.Code != http.StatusUnauthorized
+	}
+
+	updates, err := bot.GetUpdatesChan(config)
+	require.NoError(t, err)
+
+	_, ok := <-updates
+	require.False(t, ok)
+}
+
+func TestAllowedUpdates(t *testing.T) {
+	allowed := make(map[string]string)
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		allowed[r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]] = r.FormValue("allowed_updates")
+		_, _ = w.Write([]byte(`{"ok":true,"result":[]}`))
+	})
+
+	config := tgbotapi.NewUpdate(0)
+	config.AllowedUpdates = []string{tgbotapi.UpdateTypeMessage, tgbotapi.UpdateTypeCallbackQuery}
+	_, err := bot.GetUpdates(config)
+	require.NoError(t, err)
+
+	webhook := tgbotapi.NewWebhook("https://example.com/hook")
+	webhook.AllowedUpdates = []string{tgbotapi.UpdateTypeInlineQuery}
+	_, err = bot.SetWebhook(webhook)
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]string{
+		"getUpdates": `["message","callback_query"]`,
+		"setWebhook": `["inline_query"]`,
+	}, allowed)
+}
+
+func TestDropPendingUpdates(t *testing.T) {
+	dropped := make(map[string]string)
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		dropped[r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]] = r.FormValue("drop_pending_updates")
+		_, _ = w.Write([]byte(`{"ok":true,"result":true}`))
+	})
+
+	webhook := tgbotapi.NewWebhook("https://example.com/hook")
+	webhook.DropPendingUpdates = true
+	_, err := bot.SetWebhook(webhook)
+	require.NoError(t, err)
+
+	_, err = bot.DeleteWebhook(tgbotapi.DeleteWebhookConfig{DropPendingUpdates: true})
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]string{
+		"setWebhook":    "true",
+		"deleteWebhook": "true",
+	}, dropped)
+}
+
 func TestGetUpdates(t *testing.T) {
 	bot := getBot(t)
 
@@ -276,6 +538,22 @@ func TestSendWithVenue(t *testing.T) {
 	}
 }
 
+func TestLocationZeroCoordinates(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	_, err := bot.Send(tgbotapi.NewLocation(ChatID, 0, 30.5))
+	require.NoError(t, err)
+	params := server.RequestsFor("sendLocation")[0].Params
+	require.Equal(t, "0.000000", params["latitude"])
+	require.Equal(t, "30.500000", params["longitude"])
+
+	_, err = bot.Send(tgbotapi.NewVenue(ChatID, "Null Island", "Gulf of Guinea", 0, 0))
+	require.NoError(t, err)
+	params = server.RequestsFor("sendVenue")[0].Params
+	require.Equal(t, "0.000000", params["latitude"])
+	require.Equal(t, "0.000000", params["longitude"])
+}
+
 func TestSendWithNewVideo(t *testing.T) {
 	bot := getBot(t)
 
@@ -553,6 +831,91 @@ func TestSendWithMediaGroup(t *testing.T) {
 	}
 }
 
+func TestSendMediaGroupWithSend(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	cfg := tgbotapi.NewMediaGroup(ChatID, []interface{}{
+		tgbotapi.NewInputMediaPhoto("photo-1"),
+		tgbotapi.NewInputMediaPhoto("photo-2"),
+	})
+
+	msg, err := bot.Send(cfg)
+	require.NoError(t, err)
+	require.Equal(t, 1, msg.MessageID)
+
+	msg, err = bot.Send(&cfg)
+	require.NoError(t, err)
+	require.Equal(t, 3, msg.MessageID)
+	require.Len(t, server.RequestsFor("sendMediaGroup"), 2)
+}
+
+func TestSendMediaGroupUpload(t *testing.T) {
+	var (
+		media string
+		files []string
+	)
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		media = r.FormValue("media")
+		for name := range r.MultipartForm.File {
+			files = append(files, name)
+		}
+		_, _ = w.Write([]byte(`{"ok":true,"result":[{"message_id":1},{"message_id":2},{"message_id":3}]}`))
+	})
+
+	cfg := tgbotapi.NewMediaGroup(ChatID, []interface{}{
+		tgbotapi.NewInputMediaPhotoUpload("tests/image.jpg"),
+		tgbotapi.NewInputMediaPhoto("existing-photo"),
+		tgbotapi.NewInputMediaVideoUpload(tgbotapi.FileBytes{Name: "video.mp4", Bytes: []byte("mp4")}),
+	})
+	messages, err := bot.SendMediaGroup(cfg)
+	require.NoError(t, err)
+	require.Len(t, messages, 3)
+	require.Equal(t, 3, messages[2].MessageID)
+
+	require.ElementsMatch(t, []string{"file-0", "file-2"}, files)
+	require.JSONEq(t, `[
+		{"type":"photo","media":"attach://file-0","caption":"","parse_mode":""},
+		{"type":"photo","media":"existing-photo","caption":"","parse_mode":""},
+		{"type":"video","media":"attach://file-2","caption":"","parse_mode":"","width":0,"height":0,"duration":0,"supports_streaming":false}
+	]`, media)
+
+	_, err = bot.SendMediaGroup(tgbotapi.NewMediaGroup(ChatID, []interface{}{tgbotapi.NewInputMediaPhoto("single")}))
+	require.EqualError(t, err, tgbotapi.ErrBadMediaGroup)
+}
+
+func TestSendMediaGroupThumbnails(t *testing.T) {
+	var (
+		media string
+		files []string
+	)
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		media = r.FormValue("media")
+		for name := range r.MultipartForm.File {
+			files = append(files, name)
+		}
+		_, _ = w.Write([]byte(`{"ok":true,"result":[]}`))
+	})
+
+	first := tgbotapi.NewInputMediaDocumentUpload("tests/audio.mp3")
+	first.Thumbnail = "tests/image.jpg"
+	first.CaptionEntities = []tgbotapi.MessageEntity{{Type: "bold", Offset: 0, Length: 4}}
+	first.Caption = "bold"
+	second := tgbotapi.NewInputMediaDocument("existing-document")
+	second.DisableContentTypeDetection = true
+
+	_, err := bot.SendMediaGroup(tgbotapi.NewMediaGroup(ChatID, []interface{}{first, second}))
+	require.NoError(t, err)
+
+	require.ElementsMatch(t, []string{"file-0", "file-0-thumbnail"}, files)
+	require.JSONEq(t, `[
+		{"type":"document","media":"attach://file-0","thumbnail":"attach://file-0-thumbnail","caption":"bold","parse_mode":"",
+		 "caption_entities":[{"type":"bold","offset":0,"length":4,"url":"","user":null}]},
+		{"type":"document","media":"existing-document","caption":"","parse_mode":"","disable_content_type_detection":true}
+	]`, media)
+}
+
 func ExampleNewBotAPI() {
 	bot, err := tgbotapi.NewBotAPI("MyAwesomeBotToken")
 	if err != nil {
@@ -753,3 +1116,1426 @@ func TestUnpinChatMessage(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestGetUpdatesPollTimeout(t *testing.T) {
+	var timeouts []string
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		timeouts = append(timeouts, r.FormValue("timeout"))
+		_, _ = w.Write([]byte(`{"ok":true,"result":[]}`))
+	})
+
+	config := tgbotapi.NewUpdate(0)
+	config.Timeout = 60
+
+	_, err := bot.GetUpdates(config)
+	require.NoError(t, err)
+
+	bot.Client = &http.Client{Timeout: tgbotapi.LongPollMargin + 15*time.Second}
+	_, err = bot.GetUpdates(config)
+	require.NoError(t, err)
+
+	bot.Client = &http.Client{Timeout: time.Second}
+	_, err = bot.GetUpdates(config)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"60", "15", ""}, timeouts)
+}
+
+func TestCopyMessage(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	require.NoError(t, server.Respond("copyMessage", tgbotapi.MessageID{MessageID: 10}))
+	copyConfig := tgbotapi.NewCopyMessage(ChatID, 42, 7)
+	copyConfig.Caption = "new caption"
+	copyConfig.CaptionEntities = []tgbotapi.MessageEntity{{Type: "italic", Length: 3}}
+	messageID, err := bot.CopyMessage(copyConfig)
+	require.NoError(t, err)
+	require.Equal(t, 10, messageID.MessageID)
+
+	params := server.RequestsFor("copyMessage")[0].Params
+	require.Equal(t, "42", params["from_chat_id"])
+	require.Equal(t, "7", params["message_id"])
+	require.Equal(t, "new caption", params["caption"])
+	require.JSONEq(t, `[{"type":"italic","offset":0,"length":3,"url":"","user":null}]`, params["caption_entities"])
+
+	require.NoError(t, server.Respond("copyMessages", []tgbotapi.MessageID{{MessageID: 11}, {MessageID: 12}}))
+	copiesConfig := tgbotapi.NewCopyMessages(ChatID, 42, []int{7, 8})
+	copiesConfig.RemoveCaption = true
+	messageIDs, err := bot.CopyMessages(copiesConfig)
+	require.NoError(t, err)
+	require.Equal(t, []tgbotapi.MessageID{{MessageID: 11}, {MessageID: 12}}, messageIDs)
+
+	params = server.RequestsFor("copyMessages")[0].Params
+	require.Equal(t, "[7,8]", params["message_ids"])
+	require.Equal(t, "true", params["remove_caption"])
+}
+
+func TestForwardMessages(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	require.NoError(t, server.Respond("forwardMessages", []tgbotapi.MessageID{{MessageID: 20}, {MessageID: 21}}))
+	config := tgbotapi.NewForwardMessages(ChatID, 42, []int{3, 5})
+	config.DisableNotification = true
+	messageIDs, err := bot.ForwardMessages(config)
+	require.NoError(t, err)
+	require.Equal(t, []tgbotapi.MessageID{{MessageID: 20}, {MessageID: 21}}, messageIDs)
+
+	params := server.RequestsFor("forwardMessages")[0].Params
+	require.Equal(t, tgbotapi.Params{
+		"chat_id":              "76918703",
+		"from_chat_id":         "42",
+		"message_ids":          "[3,5]",
+		"disable_notification": "true",
+	}, params)
+}
+
+func TestDeleteMessages(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	_, err := bot.DeleteMessages(tgbotapi.NewDeleteMessages(ChatID, []int{1, 2, 3}))
+	require.NoError(t, err)
+
+	requests := server.RequestsFor("deleteMessages")
+	require.Len(t, requests, 1)
+	require.Equal(t, tgbotapi.Params{"chat_id": "76918703", "message_ids": "[1,2,3]"}, requests[0].Params)
+}
+
+func TestSendDice(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	dice := &tgbotapi.Dice{Emoji: tgbotapi.SlotMachineEmoji, Value: 64}
+	require.NoError(t, server.Respond("sendDice", tgbotapi.Message{MessageID: 1, Dice: dice}))
+
+	msg, err := bot.Send(tgbotapi.NewDiceWithEmoji(ChatID, tgbotapi.SlotMachineEmoji))
+	require.NoError(t, err)
+	require.Equal(t, dice, msg.Dice)
+
+	params := server.RequestsFor("sendDice")[0].Params
+	require.Equal(t, "🎰", params["emoji"])
+}
+
+func TestSendPoll(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	config := tgbotapi.NewQuiz(ChatID, "2+2?", 1, "3", "4")
+	config.Explanation = "basic math"
+	config.ExplanationEntities = []tgbotapi.MessageEntity{{Type: "bold", Length: 5}}
+	config.OpenPeriod = 60
+	_, err := bot.Send(config)
+	require.NoError(t, err)
+
+	params := server.RequestsFor("sendPoll")[0].Params
+	require.JSONEq(t, `[{"text":"3"},{"text":"4"}]`, params["options"])
+	require.Equal(t, "quiz", params["type"])
+	require.Equal(t, "1", params["correct_option_id"])
+	require.Equal(t, "true", params["is_anonymous"])
+	require.Equal(t, "60", params["open_period"])
+	require.JSONEq(t, `[{"type":"bold","offset":0,"length":5,"url":"","user":null}]`, params["explanation_entities"])
+
+	config = tgbotapi.NewPoll(ChatID, "Lunch?")
+	config.QuestionParseMode = tgbotapi.ModeHTML
+	config.InputOptions = []tgbotapi.InputPollOption{{Text: "<b>Pizza</b>", TextParseMode: tgbotapi.ModeHTML}, {Text: "Salad"}}
+	config.AllowsMultipleAnswers = true
+	_, err = bot.Send(config)
+	require.NoError(t, err)
+
+	params = server.RequestsFor("sendPoll")[1].Params
+	require.JSONEq(t, `[{"text":"<b>Pizza</b>","text_parse_mode":"HTML"},{"text":"Salad"}]`, params["options"])
+	require.Equal(t, "HTML", params["question_parse_mode"])
+	require.Equal(t, "true", params["allows_multiple_answers"])
+	require.NotContains(t, params, "correct_option_id")
+}
+
+func TestStopPoll(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	require.NoError(t, server.Respond("stopPoll", tgbotapi.Poll{
+		ID:       "poll",
+		Question: "Lunch?",
+		Options:  []tgbotapi.PollOption{{Text: "Pizza", VoterCount: 2}, {Text: "Salad", VoterCount: 1}},
+		IsClosed: true,
+		Type:     tgbotapi.PollTypeRegular,
+	}))
+	poll, err := bot.StopPoll(tgbotapi.NewStopPoll(ChatID, 5))
+	require.NoError(t, err)
+	require.True(t, poll.IsClosed)
+	require.Equal(t, 2, poll.Options[0].VoterCount)
+
+	params := server.RequestsFor("stopPoll")[0].Params
+	require.Equal(t, tgbotapi.Params{"chat_id": "76918703", "message_id": "5"}, params)
+}
+
+func TestSendVenueAndContact(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	venue := tgbotapi.NewVenue(ChatID, "Cafe", "1 Main Street", 40, 40)
+	venue.GooglePlaceID = "place"
+	venue.GooglePlaceType = "cafe"
+	_, err := bot.Send(venue)
+	require.NoError(t, err)
+
+	params := server.RequestsFor("sendVenue")[0].Params
+	require.Equal(t, "place", params["google_place_id"])
+	require.Equal(t, "cafe", params["google_place_type"])
+
+	contact := tgbotapi.NewContact(ChatID, "5551234567", "Test")
+	contact.VCard = "BEGIN:VCARD\nVERSION:3.0\nEND:VCARD"
+	_, err = bot.Send(contact)
+	require.NoError(t, err)
+
+	params = server.RequestsFor("sendContact")[0].Params
+	require.Equal(t, contact.VCard, params["vcard"])
+}
+
+func TestSendAnimationVoiceVideoNote(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	animation := tgbotapi.NewAnimationUpload(ChatID, tgbotapi.FileBytes{Name: "cat.gif", Bytes: []byte("gif")})
+	animation.Width = 320
+	animation.Height = 240
+	animation.HasSpoiler = true
+	animation.Caption = "cat"
+	animation.CaptionEntities = []tgbotapi.MessageEntity{{Type: "bold", Length: 3}}
+	animation.Thumbnail = tgbotapi.FileBytes{Name: "thumb.jpg", Bytes: []byte("jpeg")}
+	_, err := bot.Send(animation)
+	require.NoError(t, err)
+
+	request := server.RequestsFor("sendAnimation")[0]
+	require.Equal(t, []byte("gif"), request.Files["animation"].Data)
+	require.Equal(t, []byte("jpeg"), request.Files["thumbnail"].Data)
+	require.Equal(t, "320", request.Params["width"])
+	require.Equal(t, "240", request.Params["height"])
+	require.Equal(t, "true", request.Params["has_spoiler"])
+	require.JSONEq(t, `[{"type":"bold","offset":0,"length":3,"url":"","user":null}]`, request.Params["caption_entities"])
+
+	voice := tgbotapi.NewVoiceShare(ChatID, "voice-id")
+	voice.Duration = 5
+	voice.CaptionEntities = []tgbotapi.MessageEntity{{Type: "italic", Length: 2}}
+	_, err = bot.Send(voice)
+	require.NoError(t, err)
+
+	params := server.RequestsFor("sendVoice")[0].Params
+	require.Equal(t, "voice-id", params["voice"])
+	require.Equal(t, "5", params["duration"])
+	require.JSONEq(t, `[{"type":"italic","offset":0,"length":2,"url":"","user":null}]`, params["caption_entities"])
+
+	videoNote := tgbotapi.NewVideoNoteShare(ChatID, 240, "note-id")
+	videoNote.Duration = 10
+	_, err = bot.Send(videoNote)
+	require.NoError(t, err)
+
+	params = server.RequestsFor("sendVideoNote")[0].Params
+	require.Equal(t, "note-id", params["video_note"])
+	require.Equal(t, "240", params["length"])
+	require.Equal(t, "10", params["duration"])
+}
+
+func TestEditMessageMedia(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	require.NoError(t, server.Respond("editMessageMedia", tgbotapi.Message{MessageID: 5}))
+	photo := tgbotapi.NewInputMediaPhotoUpload(tgbotapi.FileBytes{Name: "image.jpg", Bytes: []byte("jpeg")})
+	photo.Caption = "next"
+	msg, err := bot.Send(tgbotapi.NewEditMessageMedia(ChatID, 5, photo))
+	require.NoError(t, err)
+	require.Equal(t, 5, msg.MessageID)
+
+	request := server.RequestsFor("editMessageMedia")[0]
+	require.Equal(t, []byte("jpeg"), request.Files["media-file"].Data)
+	require.Equal(t, "5", request.Params["message_id"])
+	require.JSONEq(t, `{"type":"photo","media":"attach://media-file","caption":"next","parse_mode":""}`, request.Params["media"])
+
+	require.NoError(t, server.Respond("editMessageMedia", tgbotapi.Message{MessageID: 5}))
+	_, err = bot.Send(tgbotapi.NewEditMessageMedia(ChatID, 5, tgbotapi.NewInputMediaVideo("video-id")))
+	require.NoError(t, err)
+
+	request = server.RequestsFor("editMessageMedia")[1]
+	require.Empty(t, request.Files)
+	require.JSONEq(t, `{"type":"video","media":"video-id","caption":"","parse_mode":"","width":0,"height":0,"duration":0,"supports_streaming":false}`, request.Params["media"])
+}
+
+func TestCaptionEntities(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	entities := []tgbotapi.MessageEntity{{Type: "bold", Length: 4}}
+	const entitiesJSON = `[{"type":"bold","offset":0,"length":4,"url":"","user":null}]`
+
+	photo := tgbotapi.NewPhotoShare(ChatID, "photo-id")
+	photo.Caption = "bold"
+	photo.CaptionEntities = entities
+	photo.ShowCaptionAboveMedia = true
+	_, err := bot.Send(photo)
+	require.NoError(t, err)
+
+	params := server.RequestsFor("sendPhoto")[0].Params
+	require.JSONEq(t, entitiesJSON, params["caption_entities"])
+	require.Equal(t, "true", params["show_caption_above_media"])
+
+	document := tgbotapi.NewDocumentShare(ChatID, "document-id")
+	document.CaptionEntities = entities
+	_, err = bot.Send(document)
+	require.NoError(t, err)
+
+	params = server.RequestsFor("sendDocument")[0].Params
+	require.JSONEq(t, entitiesJSON, params["caption_entities"])
+	require.NotContains(t, params, "parse_mode")
+
+	require.NoError(t, server.Respond("editMessageCaption", tgbotapi.Message{MessageID: 3}))
+	edit := tgbotapi.NewEditMessageCaption(ChatID, 3, "bold")
+	edit.CaptionEntities = entities
+	edit.ShowCaptionAboveMedia = true
+	_, err = bot.Send(edit)
+	require.NoError(t, err)
+
+	params = server.RequestsFor("editMessageCaption")[0].Params
+	require.JSONEq(t, entitiesJSON, params["caption_entities"])
+	require.Equal(t, "true", params["show_caption_above_media"])
+}
+
+func TestLiveLocation(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	location := tgbotapi.NewLocation(ChatID, 40, 40)
+	location.LivePeriod = tgbotapi.LivePeriodIndefinite
+	location.HorizontalAccuracy = 12.5
+	location.ProximityAlertRadius = 100
+	msg, err := bot.Send(location)
+	require.NoError(t, err)
+
+	params := server.RequestsFor("sendLocation")[0].Params
+	require.Equal(t, "2147483647", params["live_period"])
+	require.Equal(t, "12.500000", params["horizontal_accuracy"])
+	require.Equal(t, "100", params["proximity_alert_radius"])
+
+	require.NoError(t, server.Respond("editMessageLiveLocation", tgbotapi.Message{MessageID: msg.MessageID}))
+	edit := tgbotapi.NewEditMessageLiveLocation(ChatID, msg.MessageID, 41, 42)
+	edit.Heading = 90
+	_, err = bot.Send(edit)
+	require.NoError(t, err)
+
+	params = server.RequestsFor("editMessageLiveLocation")[0].Params
+	require.Equal(t, "41.000000", params["latitude"])
+	require.Equal(t, "42.000000", params["longitude"])
+	require.Equal(t, "90", params["heading"])
+
+	require.NoError(t, server.Respond("editMessageLiveLocation", tgbotapi.Message{MessageID: msg.MessageID}))
+	_, err = bot.Send(tgbotapi.NewEditMessageLiveLocation(ChatID, msg.MessageID, 0, 0))
+	require.NoError(t, err)
+
+	params = server.RequestsFor("editMessageLiveLocation")[1].Params
+	require.Equal(t, "0.000000", params["latitude"])
+	require.Equal(t, "0.000000", params["longitude"])
+
+	require.NoError(t, server.Respond("stopMessageLiveLocation", tgbotapi.Message{MessageID: msg.MessageID}))
+	_, err = bot.Send(tgbotapi.NewStopMessageLiveLocation(ChatID, msg.MessageID))
+	require.NoError(t, err)
+
+	params = server.RequestsFor("stopMessageLiveLocation")[0].Params
+	require.Equal(t, tgbotapi.Params{"chat_id": "76918703", "message_id": "1"}, params)
+}
+
+func TestMyCommands(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	config := tgbotapi.NewSetMyCommandsWithScope(
+		tgbotapi.NewBotCommandScopeChatMember(ChatID, 42),
+		tgbotapi.BotCommand{Command: "start", Description: "Start the bot"},
+	)
+	config.LanguageCode = "de"
+	_, err := bot.SetMyCommandsWithConfig(config)
+	require.NoError(t, err)
+
+	params := server.RequestsFor("setMyCommands")[0].Params
+	require.JSONEq(t, `[{"command":"start","description":"Start the bot"}]`, params["commands"])
+	require.JSONEq(t, `{"type":"chat_member","chat_id":76918703,"user_id":42}`, params["scope"])
+	require.Equal(t, "de", params["language_code"])
+
+	require.NoError(t, server.Respond("getMyCommands", []tgbotapi.BotCommand{{Command: "help", Description: "Get help"}}))
+	commands, err := bot.GetMyCommandsWithConfig(tgbotapi.NewGetMyCommandsWithScope(tgbotapi.NewBotCommandScopeAllPrivateChats()))
+	require.NoError(t, err)
+	require.Equal(t, []tgbotapi.BotCommand{{Command: "help", Description: "Get help"}}, commands)
+
+	params = server.RequestsFor("getMyCommands")[0].Params
+	require.JSONEq(t, `{"type":"all_private_chats"}`, params["scope"])
+
+	_, err = bot.DeleteMyCommands(tgbotapi.NewDeleteMyCommandsWithScope(tgbotapi.NewBotCommandScopeChat(ChatID)))
+	require.NoError(t, err)
+
+	params = server.RequestsFor("deleteMyCommands")[0].Params
+	require.JSONEq(t, `{"type":"chat","chat_id":76918703}`, params["scope"])
+
+	require.NoError(t, bot.SetMyCommands(nil))
+	params = server.RequestsFor("setMyCommands")[1].Params
+	require.Equal(t, tgbotapi.Params{"commands": "[]"}, params)
+}
+
+func TestMyNameAndDescriptions(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	_, err := bot.SetMyName(tgbotapi.SetMyNameConfig{Name: "Testbot", LanguageCode: "en"})
+	require.NoError(t, err)
+	require.Equal(t, tgbotapi.Params{"name": "Testbot", "language_code": "en"}, server.RequestsFor("setMyName")[0].Params)
+
+	require.NoError(t, server.Respond("getMyName", tgbotapi.BotName{Name: "Testbot"}))
+	name, err := bot.GetMyName(tgbotapi.GetMyNameConfig{LanguageCode: "en"})
+	require.NoError(t, err)
+	require.Equal(t, "Testbot", name.Name)
+
+	_, err = bot.SetMyDescription(tgbotapi.SetMyDescriptionConfig{Description: "Does tests"})
+	require.NoError(t, err)
+	require.Equal(t, tgbotapi.Params{"description": "Does tests"}, server.RequestsFor("setMyDescription")[0].Params)
+
+	require.NoError(t, server.Respond("getMyDescription", tgbotapi.BotDescription{Description: "Does tests"}))
+	description, err := bot.GetMyDescription(tgbotapi.GetMyDescriptionConfig{})
+	require.NoError(t, err)
+	require.Equal(t, "Does tests", description.Description)
+
+	_, err = bot.SetMyShortDescription(tgbotapi.SetMyShortDescriptionConfig{LanguageCode: "de"})
+	require.NoError(t, err)
+	require.Equal(t, tgbotapi.Params{"language_code": "de"}, server.RequestsFor("setMyShortDescription")[0].Params)
+
+	require.NoError(t, server.Respond("getMyShortDescription", tgbotapi.BotShortDescription{ShortDescription: "Tests"}))
+	shortDescription, err := bot.GetMyShortDescription(tgbotapi.GetMyShortDescriptionConfig{})
+	require.NoError(t, err)
+	require.Equal(t, "Tests", shortDescription.ShortDescription)
+}
+
+func TestChatMenuButton(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	button := tgbotapi.NewMenuButtonWebApp("Open", "https://example.com/app")
+	_, err := bot.SetChatMenuButton(tgbotapi.NewSetChatMenuButton(ChatID, button))
+	require.NoError(t, err)
+
+	params := server.RequestsFor("setChatMenuButton")[0].Params
+	require.Equal(t, "76918703", params["chat_id"])
+	require.JSONEq(t, `{"type":"web_app","text":"Open","web_app":{"url":"https://example.com/app"}}`, params["menu_button"])
+
+	_, err = bot.SetChatMenuButton(tgbotapi.SetChatMenuButtonConfig{})
+	require.NoError(t, err)
+	require.Empty(t, server.RequestsFor("setChatMenuButton")[1].Params)
+
+	require.NoError(t, server.Respond("getChatMenuButton", button))
+	got, err := bot.GetChatMenuButton(tgbotapi.GetChatMenuButtonConfig{ChatID: ChatID})
+	require.NoError(t, err)
+	require.Equal(t, button, got)
+}
+
+func TestMyDefaultAdministratorRights(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	rights := tgbotapi.ChatAdministratorRights{CanManageChat: true, CanPostMessages: true}
+	_, err := bot.SetMyDefaultAdministratorRights(tgbotapi.SetMyDefaultAdministratorRightsConfig{
+		Rights:      &rights,
+		ForChannels: true,
+	})
+	require.NoError(t, err)
+
+	params := server.RequestsFor("setMyDefaultAdministratorRights")[0].Params
+	require.Equal(t, "true", params["for_channels"])
+
+	var sent tgbotapi.ChatAdministratorRights
+	require.NoError(t, json.Unmarshal([]byte(params["rights"]), &sent))
+	require.Equal(t, rights, sent)
+
+	require.NoError(t, server.Respond("getMyDefaultAdministratorRights", rights))
+	got, err := bot.GetMyDefaultAdministratorRights(tgbotapi.GetMyDefaultAdministratorRightsConfig{ForChannels: true})
+	require.NoError(t, err)
+	require.Equal(t, rights, got)
+}
+
+func TestSetChatPermissions(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	_, err := bot.SetChatPermissions(tgbotapi.SetChatPermissionsConfig{
+		ChatID:                        ChatID,
+		Permissions:                   &tgbotapi.ChatPermissions{CanSendMessages: true, CanSendPhotos: true},
+		UseIndependentChatPermissions: true,
+	})
+	require.NoError(t, err)
+
+	params := server.RequestsFor("setChatPermissions")[0].Params
+	require.Equal(t, "76918703", params["chat_id"])
+	require.Equal(t, "true", params["use_independent_chat_permissions"])
+	require.JSONEq(t, `{"can_send_messages":true,"can_send_photos":true}`, params["permissions"])
+}
+
+func TestBanChatSenderChat(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	_, err := bot.BanChatSenderChat(tgbotapi.BanChatSenderChatConfig{ChatID: ChatID, SenderChatID: -1001234})
+	require.NoError(t, err)
+	require.Equal(t, tgbotapi.Params{"chat_id": "76918703", "sender_chat_id": "-1001234"},
+		server.RequestsFor("banChatSenderChat")[0].Params)
+
+	_, err = bot.UnbanChatSenderChat(tgbotapi.UnbanChatSenderChatConfig{SuperGroupUsername: "@group", SenderChatID: -1001234})
+	require.NoError(t, err)
+	require.Equal(t, tgbotapi.Params{"chat_id": "@group", "sender_chat_id": "-1001234"},
+		server.RequestsFor("unbanChatSenderChat")[0].Params)
+}
+
+func TestChatJoinRequest(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	server.SendUpdate(tgbotapi.Update{ChatJoinRequest: &tgbotapi.ChatJoinRequest{
+		Chat:       tgbotapi.Chat{ID: ChatID},
+		From:       tgbotapi.User{ID: 42},
+		UserChatID: 42,
+		InviteLink: &tgbotapi.ChatInviteLink{InviteLink: "https://t.me/+abc", CreatesJoinRequest: true},
+	}})
+	updates, err := bot.GetUpdates(tgbotapi.NewUpdate(0))
+	require.NoError(t, err)
+	require.Len(t, updates, 1)
+
+	request := updates[0].ChatJoinRequest
+	require.NotNil(t, request)
+	require.True(t, request.InviteLink.CreatesJoinRequest)
+
+	_, err = bot.ApproveChatJoinRequest(tgbotapi.NewApproveChatJoinRequest(request.Chat.ID, request.From.ID))
+	require.NoError(t, err)
+	require.Equal(t, tgbotapi.Params{"chat_id": "76918703", "user_id": "42"},
+		server.RequestsFor("approveChatJoinRequest")[0].Params)
+
+	_, err = bot.DeclineChatJoinRequest(tgbotapi.NewDeclineChatJoinRequest(request.Chat.ID, request.From.ID))
+	require.NoError(t, err)
+	require.Equal(t, tgbotapi.Params{"chat_id": "76918703", "user_id": "42"},
+		server.RequestsFor("declineChatJoinRequest")[0].Params)
+}
+
+func TestChatInviteLinks(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	created := tgbotapi.ChatInviteLink{InviteLink: "https://t.me/+abc", Name: "promo", MemberLimit: 10}
+	require.NoError(t, server.Respond("createChatInviteLink", created))
+	link, err := bot.CreateChatInviteLink(tgbotapi.CreateChatInviteLinkConfig{
+		ChatConfig:  tgbotapi.ChatConfig{ChatID: ChatID},
+		Name:        "promo",
+		ExpireDate:  1700000000,
+		MemberLimit: 10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, created, link)
+	require.Equal(t, tgbotapi.Params{
+		"chat_id":      "76918703",
+		"name":         "promo",
+		"expire_date":  "1700000000",
+		"member_limit": "10",
+	}, server.RequestsFor("createChatInviteLink")[0].Params)
+
+	require.NoError(t, server.Respond("editChatInviteLink", created))
+	_, err = bot.EditChatInviteLink(tgbotapi.EditChatInviteLinkConfig{
+		ChatConfig:         tgbotapi.ChatConfig{ChatID: ChatID},
+		InviteLink:         created.InviteLink,
+		CreatesJoinRequest: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, tgbotapi.Params{
+		"chat_id":              "76918703",
+		"invite_link":          "https://t.me/+abc",
+		"creates_join_request": "true",
+	}, server.RequestsFor("editChatInviteLink")[0].Params)
+
+	require.NoError(t, server.Respond("createChatSubscriptionInviteLink", tgbotapi.ChatInviteLink{SubscriptionPrice: 50}))
+	link, err = bot.CreateChatSubscriptionInviteLink(tgbotapi.CreateChatSubscriptionInviteLinkConfig{
+		ChatConfig:         tgbotapi.ChatConfig{SuperGroupUsername: "@channel"},
+		SubscriptionPeriod: 2592000,
+		SubscriptionPrice:  50,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 50, link.SubscriptionPrice)
+	require.Equal(t, tgbotapi.Params{
+		"chat_id":             "@channel",
+		"subscription_period": "2592000",
+		"subscription_price":  "50",
+	}, server.RequestsFor("createChatSubscriptionInviteLink")[0].Params)
+
+	require.NoError(t, server.Respond("revokeChatInviteLink", tgbotapi.ChatInviteLink{InviteLink: created.InviteLink, IsRevoked: true}))
+	link, err = bot.RevokeChatInviteLink(tgbotapi.RevokeChatInviteLinkConfig{
+		ChatConfig: tgbotapi.ChatConfig{ChatID: ChatID},
+		InviteLink: created.InviteLink,
+	})
+	require.NoError(t, err)
+	require.True(t, link.IsRevoked)
+}
+
+func TestUnbanAndUnpinAll(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	member := tgbotapi.ChatMemberConfig{ChatID: ChatID, UserID: 42}
+	_, err := bot.UnbanChatMemberWithConfig(tgbotapi.UnbanChatMemberConfig{ChatMemberConfig: member, OnlyIfBanned: true})
+	require.NoError(t, err)
+	require.Equal(t, tgbotapi.Params{"chat_id": "76918703", "user_id": "42", "only_if_banned": "true"},
+		server.RequestsFor("unbanChatMember")[0].Params)
+
+	_, err = bot.UnbanChatMember(member)
+	require.NoError(t, err)
+	require.Equal(t, tgbotapi.Params{"chat_id": "76918703", "user_id": "42"},
+		server.RequestsFor("unbanChatMember")[1].Params)
+
+	_, err = bot.UnpinChatMessage(tgbotapi.UnpinChatMessageConfig{ChatID: ChatID, MessageID: 7})
+	require.NoError(t, err)
+	require.Equal(t, tgbotapi.Params{"chat_id": "76918703", "message_id": "7"},
+		server.RequestsFor("unpinChatMessage")[0].Params)
+
+	_, err = bot.UnpinAllChatMessages(tgbotapi.UnpinAllChatMessagesConfig{ChatID: ChatID})
+	require.NoError(t, err)
+	require.Equal(t, tgbotapi.Params{"chat_id": "76918703"}, server.RequestsFor("unpinAllChatMessages")[0].Params)
+}
+
+func TestPromoteChatMember(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	yes, no := true, false
+	_, err := bot.PromoteChatMember(tgbotapi.PromoteChatMemberConfig{
+		ChatMemberConfig: tgbotapi.ChatMemberConfig{ChatID: ChatID, UserID: 42},
+		CanManageChat:    &yes,
+		CanManageTopics:  &yes,
+		CanPostStories:   &no,
+	})
+	require.NoError(t, err)
+	require.Equal(t, tgbotapi.Params{
+		"chat_id":           "76918703",
+		"user_id":           "42",
+		"can_manage_chat":   "true",
+		"can_manage_topics": "true",
+		"can_post_stories":  "false",
+	}, server.RequestsFor("promoteChatMember")[0].Params)
+}
+
+func TestForumTopics(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	forum := tgbotapi.ChatConfig{ChatID: ChatID}
+
+	require.NoError(t, server.Respond("createForumTopic", tgbotapi.ForumTopic{MessageThreadID: 5, Name: "News", IconColor: tgbotapi.ForumTopicIconColorBlue}))
+	topic, err := bot.CreateForumTopic(tgbotapi.CreateForumTopicConfig{
+		ChatConfig: forum,
+		Name:       "News",
+		IconColor:  tgbotapi.ForumTopicIconColorBlue,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 5, topic.MessageThreadID)
+	require.Equal(t, tgbotapi.Params{"chat_id": "76918703", "name": "News", "icon_color": "7322096"},
+		server.RequestsFor("createForumTopic")[0].Params)
+
+	noIcon := ""
+	_, err = bot.EditForumTopic(tgbotapi.EditForumTopicConfig{
+		BaseForumTopic:    tgbotapi.BaseForumTopic{ChatConfig: forum, MessageThreadID: 5},
+		IconCustomEmojiID: &noIcon,
+	})
+	require.NoError(t, err)
+	require.Equal(t, tgbotapi.Params{"chat_id": "76918703", "message_thread_id": "5", "icon_custom_emoji_id": ""},
+		server.RequestsFor("editForumTopic")[0].Params)
+
+	_, err = bot.CloseForumTopic(tgbotapi.CloseForumTopicConfig{BaseForumTopic: tgbotapi.BaseForumTopic{ChatConfig: forum, MessageThreadID: 5}})
+	require.NoError(t, err)
+	require.Equal(t, tgbotapi.Params{"chat_id": "76918703", "message_thread_id": "5"},
+		server.RequestsFor("closeForumTopic")[0].Params)
+
+	_, err = bot.HideGeneralForumTopic(tgbotapi.HideGeneralForumTopicConfig{ChatConfig: forum})
+	require.NoError(t, err)
+	require.Equal(t, tgbotapi.Params{"chat_id": "76918703"}, server.RequestsFor("hideGeneralForumTopic")[0].Params)
+
+	require.NoError(t, server.Respond("getForumTopicIconStickers", []tgbotapi.Sticker{{FileID: "sticker"}}))
+	stickers, err := bot.GetForumTopicIconStickers()
+	require.NoError(t, err)
+	require.Len(t, stickers, 1)
+}
+
+func TestMessageThreadID(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	require.NoError(t, server.Respond("sendMessage", tgbotapi.Message{MessageID: 1, MessageThreadID: 42, IsTopicMessage: true}))
+	msg := tgbotapi.NewMessage(ChatID, "in a topic")
+	msg.MessageThreadID = 42
+	sent, err := bot.Send(msg)
+	require.NoError(t, err)
+	require.True(t, sent.IsTopicMessage)
+	require.Equal(t, 42, sent.MessageThreadID)
+	require.Equal(t, "42", server.RequestsFor("sendMessage")[0].Params["message_thread_id"])
+
+	photo := tgbotapi.NewPhotoShare(ChatID, "photo")
+	photo.MessageThreadID = 42
+	_, err = bot.Send(photo)
+	require.NoError(t, err)
+	require.Equal(t, "42", server.RequestsFor("sendPhoto")[0].Params["message_thread_id"])
+
+	require.NoError(t, server.Respond("forwardMessages", []tgbotapi.MessageID{{MessageID: 2}}))
+	forward := tgbotapi.NewForwardMessages(ChatID, ChatID, []int{1})
+	forward.MessageThreadID = 42
+	_, err = bot.ForwardMessages(forward)
+	require.NoError(t, err)
+	require.Equal(t, "42", server.RequestsFor("forwardMessages")[0].Params["message_thread_id"])
+
+	_, err = bot.Send(tgbotapi.NewMessage(ChatID, "in the chat"))
+	require.NoError(t, err)
+	require.NotContains(t, server.RequestsFor("sendMessage")[1].Params, "message_thread_id")
+}
+
+func TestSetMessageReaction(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	config := tgbotapi.NewSetMessageReaction(ChatID, 5, tgbotapi.NewReactionEmoji("👍"), tgbotapi.NewReactionCustomEmoji("42"))
+	config.IsBig = true
+	_, err := bot.SetMessageReaction(config)
+	require.NoError(t, err)
+
+	params := server.RequestsFor("setMessageReaction")[0].Params
+	require.Equal(t, "5", params["message_id"])
+	require.Equal(t, "true", params["is_big"])
+	require.JSONEq(t, `[{"type":"emoji","emoji":"👍"},{"type":"custom_emoji","custom_emoji_id":"42"}]`, params["reaction"])
+
+	_, err = bot.SetMessageReaction(tgbotapi.NewSetMessageReaction(ChatID, 5))
+	require.NoError(t, err)
+	require.NotContains(t, server.RequestsFor("setMessageReaction")[1].Params, "reaction")
+
+	var update tgbotapi.Update
+	err = json.Unmarshal([]byte(`{"update_id":1,"message_reaction_count":{"chat":{"id":1},"message_id":5,"date":1,"reactions":[{"type":{"type":"paid"},"total_count":3}]}}`), &update)
+	require.NoError(t, err)
+	require.Equal(t, []tgbotapi.ReactionCount{{Type: tgbotapi.NewReactionPaid(), TotalCount: 3}}, update.MessageReactionCount.Reactions)
+}
+
+func TestGetUserChatBoosts(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	boost := tgbotapi.ChatBoost{
+		BoostID: "boost",
+		AddDate: 1,
+		Source:  tgbotapi.ChatBoostSource{Source: tgbotapi.ChatBoostSourcePremium, User: &tgbotapi.User{ID: 7}},
+	}
+	require.NoError(t, server.Respond("getUserChatBoosts", tgbotapi.UserChatBoosts{Boosts: []tgbotapi.ChatBoost{boost}}))
+
+	boosts, err := bot.GetUserChatBoosts(tgbotapi.NewGetUserChatBoosts(ChatID, 7))
+	require.NoError(t, err)
+	require.Equal(t, []tgbotapi.ChatBoost{boost}, boosts.Boosts)
+
+	params := server.RequestsFor("getUserChatBoosts")[0].Params
+	require.Equal(t, "76918703", params["chat_id"])
+	require.Equal(t, "7", params["user_id"])
+}
+
+func TestBusinessConnection(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	connection := tgbotapi.BusinessConnection{ID: "connection", User: tgbotapi.User{ID: 7}, UserChatID: 7, IsEnabled: true}
+	require.NoError(t, server.Respond("getBusinessConnection", connection))
+
+	got, err := bot.GetBusinessConnection(tgbotapi.GetBusinessConnectionConfig{BusinessConnectionID: "connection"})
+	require.NoError(t, err)
+	require.Equal(t, connection, got)
+	require.Equal(t, "connection", server.RequestsFor("getBusinessConnection")[0].Params["business_connection_id"])
+
+	msg := tgbotapi.NewMessage(ChatID, "on behalf")
+	msg.BusinessConnectionID = "connection"
+	_, err = bot.Send(msg)
+	require.NoError(t, err)
+	require.Equal(t, "connection", server.RequestsFor("sendMessage")[0].Params["business_connection_id"])
+
+	require.NoError(t, server.Respond("editMessageText", tgbotapi.Message{MessageID: 1}))
+	edit := tgbotapi.NewEditMessageText(ChatID, 1, "edited")
+	edit.BusinessConnectionID = "connection"
+	_, err = bot.Send(edit)
+	require.NoError(t, err)
+	require.Equal(t, "connection", server.RequestsFor("editMessageText")[0].Params["business_connection_id"])
+}
+
+func TestBusinessAccountManagement(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	business := tgbotapi.BaseBusiness{BusinessConnectionID: "connection"}
+
+	_, err := bot.ReadBusinessMessage(tgbotapi.ReadBusinessMessageConfig{BaseBusiness: business, ChatID: ChatID, MessageID: 5})
+	require.NoError(t, err)
+	params := server.RequestsFor("readBusinessMessage")[0].Params
+	require.Equal(t, "connection", params["business_connection_id"])
+	require.Equal(t, "76918703", params["chat_id"])
+	require.Equal(t, "5", params["message_id"])
+
+	_, err = bot.DeleteBusinessMessages(tgbotapi.DeleteBusinessMessagesConfig{BaseBusiness: business, MessageIDs: []int{1, 2}})
+	require.NoError(t, err)
+	require.Equal(t, "[1,2]", server.RequestsFor("deleteBusinessMessages")[0].Params["message_ids"])
+
+	_, err = bot.SetBusinessAccountName(tgbotapi.SetBusinessAccountNameConfig{BaseBusiness: business, FirstName: "Shop"})
+	require.NoError(t, err)
+	require.Equal(t, "Shop", server.RequestsFor("setBusinessAccountName")[0].Params["first_name"])
+
+	photo := tgbotapi.NewInputProfilePhotoStatic(tgbotapi.FileBytes{Name: "photo.jpg", Bytes: []byte("photo")})
+	_, err = bot.SetBusinessAccountProfilePhoto(tgbotapi.SetBusinessAccountProfilePhotoConfig{BaseBusiness: business, Photo: photo, IsPublic: true})
+	require.NoError(t, err)
+	req := server.RequestsFor("setBusinessAccountProfilePhoto")[0]
+	require.JSONEq(t, `{"type":"static","photo":"attach://photo-file"}`, req.Params["photo"])
+	require.Equal(t, "true", req.Params["is_public"])
+	require.Equal(t, []byte("photo"), req.Files["photo-file"].Data)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = bot.SetBusinessAccountProfilePhotoWithContext(ctx, tgbotapi.SetBusinessAccountProfilePhotoConfig{BaseBusiness: business, Photo: photo})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Len(t, server.RequestsFor("setBusinessAccountProfilePhoto"), 1)
+
+	_, err = bot.SetBusinessAccountGiftSettings(tgbotapi.SetBusinessAccountGiftSettingsConfig{BaseBusiness: business})
+	require.NoError(t, err)
+	params = server.RequestsFor("setBusinessAccountGiftSettings")[0].Params
+	require.Equal(t, "false", params["show_gift_button"])
+	require.JSONEq(t, `{"unlimited_gifts":false,"limited_gifts":false,"unique_gifts":false,"premium_subscription":false}`, params["accepted_gift_types"])
+
+	require.NoError(t, server.Respond("getBusinessAccountStarBalance", tgbotapi.StarAmount{Amount: 100}))
+	balance, err := bot.GetBusinessAccountStarBalance(tgbotapi.GetBusinessAccountStarBalanceConfig{BaseBusiness: business})
+	require.NoError(t, err)
+	require.Equal(t, 100, balance.Amount)
+
+	gifts := tgbotapi.OwnedGifts{TotalCount: 2, Gifts: []tgbotapi.OwnedGift{
+		{Type: tgbotapi.OwnedGiftRegular, OwnedGiftID: "regular", Gift: &tgbotapi.Gift{ID: "gift", StarCount: 15}},
+		{Type: tgbotapi.OwnedGiftUnique, OwnedGiftID: "unique", UniqueGift: &tgbotapi.UniqueGift{Name: "Gift-1", Number: 1}},
+	}}
+	require.NoError(t, server.Respond("getBusinessAccountGifts", gifts))
+	got, err := bot.GetBusinessAccountGifts(tgbotapi.GetBusinessAccountGiftsConfig{BaseBusiness: business, ExcludeSaved: true, Limit: 10})
+	require.NoError(t, err)
+	require.Equal(t, gifts, got)
+	params = server.RequestsFor("getBusinessAccountGifts")[0].Params
+	require.Equal(t, "true", params["exclude_saved"])
+	require.Equal(t, "10", params["limit"])
+
+	_, err = bot.TransferGift(tgbotapi.TransferGiftConfig{BaseBusiness: business, OwnedGiftID: "unique", NewOwnerChatID: ChatID, StarCount: 25})
+	require.NoError(t, err)
+	params = server.RequestsFor("transferGift")[0].Params
+	require.Equal(t, "unique", params["owned_gift_id"])
+	require.Equal(t, "76918703", params["new_owner_chat_id"])
+	require.Equal(t, "25", params["star_count"])
+}
+
+func TestStarPayments(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	_, err := bot.Send(tgbotapi.NewStarsInvoice(ChatID, "Sticker pack", "Premium stickers", "pack-1", 50))
+	require.NoError(t, err)
+	params := server.RequestsFor("sendInvoice")[0].Params
+	require.Equal(t, "XTR", params["currency"])
+	require.NotContains(t, params, "provider_token")
+	require.JSONEq(t, `[{"label":"Sticker pack","amount":50}]`, params["prices"])
+
+	_, err = bot.RefundStarPayment(tgbotapi.RefundStarPaymentConfig{UserID: 7, TelegramPaymentChargeID: "charge"})
+	require.NoError(t, err)
+	params = server.RequestsFor("refundStarPayment")[0].Params
+	require.Equal(t, "7", params["user_id"])
+	require.Equal(t, "charge", params["telegram_payment_charge_id"])
+
+	transactions := tgbotapi.StarTransactions{Transactions: []tgbotapi.StarTransaction{{
+		ID:     "charge",
+		Amount: 50,
+		Source: &tgbotapi.TransactionPartner{
+			Type:            tgbotapi.TransactionPartnerUser,
+			TransactionType: "invoice_payment",
+			User:            &tgbotapi.User{ID: 7},
+			InvoicePayload:  "pack-1",
+		},
+	}}}
+	require.NoError(t, server.Respond("getStarTransactions", transactions))
+	got, err := bot.GetStarTransactions(tgbotapi.GetStarTransactionsConfig{Offset: 100, Limit: 50})
+	require.NoError(t, err)
+	require.Equal(t, transactions, got)
+	params = server.RequestsFor("getStarTransactions")[0].Params
+	require.Equal(t, "100", params["offset"])
+	require.Equal(t, "50", params["limit"])
+
+	require.NoError(t, server.Respond("getMyStarBalance", tgbotapi.StarAmount{Amount: 50}))
+	balance, err := bot.GetMyStarBalance()
+	require.NoError(t, err)
+	require.Equal(t, 50, balance.Amount)
+}
+
+func TestStarSubscriptions(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	require.NoError(t, server.Respond("createInvoiceLink", "https://t.me/$invoice"))
+	link, err := bot.CreateInvoiceLink(tgbotapi.NewStarsSubscriptionLink("Club", "Monthly access", "club", 100))
+	require.NoError(t, err)
+	require.Equal(t, "https://t.me/$invoice", link)
+
+	params := server.RequestsFor("createInvoiceLink")[0].Params
+	require.Equal(t, "XTR", params["currency"])
+	require.Equal(t, "2592000", params["subscription_period"])
+	require.NotContains(t, params, "provider_token")
+
+	_, err = bot.EditUserStarSubscription(tgbotapi.EditUserStarSubscriptionConfig{UserID: 7, TelegramPaymentChargeID: "charge"})
+	require.NoError(t, err)
+	params = server.RequestsFor("editUserStarSubscription")[0].Params
+	require.Equal(t, "7", params["user_id"])
+	require.Equal(t, "false", params["is_canceled"])
+
+	var payment tgbotapi.SuccessfulPayment
+	err = json.Unmarshal([]byte(`{"currency":"XTR","total_amount":100,"is_recurring":true,"is_first_recurring":true,"subscription_expiration_date":1700000000}`), &payment)
+	require.NoError(t, err)
+	require.True(t, payment.IsRecurring)
+	require.True(t, payment.IsFirstRecurring)
+	require.Equal(t, 1700000000, payment.SubscriptionExpirationDate)
+}
+
+func TestSendInvoice(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	prices := []tgbotapi.LabeledPrice{{Label: "Pizza", Amount: 1200}, {Label: "Delivery", Amount: 300}}
+	config := tgbotapi.NewInvoice(ChatID, "Pizza", "Margherita", "order-1", "provider", "", "EUR", &prices)
+	config.MaxTipAmount = 500
+	config.SuggestedTipAmounts = []int{100, 200}
+	config.ProviderData = `{"receipt":true}`
+	config.NeedShippingAddress = true
+	config.SendEmailToProvider = true
+	config.IsFlexible = true
+	config.ProtectContent = true
+	_, err := bot.Send(config)
+	require.NoError(t, err)
+
+	params := server.RequestsFor("sendInvoice")[0].Params
+	require.Equal(t, "provider", params["provider_token"])
+	require.NotContains(t, params, "start_parameter")
+	require.Equal(t, "500", params["max_tip_amount"])
+	require.Equal(t, "[100,200]", params["suggested_tip_amounts"])
+	require.Equal(t, `{"receipt":true}`, params["provider_data"])
+	require.Equal(t, "true", params["need_shipping_address"])
+	require.Equal(t, "true", params["send_email_to_provider"])
+	require.Equal(t, "true", params["is_flexible"])
+	require.Equal(t, "true", params["protect_content"])
+}
+
+func TestSendPaidMedia(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	info := &tgbotapi.PaidMediaInfo{StarCount: 25, PaidMedia: []tgbotapi.PaidMedia{{Type: "preview", Width: 640}}}
+	require.NoError(t, server.Respond("sendPaidMedia", tgbotapi.Message{MessageID: 1, PaidMedia: info}))
+
+	config := tgbotapi.NewPaidMedia(ChatID, 25,
+		tgbotapi.NewInputPaidMediaPhoto("photo"),
+		tgbotapi.NewInputPaidMediaVideoUpload(tgbotapi.FileBytes{Name: "video.mp4", Bytes: []byte("video")}),
+	)
+	config.Payload = "post-1"
+	config.Caption = "Behind the scenes"
+	msg, err := bot.Send(config)
+	require.NoError(t, err)
+	require.Equal(t, info, msg.PaidMedia)
+
+	req := server.RequestsFor("sendPaidMedia")[0]
+	require.Equal(t, "25", req.Params["star_count"])
+	require.Equal(t, "post-1", req.Params["payload"])
+	require.JSONEq(t, `[{"type":"photo","media":"photo"},{"type":"video","media":"attach://file-1"}]`, req.Params["media"])
+	require.Equal(t, []byte("video"), req.Files["file-1"].Data)
+
+	_, err = bot.Send(tgbotapi.NewPaidMedia(ChatID, 25))
+	require.EqualError(t, err, tgbotapi.ErrBadPaidMedia)
+}
+
+func TestGifts(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	gifts := tgbotapi.Gifts{Gifts: []tgbotapi.Gift{{ID: "gift", StarCount: 15, TotalCount: 1000, RemainingCount: 10}}}
+	require.NoError(t, server.Respond("getAvailableGifts", gifts))
+	got, err := bot.GetAvailableGifts()
+	require.NoError(t, err)
+	require.Equal(t, gifts, got)
+
+	config := tgbotapi.NewSendGift(7, "gift")
+	config.PayForUpgrade = true
+	config.Text = "Thanks!"
+	_, err = bot.SendGift(config)
+	require.NoError(t, err)
+	params := server.RequestsFor("sendGift")[0].Params
+	require.Equal(t, "7", params["user_id"])
+	require.NotContains(t, params, "chat_id")
+	require.Equal(t, "gift", params["gift_id"])
+	require.Equal(t, "true", params["pay_for_upgrade"])
+	require.Equal(t, "Thanks!", params["text"])
+
+	_, err = bot.SendGift(tgbotapi.NewSendGiftToChat(ChatID, "gift"))
+	require.NoError(t, err)
+	params = server.RequestsFor("sendGift")[1].Params
+	require.Equal(t, "76918703", params["chat_id"])
+	require.NotContains(t, params, "user_id")
+
+	_, err = bot.GiftPremiumSubscription(tgbotapi.GiftPremiumSubscriptionConfig{UserID: 7, MonthCount: 3, StarCount: 1000})
+	require.NoError(t, err)
+	params = server.RequestsFor("giftPremiumSubscription")[0].Params
+	require.Equal(t, "3", params["month_count"])
+	require.Equal(t, "1000", params["star_count"])
+}
+
+func TestChecklist(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	checklist := tgbotapi.NewInputChecklist("Groceries",
+		tgbotapi.NewInputChecklistTask(1, "Milk"),
+		tgbotapi.NewInputChecklistTask(2, "Bread"),
+	)
+	checklist.OthersCanMarkTasksAsDone = true
+
+	sent := &tgbotapi.Checklist{Title: "Groceries", Tasks: []tgbotapi.ChecklistTask{{ID: 1, Text: "Milk"}, {ID: 2, Text: "Bread"}}}
+	require.NoError(t, server.Respond("sendChecklist", tgbotapi.Message{MessageID: 1, Checklist: sent}))
+	msg, err := bot.Send(tgbotapi.NewChecklist("connection", ChatID, checklist))
+	require.NoError(t, err)
+	require.Equal(t, sent, msg.Checklist)
+
+	params := server.RequestsFor("sendChecklist")[0].Params
+	require.Equal(t, "connection", params["business_connection_id"])
+	require.JSONEq(t, `{"title":"Groceries","tasks":[{"id":1,"text":"Milk"},{"id":2,"text":"Bread"}],"others_can_mark_tasks_as_done":true}`, params["checklist"])
+
+	checklist.Tasks = append(checklist.Tasks, tgbotapi.NewInputChecklistTask(3, "Eggs"))
+	require.NoError(t, server.Respond("editMessageChecklist", tgbotapi.Message{MessageID: 1}))
+	_, err = bot.Send(tgbotapi.NewEditMessageChecklist("connection", ChatID, 1, checklist))
+	require.NoError(t, err)
+
+	params = server.RequestsFor("editMessageChecklist")[0].Params
+	require.Equal(t, "connection", params["business_connection_id"])
+	require.Equal(t, "1", params["message_id"])
+	require.Contains(t, params["checklist"], `"Eggs"`)
+}
+
+func TestVerification(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	_, err := bot.VerifyUser(tgbotapi.VerifyUserConfig{UserID: 7, CustomDescription: "Employee"})
+	require.NoError(t, err)
+	params := server.RequestsFor("verifyUser")[0].Params
+	require.Equal(t, "7", params["user_id"])
+	require.Equal(t, "Employee", params["custom_description"])
+
+	_, err = bot.VerifyChat(tgbotapi.VerifyChatConfig{ChannelUsername: "@channel"})
+	require.NoError(t, err)
+	params = server.RequestsFor("verifyChat")[0].Params
+	require.Equal(t, "@channel", params["chat_id"])
+	require.NotContains(t, params, "custom_description")
+
+	_, err = bot.RemoveUserVerification(tgbotapi.RemoveUserVerificationConfig{UserID: 7})
+	require.NoError(t, err)
+	require.Equal(t, "7", server.RequestsFor("removeUserVerification")[0].Params["user_id"])
+
+	_, err = bot.RemoveChatVerification(tgbotapi.RemoveChatVerificationConfig{ChatID: ChatID})
+	require.NoError(t, err)
+	require.Equal(t, "76918703", server.RequestsFor("removeChatVerification")[0].Params["chat_id"])
+}
+
+func TestSetUserEmojiStatus(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	config := tgbotapi.NewSetUserEmojiStatus(7, "5368324170671202286")
+	config.EmojiStatusExpirationDate = 1700000000
+	_, err := bot.SetUserEmojiStatus(config)
+	require.NoError(t, err)
+
+	params := server.RequestsFor("setUserEmojiStatus")[0].Params
+	require.Equal(t, "7", params["user_id"])
+	require.Equal(t, "5368324170671202286", params["emoji_status_custom_emoji_id"])
+	require.Equal(t, "1700000000", params["emoji_status_expiration_date"])
+
+	_, err = bot.SetUserEmojiStatus(tgbotapi.SetUserEmojiStatusConfig{UserID: 7})
+	require.NoError(t, err)
+	require.NotContains(t, server.RequestsFor("setUserEmojiStatus")[1].Params, "emoji_status_custom_emoji_id")
+}
+
+func TestSavePreparedInlineMessage(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	prepared := tgbotapi.PreparedInlineMessage{ID: "prepared", ExpirationDate: 1700000000}
+	require.NoError(t, server.Respond("savePreparedInlineMessage", prepared))
+
+	got, err := bot.SavePreparedInlineMessage(tgbotapi.SavePreparedInlineMessageConfig{
+		UserID:          7,
+		Result:          tgbotapi.NewInlineQueryResultArticle("article", "Score", "I scored 42!"),
+		AllowUserChats:  true,
+		AllowGroupChats: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, prepared, got)
+
+	params := server.RequestsFor("savePreparedInlineMessage")[0].Params
+	require.Equal(t, "7", params["user_id"])
+	require.Equal(t, "true", params["allow_user_chats"])
+	require.Equal(t, "true", params["allow_group_chats"])
+	require.NotContains(t, params, "allow_bot_chats")
+	require.Contains(t, params["result"], `"type":"article"`)
+}
+
+func TestLogOutAndClose(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	_, err := bot.LogOut()
+	require.NoError(t, err)
+	require.Len(t, server.RequestsFor("logOut"), 1)
+
+	server.RespondError("close", 429, "Too Many Requests: retry after 600")
+	_, err = bot.Close()
+	require.Error(t, err)
+	require.Len(t, server.RequestsFor("close"), 1)
+}
+
+func TestEditResult(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	require.NoError(t, server.Respond("editMessageText", tgbotapi.Message{MessageID: 1, Text: "edited"}))
+	result, err := bot.Edit(tgbotapi.NewEditMessageText(ChatID, 1, "edited"))
+	require.NoError(t, err)
+	require.True(t, result.OK)
+	require.Equal(t, "edited", result.Message.Text)
+
+	result, err = bot.Edit(tgbotapi.EditMessageTextConfig{BaseEdit: tgbotapi.BaseEdit{InlineMessageID: "inline"}, Text: "edited"})
+	require.NoError(t, err)
+	require.True(t, result.OK)
+	require.Nil(t, result.Message)
+	require.Equal(t, "inline", server.RequestsFor("editMessageText")[1].Params["inline_message_id"])
+
+	result, err = bot.SetGameScore(tgbotapi.SetGameScoreConfig{UserID: 7, Score: 0, Force: true, InlineMessageID: "inline"})
+	require.NoError(t, err)
+	require.True(t, result.OK)
+
+	params := server.RequestsFor("setGameScore")[0].Params
+	require.Equal(t, "0", params["score"])
+	require.Equal(t, "true", params["force"])
+	require.Equal(t, "inline", params["inline_message_id"])
+}
+
+func TestSetPassportDataErrors(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	_, err := bot.SetPassportDataErrors(tgbotapi.SetPassportDataErrorsConfig{
+		UserID: 7,
+		Errors: []tgbotapi.PassportElementError{
+			tgbotapi.PassportElementErrorDataField{Source: "data", Type: "personal_details", FieldName: "first_name", DataHash: "hash", Message: "Invalid name"},
+			tgbotapi.PassportElementErrorUnspecified{Source: "unspecified", Type: "passport", ElementHash: "hash", Message: "Expired"},
+		},
+	})
+	require.NoError(t, err)
+
+	params := server.RequestsFor("setPassportDataErrors")[0].Params
+	require.Equal(t, "7", params["user_id"])
+	require.JSONEq(t, `[
+		{"source":"data","type":"personal_details","field_name":"first_name","data_hash":"hash","message":"Invalid name"},
+		{"source":"unspecified","type":"passport","element_hash":"hash","message":"Expired"}
+	]`, params["errors"])
+}
+
+func TestStickerSets(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	require.NoError(t, server.Respond("uploadStickerFile", tgbotapi.File{FileID: "uploaded"}))
+	file, err := bot.UploadStickerFile(tgbotapi.UploadStickerFileConfig{
+		UserID:        7,
+		Sticker:       tgbotapi.FileBytes{Name: "sticker.png", Bytes: []byte("png")},
+		StickerFormat: tgbotapi.StickerFormatStatic,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "uploaded", file.FileID)
+	req := server.RequestsFor("uploadStickerFile")[0]
+	require.Equal(t, "static", req.Params["sticker_format"])
+	require.Equal(t, []byte("png"), req.Files["sticker"].Data)
+
+	mask := tgbotapi.NewInputStickerUpload(tgbotapi.FileBytes{Name: "mask.webp", Bytes: []byte("mask")}, tgbotapi.StickerFormatStatic, "🎭")
+	mask.MaskPosition = &tgbotapi.MaskPosition{Point: "eyes", Scale: 1}
+	config := tgbotapi.NewCreateNewStickerSet(7, "masks_by_bot", "Masks",
+		tgbotapi.NewInputSticker("uploaded", tgbotapi.StickerFormatStatic, "😀"),
+		mask,
+	)
+	config.StickerType = tgbotapi.StickerTypeMask
+	_, err = bot.CreateNewStickerSet(config)
+	require.NoError(t, err)
+	req = server.RequestsFor("createNewStickerSet")[0]
+	require.Equal(t, "mask", req.Params["sticker_type"])
+	require.JSONEq(t, `[
+		{"sticker":"uploaded","format":"static","emoji_list":["😀"]},
+		{"sticker":"attach://file-1","format":"static","emoji_list":["🎭"],"mask_position":{"point":"eyes","x_shift":0,"y_shift":0,"scale":1}}
+	]`, req.Params["stickers"])
+	require.Equal(t, []byte("mask"), req.Files["file-1"].Data)
+
+	sticker := tgbotapi.NewInputSticker("new", tgbotapi.StickerFormatVideo, "🎬")
+	sticker.Keywords = []string{"movie"}
+	_, err = bot.AddStickerToSet(tgbotapi.AddStickerToSetConfig{UserID: 7, Name: "masks_by_bot", Sticker: sticker})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"sticker":"new","format":"video","emoji_list":["🎬"],"keywords":["movie"]}`,
+		server.RequestsFor("addStickerToSet")[0].Params["sticker"])
+
+	_, err = bot.ReplaceStickerInSet(tgbotapi.ReplaceStickerInSetConfig{UserID: 7, Name: "masks_by_bot", OldSticker: "old", Sticker: sticker})
+	require.NoError(t, err)
+	require.Equal(t, "old", server.RequestsFor("replaceStickerInSet")[0].Params["old_sticker"])
+
+	_, err = bot.SetStickerPositionInSet(tgbotapi.SetStickerPositionInSetConfig{Sticker: "new"})
+	require.NoError(t, err)
+	require.Equal(t, "0", server.RequestsFor("setStickerPositionInSet")[0].Params["position"])
+
+	_, err = bot.DeleteStickerFromSet(tgbotapi.DeleteStickerFromSetConfig{Sticker: "new"})
+	require.NoError(t, err)
+	_, err = bot.SetStickerSetTitle(tgbotapi.SetStickerSetTitleConfig{Name: "masks_by_bot", Title: "Faces"})
+	require.NoError(t, err)
+	require.Equal(t, "Faces", server.RequestsFor("setStickerSetTitle")[0].Params["title"])
+
+	thumbnail := tgbotapi.SetStickerSetThumbnailConfig{
+		Name:      "masks_by_bot",
+		UserID:    7,
+		Thumbnail: tgbotapi.FileBytes{Name: "thumb.webp", Bytes: []byte("thumb")},
+		Format:    tgbotapi.StickerFormatStatic,
+	}
+	_, err = bot.SetStickerSetThumbnail(thumbnail)
+	require.NoError(t, err)
+	require.Equal(t, []byte("thumb"), server.RequestsFor("setStickerSetThumbnail")[0].Files["thumbnail"].Data)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = bot.SetStickerSetThumbnailWithContext(ctx, thumbnail)
+	require.ErrorIs(t, err, context.Canceled)
+	_, err = bot.UploadStickerFileWithContext(ctx, tgbotapi.UploadStickerFileConfig{
+		UserID:        7,
+		Sticker:       tgbotapi.FileBytes{Name: "sticker.webp", Bytes: []byte("sticker")},
+		StickerFormat: tgbotapi.StickerFormatStatic,
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Len(t, server.RequestsFor("setStickerSetThumbnail"), 1)
+	require.Len(t, server.RequestsFor("uploadStickerFile"), 1)
+
+	_, err = bot.DeleteStickerSet(tgbotapi.DeleteStickerSetConfig{Name: "masks_by_bot"})
+	require.NoError(t, err)
+	require.Equal(t, "masks_by_bot", server.RequestsFor("deleteStickerSet")[0].Params["name"])
+}
+
+func TestStickerMetadata(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	_, err := bot.SetStickerEmojiList(tgbotapi.NewSetStickerEmojiList("sticker", "😀", "😃"))
+	require.NoError(t, err)
+	require.JSONEq(t, `["😀","😃"]`, server.RequestsFor("setStickerEmojiList")[0].Params["emoji_list"])
+
+	_, err = bot.SetStickerKeywords(tgbotapi.NewSetStickerKeywords("sticker", "smile"))
+	require.NoError(t, err)
+	require.JSONEq(t, `["smile"]`, server.RequestsFor("setStickerKeywords")[0].Params["keywords"])
+
+	_, err = bot.SetStickerKeywords(tgbotapi.NewSetStickerKeywords("sticker"))
+	require.NoError(t, err)
+	require.NotContains(t, server.RequestsFor("setStickerKeywords")[1].Params, "keywords")
+
+	position := &tgbotapi.MaskPosition{Point: "forehead", XShift: -1, YShift: 0.5, Scale: 2}
+	_, err = bot.SetStickerMaskPosition(tgbotapi.NewSetStickerMaskPosition("sticker", position))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"point":"forehead","x_shift":-1,"y_shift":0.5,"scale":2}`,
+		server.RequestsFor("setStickerMaskPosition")[0].Params["mask_position"])
+
+	_, err = bot.SetStickerMaskPosition(tgbotapi.NewSetStickerMaskPosition("sticker", nil))
+	require.NoError(t, err)
+	require.NotContains(t, server.RequestsFor("setStickerMaskPosition")[1].Params, "mask_position")
+}
+
+func TestGetCustomEmojiStickers(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	stickers := []tgbotapi.Sticker{{FileID: "sticker", Emoji: "👍", SetName: "emoji_by_bot"}}
+	require.NoError(t, server.Respond("getCustomEmojiStickers", stickers))
+
+	got, err := bot.GetCustomEmojiStickers([]string{"5368324170671202286"})
+	require.NoError(t, err)
+	require.Equal(t, stickers, got)
+	require.JSONEq(t, `["5368324170671202286"]`, server.RequestsFor("getCustomEmojiStickers")[0].Params["custom_emoji_ids"])
+}
+
+func TestWebApp(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	require.NoError(t, server.Respond("answerWebAppQuery", tgbotapi.SentWebAppMessage{InlineMessageID: "inline"}))
+	sent, err := bot.AnswerWebAppQuery(tgbotapi.NewAnswerWebAppQuery("query",
+		tgbotapi.NewInlineQueryResultArticle("article", "Order", "Order placed")))
+	require.NoError(t, err)
+	require.Equal(t, "inline", sent.InlineMessageID)
+
+	params := server.RequestsFor("answerWebAppQuery")[0].Params
+	require.Equal(t, "query", params["web_app_query_id"])
+	require.Contains(t, params["result"], `"type":"article"`)
+
+	msg := tgbotapi.NewMessage(ChatID, "Open the shop")
+	msg.ReplyMarkup = tgbotapi.NewReplyKeyboard(tgbotapi.NewKeyboardButtonRow(
+		tgbotapi.NewKeyboardButtonWebApp("Shop", "https://example.com/shop"),
+	))
+	_, err = bot.Send(msg)
+	require.NoError(t, err)
+	require.Contains(t, server.RequestsFor("sendMessage")[0].Params["reply_markup"], `"web_app":{"url":"https://example.com/shop"}`)
+
+	msg.ReplyMarkup = tgbotapi.NewInlineKeyboardMarkup(tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonWebApp("Shop", "https://example.com/shop"),
+	))
+	_, err = bot.Send(msg)
+	require.NoError(t, err)
+	require.Contains(t, server.RequestsFor("sendMessage")[1].Params["reply_markup"], `"web_app":{"url":"https://example.com/shop"}`)
+}
+
+func TestGetChatFullInfo(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	server.RespondWith("getChat", tgbotapi.APIResponse{Ok: true, Result: json.RawMessage(`{
+		"id":-1001,"type":"supergroup","title":"Shop","is_forum":true,
+		"accent_color_id":3,"max_reaction_count":11,
+		"available_reactions":[{"type":"emoji","emoji":"👍"}],
+		"permissions":{"can_send_messages":true},
+		"slow_mode_delay":30,"linked_chat_id":-1002,"has_protected_content":true,
+		"location":{"location":{"latitude":51.5,"longitude":-0.1},"address":"London"},
+		"accepted_gift_types":{"unlimited_gifts":true}
+	}`)})
+
+	chat, err := bot.GetChat(tgbotapi.ChatConfig{ChatID: -1001})
+	require.NoError(t, err)
+	require.Equal(t, "Shop", chat.Title)
+	require.True(t, chat.IsForum)
+	require.True(t, chat.Permissions.CanSendMessages)
+	require.Equal(t, 3, chat.AccentColorID)
+	require.Equal(t, 11, chat.MaxReactionCount)
+	require.Equal(t, []tgbotapi.ReactionType{tgbotapi.NewReactionEmoji("👍")}, chat.AvailableReactions)
+	require.Equal(t, 30, chat.SlowModeDelay)
+	require.Equal(t, int64(-1002), chat.LinkedChatID)
+	require.True(t, chat.HasProtectedContent)
+	require.Equal(t, "London", chat.Location.Address)
+	require.True(t, chat.AcceptedGiftTypes.UnlimitedGifts)
+	require.Equal(t, int64(-1001), chat.ChatConfig().ChatID)
+}
+
+func TestReplyParameters(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	msg := tgbotapi.NewMessage(ChatID, "Indeed")
+	msg.ReplyToMessageID = ReplyToMessageID
+	_, err := bot.Send(msg)
+	require.NoError(t, err)
+	params := server.RequestsFor("sendMessage")[0].Params
+	require.Equal(t, "35", params["reply_to_message_id"])
+	require.NotContains(t, params, "reply_parameters")
+
+	msg.ReplyParameters = tgbotapi.NewQuoteReply(ReplyToMessageID, "quoted")
+	msg.ReplyParameters.ChatID = -1001
+	msg.ReplyParameters.AllowSendingWithoutReply = true
+	_, err = bot.Send(msg)
+	require.NoError(t, err)
+	params = server.RequestsFor("sendMessage")[1].Params
+	require.NotContains(t, params, "reply_to_message_id")
+	require.JSONEq(t, `{"message_id":35,"chat_id":-1001,"allow_sending_without_reply":true,"quote":"quoted"}`, params["reply_parameters"])
+}
+
+func TestLinkPreviewOptions(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	msg := tgbotapi.NewMessage(ChatID, "See https://example.com")
+	_, err := bot.Send(msg)
+	require.NoError(t, err)
+	require.NotContains(t, server.RequestsFor("sendMessage")[0].Params, "link_preview_options")
+
+	msg.LinkPreviewOptions = tgbotapi.NewLinkPreview("https://example.org")
+	msg.LinkPreviewOptions.PreferLargeMedia = true
+	msg.LinkPreviewOptions.ShowAboveText = true
+	_, err = bot.Send(msg)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"url":"https://example.org","prefer_large_media":true,"show_above_text":true}`,
+		server.RequestsFor("sendMessage")[1].Params["link_preview_options"])
+
+	require.NoError(t, server.Respond("editMessageText", tgbotapi.Message{MessageID: 1}))
+	edit := tgbotapi.NewEditMessageText(ChatID, 1, "No preview")
+	edit.LinkPreviewOptions = tgbotapi.NewLinkPreviewDisabled()
+	_, err = bot.Send(edit)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"is_disabled":true}`, server.RequestsFor("editMessageText")[0].Params["link_preview_options"])
+}
+
+func TestProtectContent(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	group := tgbotapi.NewMediaGroup(ChatID, []interface{}{
+		tgbotapi.NewInputMediaPhoto("photo-1"),
+		tgbotapi.NewInputMediaPhoto("photo-2"),
+	})
+	group.ProtectContent = true
+	group.DisableNotification = true
+	_, err := bot.SendMediaGroup(group)
+	require.NoError(t, err)
+	params := server.RequestsFor("sendMediaGroup")[0].Params
+	require.Equal(t, "true", params["protect_content"])
+	require.Equal(t, "true", params["disable_notification"])
+
+	require.NoError(t, server.Respond("forwardMessages", []tgbotapi.MessageID{{MessageID: 1}}))
+	forward := tgbotapi.NewForwardMessages(ChatID, ChatID, []int{1})
+	forward.ProtectContent = true
+	_, err = bot.ForwardMessages(forward)
+	require.NoError(t, err)
+	require.Equal(t, "true", server.RequestsFor("forwardMessages")[0].Params["protect_content"])
+
+	require.NoError(t, server.Respond("copyMessages", []tgbotapi.MessageID{{MessageID: 1}}))
+	copies := tgbotapi.NewCopyMessages(ChatID, ChatID, []int{1})
+	copies.ProtectContent = true
+	_, err = bot.CopyMessages(copies)
+	require.NoError(t, err)
+	require.Equal(t, "true", server.RequestsFor("copyMessages")[0].Params["protect_content"])
+
+	_, err = bot.Send(tgbotapi.NewMessage(ChatID, "Unprotected"))
+	require.NoError(t, err)
+	require.NotContains(t, server.RequestsFor("sendMessage")[0].Params, "protect_content")
+}
+
+func TestMessageEffect(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	msg := tgbotapi.NewMessage(ChatID, "Congratulations!")
+	msg.MessageEffectID = tgbotapi.EffectConfetti
+	_, err := bot.Send(msg)
+	require.NoError(t, err)
+	require.Equal(t, tgbotapi.EffectConfetti, server.RequestsFor("sendMessage")[0].Params["message_effect_id"])
+
+	photo := tgbotapi.NewPhotoShare(ChatID, "photo")
+	photo.MessageEffectID = tgbotapi.EffectFire
+	_, err = bot.Send(photo)
+	require.NoError(t, err)
+	require.Equal(t, tgbotapi.EffectFire, server.RequestsFor("sendPhoto")[0].Params["message_effect_id"])
+}
+
+func TestReplyKeyboardRequests(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	users := tgbotapi.NewKeyboardButtonRequestUsers("Pick friends", 1)
+	users.RequestUsers.MaxQuantity = 3
+	keyboard := tgbotapi.NewReplyKeyboard(tgbotapi.NewKeyboardButtonRow(
+		users,
+		tgbotapi.NewKeyboardButtonRequestChat("Pick channel", 2, true),
+	))
+	keyboard.IsPersistent = true
+	keyboard.InputFieldPlaceholder = "Choose"
+
+	msg := tgbotapi.NewMessage(ChatID, "Share")
+	msg.ReplyMarkup = keyboard
+	_, err := bot.Send(msg)
+	require.NoError(t, err)
+
+	var sent struct {
+		Keyboard              [][]map[string]json.RawMessage `json:"keyboard"`
+		IsPersistent          bool                           `json:"is_persistent"`
+		InputFieldPlaceholder string                         `json:"input_field_placeholder"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(server.RequestsFor("sendMessage")[0].Params["reply_markup"]), &sent))
+	require.True(t, sent.IsPersistent)
+	require.Equal(t, "Choose", sent.InputFieldPlaceholder)
+	require.JSONEq(t, `{"request_id":1,"max_quantity":3}`, string(sent.Keyboard[0][0]["request_users"]))
+	require.JSONEq(t, `{"request_id":2,"chat_is_channel":true}`, string(sent.Keyboard[0][1]["request_chat"]))
+}
+
+func TestInlineKeyboardButtons(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	msg := tgbotapi.NewMessage(ChatID, "Buttons")
+	msg.ReplyMarkup = tgbotapi.NewInlineKeyboardMarkup(tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonLoginURL("Log in", tgbotapi.LoginURL{URL: "https://example.com/login", RequestWriteAccess: true}),
+		tgbotapi.NewInlineKeyboardButtonSwitchChosenChat("Share", tgbotapi.SwitchInlineQueryChosenChat{Query: "q", AllowGroupChats: true}),
+		tgbotapi.NewInlineKeyboardButtonCopyText("Copy", "PROMO42"),
+	))
+	_, err := bot.Send(msg)
+	require.NoError(t, err)
+	markup := server.RequestsFor("sendMessage")[0].Params["reply_markup"]
+	require.Contains(t, markup, `"login_url":{"url":"https://example.com/login","request_write_access":true}`)
+	require.Contains(t, markup, `"switch_inline_query_chosen_chat":{"query":"q","allow_group_chats":true}`)
+	require.Contains(t, markup, `"copy_text":{"text":"PROMO42"}`)
+
+	invalid := tgbotapi.NewInlineKeyboardButtonData("Both", "data")
+	invalid.CopyText = &tgbotapi.CopyTextButton{Text: "text"}
+	msg.ReplyMarkup = tgbotapi.NewInlineKeyboardMarkup(tgbotapi.NewInlineKeyboardRow(invalid))
+	_, err = bot.Send(msg)
+	require.EqualError(t, err, tgbotapi.ErrBadInlineButton)
+
+	empty := tgbotapi.NewInlineKeyboardMarkup(tgbotapi.NewInlineKeyboardRow(tgbotapi.InlineKeyboardButton{Text: "None"}))
+	_, err = bot.Send(tgbotapi.NewEditMessageReplyMarkup(ChatID, 1, empty))
+	require.EqualError(t, err, tgbotapi.ErrBadInlineButton)
+	require.Len(t, server.RequestsFor("sendMessage"), 1)
+}
@@ -0,0 +1,63 @@
+package tgbotapi
+
+import (
+	"context"
+	"time"
+)
+
+// chatActionInterval is how often a chat action is repeated to keep it
+// shown, as an action is only shown for 5 seconds.
+const chatActionInterval = 4 * time.Second
+
+// WithTyping shows the "typing…" action in the chat while fn runs,
+// and returns the error of fn.
+func (bot *BotAPI) WithTyping(ctx context.Context, chatID int64, fn func() error) error {
+	return bot.WithChatAction(ctx, NewChatAction(chatID, ChatTyping), fn)
+}
+
+// WithChatAction shows the chat action of config while fn runs,
+// and returns the error of fn.
+//
+// The action is sent again every few seconds until fn returns or ctx
+// is done. Failures to send it are logged, as the action is cosmetic.
+func (bot *BotAPI) WithChatAction(ctx context.Context, config ChatActionConfig, fn func() error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(chatActionInterval)
+		defer ticker.Stop()
+
+		for {
+			if err := bot.sendChatAction(ctx, config); err != nil && ctx.Err() == nil {
+				bot.logger().Error("Failed to send chat action", "action", config.Action, "error", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	err := fn()
+	cancel()
+	<-done
+
+	return err
+}
+
+// sendChatAction sends a chat action, which is answered with true
+// rather than a Message.
+func (bot *BotAPI) sendChatAction(ctx context.Context, config ChatActionConfig) error {
+	params, err := config.params()
+	if err != nil {
+		return err
+	}
+
+	_, err = bot.MakeRequestWithContext(ctx, config.method(), params, nil)
+	return err
+}
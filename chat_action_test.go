@@ -0,0 +1,50 @@
+package tgbotapi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithTyping(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	errWork := errors.New("work failed")
+	err := bot.WithTyping(context.Background(), ChatID, func() error {
+		require.Eventually(t, func() bool {
+			return len(server.RequestsFor("sendChatAction")) == 1
+		}, time.Second, 10*time.Millisecond)
+		return errWork
+	})
+	require.Equal(t, errWork, err)
+
+	params := server.RequestsFor("sendChatAction")[0].Params
+	require.Equal(t, tgbotapi.Params{"chat_id": "76918703", "action": "typing"}, params)
+}
+
+func TestChatActionParams(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	config := tgbotapi.NewChatAction(ChatID, tgbotapi.ChatRecordVideoNote)
+	config.MessageThreadID = 12
+	config.BusinessConnectionID = "connection"
+	err := bot.WithChatAction(context.Background(), config, func() error {
+		require.Eventually(t, func() bool {
+			return len(server.RequestsFor("sendChatAction")) == 1
+		}, time.Second, 10*time.Millisecond)
+		return nil
+	})
+	require.NoError(t, err)
+
+	params := server.RequestsFor("sendChatAction")[0].Params
+	require.Equal(t, tgbotapi.Params{
+		"chat_id":                "76918703",
+		"action":                 "record_video_note",
+		"message_thread_id":      "12",
+		"business_connection_id": "connection",
+	}, params)
+}
@@ -0,0 +1,32 @@
+package tgbotapi
+
+import "encoding/json"
+
+// Codec encodes and decodes JSON.
+//
+// It allows replacing encoding/json with a faster implementation,
+// such as jsoniter.ConfigCompatibleWithStandardLibrary, which already
+// implements Codec.
+type Codec interface {
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// stdCodec is the Codec of encoding/json.
+type stdCodec struct{}
+
+func (stdCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (stdCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+// codec returns the Codec of the bot, falling back to encoding/json.
+func (bot *BotAPI) codec() Codec {
+	if bot.Codec != nil {
+		return bot.Codec
+	}
+	return stdCodec{}
+}
@@ -0,0 +1,49 @@
+package tgbotapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/stretchr/testify/require"
+)
+
+type countingCodec struct {
+	marshaled, unmarshaled int
+}
+
+func (c *countingCodec) Marshal(v interface{}) ([]byte, error) {
+	c.marshaled++
+	return json.Marshal(v)
+}
+
+func (c *countingCodec) Unmarshal(data []byte, v interface{}) error {
+	c.unmarshaled++
+	return json.Unmarshal(data, v)
+}
+
+func TestCodec(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ok":true,"result":{"message_id":7,"date":0,"chat":{"id":1,"type":"private"}}}`))
+	})
+
+	codec := &countingCodec{}
+	bot.Codec = codec
+
+	msg, err := bot.Send(tgbotapi.NewMessage(ChatID, "hi"))
+	require.NoError(t, err)
+	require.Equal(t, 7, msg.MessageID)
+	// The API response and the message in its result.
+	require.Equal(t, 2, codec.unmarshaled)
+
+	update, err := bot.HandleUpdate(httptest.NewRequest(http.MethodPost, "/hook", strings.NewReader(`{"update_id":5}`)))
+	require.NoError(t, err)
+	require.Equal(t, 5, update.UpdateID)
+	require.Equal(t, 3, codec.unmarshaled)
+
+	require.NoError(t, bot.WriteToHTTPResponse(httptest.NewRecorder(), tgbotapi.NewMessage(ChatID, "hi")))
+	require.Equal(t, 1, codec.marshaled)
+}
@@ -1,10 +1,11 @@
 package tgbotapi
 
 import (
-	"encoding/json"
+	"errors"
 	"io"
 	"net/url"
 	"strconv"
+	"time"
 )
 
 // Telegram constants
@@ -16,16 +17,66 @@ const (
 	FileEndpoint = "https://api.telegram.org/file/bot%s/%s"
 )
 
+// Upload size limits of the Bot API server.
+const (
+	// MaxUploadSize is the largest file that can be uploaded to the
+	// Telegram hosted Bot API server.
+	MaxUploadSize = 50 << 20
+	// MaxLocalUploadSize is the largest file that can be uploaded to
+	// a local Bot API server, see BotAPI.LocalMode.
+	MaxLocalUploadSize = 2000 << 20
+)
+
+// LivePeriodIndefinite is the live period of a location
+// which is updated until it is stopped.
+const LivePeriodIndefinite = 0x7FFFFFFF
+
+// LongPollMargin is the time a getUpdates request is given to complete
+// on top of the long poll timeout of UpdateConfig.
+const LongPollMargin = 10 * time.Second
+
 // Constant values for ChatActions
 const (
-	ChatTyping         = "typing"
-	ChatUploadPhoto    = "upload_photo"
-	ChatRecordVideo    = "record_video"
-	ChatUploadVideo    = "upload_video"
-	ChatRecordAudio    = "record_audio"
-	ChatUploadAudio    = "upload_audio"
-	ChatUploadDocument = "upload_document"
-	ChatFindLocation   = "find_location"
+	ChatTyping          = "typing"
+	ChatUploadPhoto     = "upload_photo"
+	ChatRecordVideo     = "record_video"
+	ChatUploadVideo     = "upload_video"
+	ChatRecordAudio     = "record_audio"
+	ChatUploadAudio     = "upload_audio"
+	ChatUploadDocument  = "upload_document"
+	ChatFindLocation    = "find_location"
+	ChatRecordVoice     = "record_voice"
+	ChatUploadVoice     = "upload_voice"
+	ChatChooseSticker   = "choose_sticker"
+	ChatRecordVideoNote = "record_video_note"
+	ChatUploadVideoNote = "upload_video_note"
+)
+
+// Constant values for the types of updates, used in AllowedUpdates
+// of UpdateConfig and WebhookConfig.
+const (
+	UpdateTypeMessage                 = "message"
+	UpdateTypeEditedMessage           = "edited_message"
+	UpdateTypeChannelPost             = "channel_post"
+	UpdateTypeEditedChannelPost       = "edited_channel_post"
+	UpdateTypeInlineQuery             = "inline_query"
+	UpdateTypeChosenInlineResult      = "chosen_inline_result"
+	UpdateTypeCallbackQuery           = "callback_query"
+	UpdateTypeShippingQuery           = "shipping_query"
+	UpdateTypePreCheckoutQuery        = "pre_checkout_query"
+	UpdateTypePoll                    = "poll"
+	UpdateTypePollAnswer              = "poll_answer"
+	UpdateTypeMyChatMember            = "my_chat_member"
+	UpdateTypeChatMember              = "chat_member"
+	UpdateTypeChatJoinRequest         = "chat_join_request"
+	UpdateTypeMessageReaction         = "message_reaction"
+	UpdateTypeMessageReactionCount    = "message_reaction_count"
+	UpdateTypeChatBoost               = "chat_boost"
+	UpdateTypeRemovedChatBoost        = "removed_chat_boost"
+	UpdateTypeBusinessConnection      = "business_connection"
+	UpdateTypeBusinessMessage         = "business_message"
+	UpdateTypeEditedBusinessMessage   = "edited_business_message"
+	UpdateTypeDeletedBusinessMessages = "deleted_business_messages"
 )
 
 // API errors
@@ -41,74 +92,130 @@ const (
 	ModeHTML       = "HTML"
 )
 
+// Message effects available to all users, for BaseChat.MessageEffectID.
+const (
+	EffectFire       = "5104841245755180586"
+	EffectThumbsUp   = "5107584321108051014"
+	EffectThumbsDown = "5104858069142078462"
+	EffectHeart      = "5044134455711629726"
+	EffectConfetti   = "5046509860389126442"
+	EffectPoop       = "5046589136895476101"
+)
+
 // Library errors
 const (
 	// ErrBadFileType happens when you pass an unknown type
 	ErrBadFileType = "bad file type"
 	ErrBadURL      = "bad or empty url"
+	// ErrBadSecretToken happens when a webhook request has no valid secret token
+	ErrBadSecretToken = "bad webhook secret token"
+	// ErrFileTooLarge happens when a file exceeds the upload size limit
+	ErrFileTooLarge = "file is too large to upload"
+	// ErrWebhookUpload happens when a webhook reply would need to upload a file
+	ErrWebhookUpload = "files can't be uploaded in a webhook reply"
+	// ErrBadProxyURL happens when a proxy URL has no host or an unsupported scheme
+	ErrBadProxyURL = "bad proxy url"
+	// ErrProxyClient happens when a proxy is set for a Client that is not an *http.Client
+	ErrProxyClient = "proxy can only be set for *http.Client"
+	// ErrNoOffsetStore happens when an OffsetCommitter has no Store
+	ErrNoOffsetStore = "offset committer has no store"
+	// ErrRangeNotSupported happens when a server ignores the range of a ranged download
+	ErrRangeNotSupported = "server doesn't support range requests"
+	// ErrBadMediaGroup happens when a media group doesn't have 2 to 10 items
+	ErrBadMediaGroup = "media group must have 2 to 10 items"
+	// ErrBadPaidMedia happens when paid media doesn't have 1 to 10 items
+	ErrBadPaidMedia = "paid media must have 1 to 10 items"
+	// ErrBadLoginData happens when Login Widget data doesn't match its hash
+	ErrBadLoginData = "bad login widget data hash"
+	// ErrLoginDataExpired happens when Login Widget data is older than allowed
+	ErrLoginDataExpired = "login widget data is outdated"
+	// ErrBadInlineButton happens when an inline keyboard button doesn't have
+	// exactly one action, such as URL or CallbackData
+	ErrBadInlineButton = "inline keyboard button must have exactly one action"
+	// ErrBadStartPayload happens when a deep link payload is too long or has
+	// characters other than A-Z, a-z, 0-9, _ and -
+	ErrBadStartPayload = "bad deep link payload"
 )
 
 // Chattable is any config type that can be sent.
 type Chattable interface {
-	values() (url.Values, error)
+	params() (Params, error)
 	method() string
 }
 
 // Fileable is any config type that can be sent that includes a file.
 type Fileable interface {
 	Chattable
-	params() (map[string]string, error)
 	name() string
 	getFile() interface{}
 	useExistingFile() bool
 }
 
+// MultiFileable is any config type that sends several files in one request,
+// such as a file with its thumbnail.
+type MultiFileable interface {
+	Chattable
+	files() ([]RequestFile, error)
+}
+
 // BaseChat is base type for all chat config types.
 type BaseChat struct {
 	ChatID              int64 // required
 	ChannelUsername     string
+	MessageThreadID     int
 	ReplyToMessageID    int
 	ReplyMarkup         interface{}
 	DisableNotification bool
-}
-
+	// ProtectContent protects the sent message from forwarding and saving.
+	ProtectContent bool
+	// MessageEffectID is the effect added to the message, such as
+	// EffectFire. Private chats only.
+	MessageEffectID string
+	// BusinessConnectionID is the business connection on behalf of which
+	// the message is sent.
+	BusinessConnectionID string
+	// ReplyParameters describes the message to reply to, possibly quoting
+	// part of it or in another chat. It takes precedence over
+	// ReplyToMessageID.
+	ReplyParameters *ReplyParameters
+}
+
+// params returns a Params representation of BaseChat.
 func (chat *BaseChat) params() (Params, error) {
 	params := make(Params)
 
-	params.AddFirstValid("chat_id", chat.ChatID, chat.ChannelUsername)
-	params.AddNonZero("reply_to_message_id", chat.ReplyToMessageID)
+	err := params.AddFirstValid("chat_id", chat.ChannelUsername, chat.ChatID)
+	if err != nil {
+		return params, err
+	}
+	params.AddNonZero("message_thread_id", chat.MessageThreadID)
+	params.AddNonEmpty("business_connection_id", chat.BusinessConnectionID)
 	params.AddBool("disable_notification", chat.DisableNotification)
+	params.AddBool("protect_content", chat.ProtectContent)
+	params.AddNonEmpty("message_effect_id", chat.MessageEffectID)
 
-	err := params.AddInterface("reply_markup", chat.ReplyMarkup)
-
-	return params, err
-}
-
-// values returns url.Values representation of BaseChat
-func (chat *BaseChat) values() (url.Values, error) {
-	v := url.Values{}
-	if chat.ChannelUsername != "" {
-		v.Add("chat_id", chat.ChannelUsername)
+	if chat.ReplyParameters != nil {
+		err = params.AddInterface("reply_parameters", chat.ReplyParameters)
+		if err != nil {
+			return params, err
+		}
 	} else {
-		v.Add("chat_id", strconv.FormatInt(chat.ChatID, 10))
+		params.AddNonZero("reply_to_message_id", chat.ReplyToMessageID)
 	}
 
-	if chat.ReplyToMessageID != 0 {
-		v.Add("reply_to_message_id", strconv.Itoa(chat.ReplyToMessageID))
+	switch markup := chat.ReplyMarkup.(type) {
+	case InlineKeyboardMarkup:
+		err = markup.Validate()
+	case *InlineKeyboardMarkup:
+		err = markup.Validate()
 	}
-
-	if chat.ReplyMarkup != nil {
-		data, err := json.Marshal(chat.ReplyMarkup)
-		if err != nil {
-			return v, err
-		}
-
-		v.Add("reply_markup", string(data))
+	if err != nil {
+		return params, err
 	}
 
-	v.Add("disable_notification", strconv.FormatBool(chat.DisableNotification))
+	err = params.AddInterface("reply_markup", chat.ReplyMarkup)
 
-	return v, nil
+	return params, err
 }
 
 // BaseFile is a base type for all file config types.
@@ -121,40 +228,14 @@ type BaseFile struct {
 	FileSize    int
 }
 
-// params returns a map[string]string representation of BaseFile.
-func (file BaseFile) params() (map[string]string, error) {
-	params := make(map[string]string)
-
-	if file.ChannelUsername != "" {
-		params["chat_id"] = file.ChannelUsername
-	} else {
-		params["chat_id"] = strconv.FormatInt(file.ChatID, 10)
-	}
-
-	if file.ReplyToMessageID != 0 {
-		params["reply_to_message_id"] = strconv.Itoa(file.ReplyToMessageID)
-	}
-
-	if file.ReplyMarkup != nil {
-		data, err := json.Marshal(file.ReplyMarkup)
-		if err != nil {
-			return params, err
-		}
+// params returns a Params representation of BaseFile.
+func (file BaseFile) params() (Params, error) {
+	params, err := file.BaseChat.params()
 
-		params["reply_markup"] = string(data)
-	}
-
-	if file.MimeType != "" {
-		params["mime_type"] = file.MimeType
-	}
-
-	if file.FileSize > 0 {
-		params["file_size"] = strconv.Itoa(file.FileSize)
-	}
-
-	params["disable_notification"] = strconv.FormatBool(file.DisableNotification)
+	params.AddNonEmpty("mime_type", file.MimeType)
+	params.AddNonZero("file_size", file.FileSize)
 
-	return params, nil
+	return params, err
 }
 
 // getFile returns the file.
@@ -167,6 +248,29 @@ func (file BaseFile) useExistingFile() bool {
 	return file.UseExisting
 }
 
+// filesWithThumbnail returns the file sent under name
+// with its thumbnail, if there is one.
+func (file BaseFile) filesWithThumbnail(name string, thumbnail interface{}) ([]RequestFile, error) {
+	var data RequestFileData = FileID(file.FileID)
+	if !file.UseExisting {
+		var err error
+		if data, err = fileData(file.File); err != nil {
+			return nil, err
+		}
+	}
+	files := []RequestFile{{Name: name, Data: data}}
+
+	if thumbnail != nil {
+		thumb, err := fileData(thumbnail)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, RequestFile{Name: "thumbnail", Data: thumb})
+	}
+
+	return files, nil
+}
+
 // BaseEdit is base type of all chat edits.
 type BaseEdit struct {
 	ChatID          int64
@@ -174,54 +278,58 @@ type BaseEdit struct {
 	MessageID       int
 	InlineMessageID string
 	ReplyMarkup     *InlineKeyboardMarkup
+	// BusinessConnectionID is the business connection on behalf of which
+	// the message to edit was sent.
+	BusinessConnectionID string
 }
 
-func (edit BaseEdit) values() (url.Values, error) {
-	v := url.Values{}
+// params returns a Params representation of BaseEdit.
+func (edit BaseEdit) params() (Params, error) {
+	params := make(Params)
 
-	if edit.InlineMessageID == "" {
-		if edit.ChannelUsername != "" {
-			v.Add("chat_id", edit.ChannelUsername)
-		} else {
-			v.Add("chat_id", strconv.FormatInt(edit.ChatID, 10))
-		}
-		v.Add("message_id", strconv.Itoa(edit.MessageID))
+	if edit.InlineMessageID != "" {
+		params["inline_message_id"] = edit.InlineMessageID
 	} else {
-		v.Add("inline_message_id", edit.InlineMessageID)
+		err := params.AddFirstValid("chat_id", edit.ChannelUsername, edit.ChatID)
+		if err != nil {
+			return params, err
+		}
+		params.AddNonZero("message_id", edit.MessageID)
 	}
 
+	params.AddNonEmpty("business_connection_id", edit.BusinessConnectionID)
 	if edit.ReplyMarkup != nil {
-		data, err := json.Marshal(edit.ReplyMarkup)
-		if err != nil {
-			return v, err
+		if err := edit.ReplyMarkup.Validate(); err != nil {
+			return params, err
 		}
-		v.Add("reply_markup", string(data))
 	}
+	err := params.AddInterface("reply_markup", edit.ReplyMarkup)
 
-	return v, nil
+	return params, err
 }
 
 // MessageConfig contains information about a SendMessage request.
 type MessageConfig struct {
 	BaseChat
-	Text                  string
-	ParseMode             string
-	DisableWebPagePreview bool
+	Text      string
+	ParseMode string
+	// LinkPreviewOptions control the link preview of the message,
+	// such as disabling it or showing it above the text.
+	LinkPreviewOptions *LinkPreviewOptions
 }
 
-// values returns a url.Values representation of MessageConfig.
-func (config MessageConfig) values() (url.Values, error) {
-	v, err := config.BaseChat.values()
+// params returns a Params representation of MessageConfig.
+func (config MessageConfig) params() (Params, error) {
+	params, err := config.BaseChat.params()
 	if err != nil {
-		return v, err
-	}
-	v.Add("text", config.Text)
-	v.Add("disable_web_page_preview", strconv.FormatBool(config.DisableWebPagePreview))
-	if config.ParseMode != "" {
-		v.Add("parse_mode", config.ParseMode)
+		return params, err
 	}
 
-	return v, nil
+	params.AddNonEmpty("text", config.Text)
+	params.AddNonEmpty("parse_mode", config.ParseMode)
+	err = params.AddInterface("link_preview_options", config.LinkPreviewOptions)
+
+	return params, err
 }
 
 // method returns Telegram API method name for sending Message.
@@ -237,15 +345,17 @@ type ForwardConfig struct {
 	MessageID           int // required
 }
 
-// values returns a url.Values representation of ForwardConfig.
-func (config ForwardConfig) values() (url.Values, error) {
-	v, err := config.BaseChat.values()
+// params returns a Params representation of ForwardConfig.
+func (config ForwardConfig) params() (Params, error) {
+	params, err := config.BaseChat.params()
 	if err != nil {
-		return v, err
+		return params, err
 	}
-	v.Add("from_chat_id", strconv.FormatInt(config.FromChatID, 10))
-	v.Add("message_id", strconv.Itoa(config.MessageID))
-	return v, nil
+
+	err = params.AddFirstValid("from_chat_id", config.FromChannelUsername, config.FromChatID)
+	params.AddNonZero("message_id", config.MessageID)
+
+	return params, err
 }
 
 // method returns Telegram API method name for sending Forward.
@@ -253,115 +363,193 @@ func (config ForwardConfig) method() string {
 	return "forwardMessage"
 }
 
-// PhotoConfig contains information about a SendPhoto request.
-type PhotoConfig struct {
-	BaseFile
-	Caption   string
-	ParseMode string
+// ForwardMessagesConfig contains information about a forwardMessages
+// request, forwarding up to 100 messages at once. Album grouping is kept.
+type ForwardMessagesConfig struct {
+	ChatID              int64 // required
+	ChannelUsername     string
+	MessageThreadID     int
+	FromChatID          int64 // required
+	FromChannelUsername string
+	// MessageIDs in strictly increasing order.
+	MessageIDs          []int // required
+	DisableNotification bool
+	ProtectContent      bool
 }
 
-// Params returns a map[string]string representation of PhotoConfig.
-func (config PhotoConfig) params() (map[string]string, error) {
-	params, _ := config.BaseFile.params()
+// params returns a Params representation of ForwardMessagesConfig.
+func (config ForwardMessagesConfig) params() (Params, error) {
+	params := make(Params)
 
-	if config.Caption != "" {
-		params["caption"] = config.Caption
-		if config.ParseMode != "" {
-			params["parse_mode"] = config.ParseMode
-		}
+	err := params.AddFirstValid("chat_id", config.ChannelUsername, config.ChatID)
+	if err != nil {
+		return params, err
+	}
+	params.AddNonZero("message_thread_id", config.MessageThreadID)
+	err = params.AddFirstValid("from_chat_id", config.FromChannelUsername, config.FromChatID)
+	if err != nil {
+		return params, err
 	}
+	params.AddBool("disable_notification", config.DisableNotification)
+	params.AddBool("protect_content", config.ProtectContent)
+	err = params.AddInterface("message_ids", config.MessageIDs)
 
-	return params, nil
+	return params, err
+}
+
+// method returns Telegram API method name for forwarding messages.
+func (config ForwardMessagesConfig) method() string {
+	return "forwardMessages"
+}
+
+// CopyMessageConfig contains information about a copyMessage request.
+// The copy has no link to the original message.
+type CopyMessageConfig struct {
+	BaseChat
+	FromChatID          int64 // required
+	FromChannelUsername string
+	MessageID           int // required
+	// Caption replaces the caption of the copied media.
+	Caption               string
+	ParseMode             string
+	CaptionEntities       []MessageEntity
+	ShowCaptionAboveMedia bool
 }
 
-// Values returns a url.Values representation of PhotoConfig.
-func (config PhotoConfig) values() (url.Values, error) {
-	v, err := config.BaseChat.values()
+// params returns a Params representation of CopyMessageConfig.
+func (config CopyMessageConfig) params() (Params, error) {
+	params, err := config.BaseChat.params()
 	if err != nil {
-		return v, err
+		return params, err
 	}
 
-	v.Add(config.name(), config.FileID)
-	if config.Caption != "" {
-		v.Add("caption", config.Caption)
-		if config.ParseMode != "" {
-			v.Add("parse_mode", config.ParseMode)
-		}
+	err = params.AddFirstValid("from_chat_id", config.FromChannelUsername, config.FromChatID)
+	if err != nil {
+		return params, err
 	}
+	params.AddNonZero("message_id", config.MessageID)
+	params.AddNonEmpty("caption", config.Caption)
+	params.AddNonEmpty("parse_mode", config.ParseMode)
+	params.AddBool("show_caption_above_media", config.ShowCaptionAboveMedia)
+	err = params.AddInterface("caption_entities", config.CaptionEntities)
 
-	return v, nil
+	return params, err
 }
 
-// name returns the field name for the Photo.
-func (config PhotoConfig) name() string {
-	return "photo"
+// method returns Telegram API method name for copying a message.
+func (config CopyMessageConfig) method() string {
+	return "copyMessage"
 }
 
-// method returns Telegram API method name for sending Photo.
-func (config PhotoConfig) method() string {
-	return "sendPhoto"
+// CopyMessagesConfig contains information about a copyMessages request,
+// copying up to 100 messages at once. Album grouping is kept.
+type CopyMessagesConfig struct {
+	ChatID              int64 // required
+	ChannelUsername     string
+	MessageThreadID     int
+	FromChatID          int64 // required
+	FromChannelUsername string
+	// MessageIDs in strictly increasing order.
+	MessageIDs          []int // required
+	DisableNotification bool
+	ProtectContent      bool
+	// RemoveCaption copies the messages without their captions.
+	RemoveCaption bool
 }
 
-// AudioConfig contains information about a SendAudio request.
-type AudioConfig struct {
+// params returns a Params representation of CopyMessagesConfig.
+func (config CopyMessagesConfig) params() (Params, error) {
+	params := make(Params)
+
+	err := params.AddFirstValid("chat_id", config.ChannelUsername, config.ChatID)
+	if err != nil {
+		return params, err
+	}
+	params.AddNonZero("message_thread_id", config.MessageThreadID)
+	err = params.AddFirstValid("from_chat_id", config.FromChannelUsername, config.FromChatID)
+	if err != nil {
+		return params, err
+	}
+	params.AddBool("disable_notification", config.DisableNotification)
+	params.AddBool("protect_content", config.ProtectContent)
+	params.AddBool("remove_caption", config.RemoveCaption)
+	err = params.AddInterface("message_ids", config.MessageIDs)
+
+	return params, err
+}
+
+// method returns Telegram API method name for copying messages.
+func (config CopyMessagesConfig) method() string {
+	return "copyMessages"
+}
+
+// PhotoConfig contains information about a SendPhoto request.
+type PhotoConfig struct {
 	BaseFile
-	Caption   string
-	ParseMode string
-	Duration  int
-	Performer string
-	Title     string
+	Caption               string
+	ParseMode             string
+	CaptionEntities       []MessageEntity
+	ShowCaptionAboveMedia bool
 }
 
-// values returns a url.Values representation of AudioConfig.
-func (config AudioConfig) values() (url.Values, error) {
-	v, err := config.BaseChat.values()
+// params returns a Params representation of PhotoConfig.
+func (config PhotoConfig) params() (Params, error) {
+	params, err := config.BaseFile.params()
 	if err != nil {
-		return v, err
+		return params, err
 	}
 
-	v.Add(config.name(), config.FileID)
-	if config.Duration != 0 {
-		v.Add("duration", strconv.Itoa(config.Duration))
-	}
+	params.AddNonEmpty(config.name(), config.FileID)
+	params.AddNonEmpty("caption", config.Caption)
+	params.AddNonEmpty("parse_mode", config.ParseMode)
+	params.AddBool("show_caption_above_media", config.ShowCaptionAboveMedia)
+	err = params.AddInterface("caption_entities", config.CaptionEntities)
 
-	if config.Performer != "" {
-		v.Add("performer", config.Performer)
-	}
-	if config.Title != "" {
-		v.Add("title", config.Title)
-	}
-	if config.Caption != "" {
-		v.Add("caption", config.Caption)
-		if config.ParseMode != "" {
-			v.Add("parse_mode", config.ParseMode)
-		}
-	}
+	return params, err
+}
 
-	return v, nil
+// name returns the field name for the Photo.
+func (config PhotoConfig) name() string {
+	return "photo"
 }
 
-// params returns a map[string]string representation of AudioConfig.
-func (config AudioConfig) params() (map[string]string, error) {
-	params, _ := config.BaseFile.params()
+// method returns Telegram API method name for sending Photo.
+func (config PhotoConfig) method() string {
+	return "sendPhoto"
+}
 
-	if config.Duration != 0 {
-		params["duration"] = strconv.Itoa(config.Duration)
+// AudioConfig contains information about a SendAudio request.
+type AudioConfig struct {
+	BaseFile
+	Caption         string
+	ParseMode       string
+	CaptionEntities []MessageEntity
+	Duration        int
+	Performer       string
+	Title           string
+	// Thumbnail is a JPEG under 200 kB and 320x320 pixels, uploaded with
+	// the file. It is given like File, but can't be an existing file.
+	//
+	// optional
+	Thumbnail interface{}
+}
+
+// params returns a Params representation of AudioConfig.
+func (config AudioConfig) params() (Params, error) {
+	params, err := config.BaseFile.params()
+	if err != nil {
+		return params, err
 	}
 
-	if config.Performer != "" {
-		params["performer"] = config.Performer
-	}
-	if config.Title != "" {
-		params["title"] = config.Title
-	}
-	if config.Caption != "" {
-		params["caption"] = config.Caption
-		if config.ParseMode != "" {
-			params["parse_mode"] = config.ParseMode
-		}
-	}
+	params.AddNonEmpty(config.name(), config.FileID)
+	params.AddNonZero("duration", config.Duration)
+	params.AddNonEmpty("performer", config.Performer)
+	params.AddNonEmpty("title", config.Title)
+	params.AddNonEmpty("caption", config.Caption)
+	params.AddNonEmpty("parse_mode", config.ParseMode)
+	err = params.AddInterface("caption_entities", config.CaptionEntities)
 
-	return params, nil
+	return params, err
 }
 
 // name returns the field name for the Audio.
@@ -369,6 +557,11 @@ func (config AudioConfig) name() string {
 	return "audio"
 }
 
+// files returns the Audio with its thumbnail.
+func (config AudioConfig) files() ([]RequestFile, error) {
+	return config.filesWithThumbnail(config.name(), config.Thumbnail)
+}
+
 // method returns Telegram API method name for sending Audio.
 func (config AudioConfig) method() string {
 	return "sendAudio"
@@ -377,40 +570,29 @@ func (config AudioConfig) method() string {
 // DocumentConfig contains information about a SendDocument request.
 type DocumentConfig struct {
 	BaseFile
-	Caption   string
-	ParseMode string
-}
-
-// values returns a url.Values representation of DocumentConfig.
-func (config DocumentConfig) values() (url.Values, error) {
-	v, err := config.BaseChat.values()
+	Caption         string
+	ParseMode       string
+	CaptionEntities []MessageEntity
+	// Thumbnail is a JPEG under 200 kB and 320x320 pixels, uploaded with
+	// the file. It is given like File, but can't be an existing file.
+	//
+	// optional
+	Thumbnail interface{}
+}
+
+// params returns a Params representation of DocumentConfig.
+func (config DocumentConfig) params() (Params, error) {
+	params, err := config.BaseFile.params()
 	if err != nil {
-		return v, err
-	}
-
-	v.Add(config.name(), config.FileID)
-	if config.Caption != "" {
-		v.Add("caption", config.Caption)
-		if config.ParseMode != "" {
-			v.Add("parse_mode", config.ParseMode)
-		}
+		return params, err
 	}
 
-	return v, nil
-}
-
-// params returns a map[string]string representation of DocumentConfig.
-func (config DocumentConfig) params() (map[string]string, error) {
-	params, _ := config.BaseFile.params()
-
-	if config.Caption != "" {
-		params["caption"] = config.Caption
-		if config.ParseMode != "" {
-			params["parse_mode"] = config.ParseMode
-		}
-	}
+	params.AddNonEmpty(config.name(), config.FileID)
+	params.AddNonEmpty("caption", config.Caption)
+	params.AddNonEmpty("parse_mode", config.ParseMode)
+	err = params.AddInterface("caption_entities", config.CaptionEntities)
 
-	return params, nil
+	return params, err
 }
 
 // name returns the field name for the Document.
@@ -418,6 +600,11 @@ func (config DocumentConfig) name() string {
 	return "document"
 }
 
+// files returns the Document with its thumbnail.
+func (config DocumentConfig) files() ([]RequestFile, error) {
+	return config.filesWithThumbnail(config.name(), config.Thumbnail)
+}
+
 // method returns Telegram API method name for sending Document.
 func (config DocumentConfig) method() string {
 	return "sendDocument"
@@ -428,21 +615,14 @@ type StickerConfig struct {
 	BaseFile
 }
 
-// values returns a url.Values representation of StickerConfig.
-func (config StickerConfig) values() (url.Values, error) {
-	v, err := config.BaseChat.values()
+// params returns a Params representation of StickerConfig.
+func (config StickerConfig) params() (Params, error) {
+	params, err := config.BaseFile.params()
 	if err != nil {
-		return v, err
+		return params, err
 	}
 
-	v.Add(config.name(), config.FileID)
-
-	return v, nil
-}
-
-// params returns a map[string]string representation of StickerConfig.
-func (config StickerConfig) params() (map[string]string, error) {
-	params, _ := config.BaseFile.params()
+	params.AddNonEmpty(config.name(), config.FileID)
 
 	return params, nil
 }
@@ -460,44 +640,33 @@ func (config StickerConfig) method() string {
 // VideoConfig contains information about a SendVideo request.
 type VideoConfig struct {
 	BaseFile
-	Duration  int
-	Caption   string
-	ParseMode string
-}
-
-// values returns a url.Values representation of VideoConfig.
-func (config VideoConfig) values() (url.Values, error) {
-	v, err := config.BaseChat.values()
+	Duration              int
+	Caption               string
+	ParseMode             string
+	CaptionEntities       []MessageEntity
+	ShowCaptionAboveMedia bool
+	// Thumbnail is a JPEG under 200 kB and 320x320 pixels, uploaded with
+	// the file. It is given like File, but can't be an existing file.
+	//
+	// optional
+	Thumbnail interface{}
+}
+
+// params returns a Params representation of VideoConfig.
+func (config VideoConfig) params() (Params, error) {
+	params, err := config.BaseFile.params()
 	if err != nil {
-		return v, err
-	}
-
-	v.Add(config.name(), config.FileID)
-	if config.Duration != 0 {
-		v.Add("duration", strconv.Itoa(config.Duration))
-	}
-	if config.Caption != "" {
-		v.Add("caption", config.Caption)
-		if config.ParseMode != "" {
-			v.Add("parse_mode", config.ParseMode)
-		}
+		return params, err
 	}
 
-	return v, nil
-}
-
-// params returns a map[string]string representation of VideoConfig.
-func (config VideoConfig) params() (map[string]string, error) {
-	params, _ := config.BaseFile.params()
-
-	if config.Caption != "" {
-		params["caption"] = config.Caption
-		if config.ParseMode != "" {
-			params["parse_mode"] = config.ParseMode
-		}
-	}
+	params.AddNonEmpty(config.name(), config.FileID)
+	params.AddNonZero("duration", config.Duration)
+	params.AddNonEmpty("caption", config.Caption)
+	params.AddNonEmpty("parse_mode", config.ParseMode)
+	params.AddBool("show_caption_above_media", config.ShowCaptionAboveMedia)
+	err = params.AddInterface("caption_entities", config.CaptionEntities)
 
-	return params, nil
+	return params, err
 }
 
 // name returns the field name for the Video.
@@ -505,52 +674,53 @@ func (config VideoConfig) name() string {
 	return "video"
 }
 
+// files returns the Video with its thumbnail.
+func (config VideoConfig) files() ([]RequestFile, error) {
+	return config.filesWithThumbnail(config.name(), config.Thumbnail)
+}
+
 // method returns Telegram API method name for sending Video.
 func (config VideoConfig) method() string {
 	return "sendVideo"
 }
 
-// AnimationConfig contains information about a SendAnimation request.
+// AnimationConfig contains information about a SendAnimation request,
+// sending a GIF or an H.264/MPEG-4 AVC video without sound.
 type AnimationConfig struct {
 	BaseFile
-	Duration  int
-	Caption   string
-	ParseMode string
-}
-
-// values returns a url.Values representation of AnimationConfig.
-func (config AnimationConfig) values() (url.Values, error) {
-	v, err := config.BaseChat.values()
+	Duration              int
+	Width                 int
+	Height                int
+	Caption               string
+	ParseMode             string
+	CaptionEntities       []MessageEntity
+	ShowCaptionAboveMedia bool
+	HasSpoiler            bool
+	// Thumbnail is a JPEG under 200 kB and 320x320 pixels, uploaded with
+	// the file. It is given like File, but can't be an existing file.
+	//
+	// optional
+	Thumbnail interface{}
+}
+
+// params returns a Params representation of AnimationConfig.
+func (config AnimationConfig) params() (Params, error) {
+	params, err := config.BaseFile.params()
 	if err != nil {
-		return v, err
-	}
-
-	v.Add(config.name(), config.FileID)
-	if config.Duration != 0 {
-		v.Add("duration", strconv.Itoa(config.Duration))
+		return params, err
 	}
-	if config.Caption != "" {
-		v.Add("caption", config.Caption)
-		if config.ParseMode != "" {
-			v.Add("parse_mode", config.ParseMode)
-		}
-	}
-
-	return v, nil
-}
 
-// params returns a map[string]string representation of AnimationConfig.
-func (config AnimationConfig) params() (map[string]string, error) {
-	params, _ := config.BaseFile.params()
+	params.AddNonEmpty(config.name(), config.FileID)
+	params.AddNonZero("duration", config.Duration)
+	params.AddNonZero("width", config.Width)
+	params.AddNonZero("height", config.Height)
+	params.AddNonEmpty("caption", config.Caption)
+	params.AddNonEmpty("parse_mode", config.ParseMode)
+	params.AddBool("show_caption_above_media", config.ShowCaptionAboveMedia)
+	params.AddBool("has_spoiler", config.HasSpoiler)
+	err = params.AddInterface("caption_entities", config.CaptionEntities)
 
-	if config.Caption != "" {
-		params["caption"] = config.Caption
-		if config.ParseMode != "" {
-			params["parse_mode"] = config.ParseMode
-		}
-	}
-
-	return params, nil
+	return params, err
 }
 
 // name returns the field name for the Animation.
@@ -558,48 +728,41 @@ func (config AnimationConfig) name() string {
 	return "animation"
 }
 
+// files returns the Animation with its thumbnail.
+func (config AnimationConfig) files() ([]RequestFile, error) {
+	return config.filesWithThumbnail(config.name(), config.Thumbnail)
+}
+
 // method returns Telegram API method name for sending Animation.
 func (config AnimationConfig) method() string {
 	return "sendAnimation"
 }
 
-// VideoNoteConfig contains information about a SendVideoNote request.
+// VideoNoteConfig contains information about a SendVideoNote request,
+// sending a rounded square MPEG4 video of up to 1 minute.
 type VideoNoteConfig struct {
 	BaseFile
 	Duration int
-	Length   int
-}
-
-// values returns a url.Values representation of VideoNoteConfig.
-func (config VideoNoteConfig) values() (url.Values, error) {
-	v, err := config.BaseChat.values()
+	// Length is the diameter of the video.
+	Length int
+	// Thumbnail is a JPEG under 200 kB and 320x320 pixels, uploaded with
+	// the file. It is given like File, but can't be an existing file.
+	//
+	// optional
+	Thumbnail interface{}
+}
+
+// params returns a Params representation of VideoNoteConfig.
+func (config VideoNoteConfig) params() (Params, error) {
+	params, err := config.BaseFile.params()
 	if err != nil {
-		return v, err
-	}
-
-	v.Add(config.name(), config.FileID)
-	if config.Duration != 0 {
-		v.Add("duration", strconv.Itoa(config.Duration))
+		return params, err
 	}
 
+	params.AddNonEmpty(config.name(), config.FileID)
+	params.AddNonZero("duration", config.Duration)
 	// Telegram API seems to have a bug, if no length is provided or it is 0, it will send an error response
-	if config.Length != 0 {
-		v.Add("length", strconv.Itoa(config.Length))
-	}
-
-	return v, nil
-}
-
-// params returns a map[string]string representation of VideoNoteConfig.
-func (config VideoNoteConfig) params() (map[string]string, error) {
-	params, _ := config.BaseFile.params()
-
-	if config.Length != 0 {
-		params["length"] = strconv.Itoa(config.Length)
-	}
-	if config.Duration != 0 {
-		params["duration"] = strconv.Itoa(config.Duration)
-	}
+	params.AddNonZero("length", config.Length)
 
 	return params, nil
 }
@@ -609,55 +772,40 @@ func (config VideoNoteConfig) name() string {
 	return "video_note"
 }
 
+// files returns the VideoNote with its thumbnail.
+func (config VideoNoteConfig) files() ([]RequestFile, error) {
+	return config.filesWithThumbnail(config.name(), config.Thumbnail)
+}
+
 // method returns Telegram API method name for sending VideoNote.
 func (config VideoNoteConfig) method() string {
 	return "sendVideoNote"
 }
 
-// VoiceConfig contains information about a SendVoice request.
+// VoiceConfig contains information about a SendVoice request,
+// sending an OGG file encoded with OPUS, or an MP3 or M4A file.
 type VoiceConfig struct {
 	BaseFile
-	Caption   string
-	ParseMode string
-	Duration  int
+	Caption         string
+	ParseMode       string
+	CaptionEntities []MessageEntity
+	Duration        int
 }
 
-// values returns a url.Values representation of VoiceConfig.
-func (config VoiceConfig) values() (url.Values, error) {
-	v, err := config.BaseChat.values()
+// params returns a Params representation of VoiceConfig.
+func (config VoiceConfig) params() (Params, error) {
+	params, err := config.BaseFile.params()
 	if err != nil {
-		return v, err
-	}
-
-	v.Add(config.name(), config.FileID)
-	if config.Duration != 0 {
-		v.Add("duration", strconv.Itoa(config.Duration))
-	}
-	if config.Caption != "" {
-		v.Add("caption", config.Caption)
-		if config.ParseMode != "" {
-			v.Add("parse_mode", config.ParseMode)
-		}
+		return params, err
 	}
 
-	return v, nil
-}
-
-// params returns a map[string]string representation of VoiceConfig.
-func (config VoiceConfig) params() (map[string]string, error) {
-	params, _ := config.BaseFile.params()
-
-	if config.Duration != 0 {
-		params["duration"] = strconv.Itoa(config.Duration)
-	}
-	if config.Caption != "" {
-		params["caption"] = config.Caption
-		if config.ParseMode != "" {
-			params["parse_mode"] = config.ParseMode
-		}
-	}
+	params.AddNonEmpty(config.name(), config.FileID)
+	params.AddNonZero("duration", config.Duration)
+	params.AddNonEmpty("caption", config.Caption)
+	params.AddNonEmpty("parse_mode", config.ParseMode)
+	err = params.AddInterface("caption_entities", config.CaptionEntities)
 
-	return params, nil
+	return params, err
 }
 
 // name returns the field name for the Voice.
@@ -673,47 +821,204 @@ func (config VoiceConfig) method() string {
 // MediaGroupConfig contains information about a sendMediaGroup request.
 type MediaGroupConfig struct {
 	BaseChat
+	// InputMedia are two to ten InputMediaPhoto, InputMediaVideo,
+	// InputMediaAudio or InputMediaDocument. Audios and documents
+	// can only be grouped with media of the same type.
 	InputMedia []interface{}
 }
 
-func (config MediaGroupConfig) values() (url.Values, error) {
-	v, err := config.BaseChat.values()
+// params returns a Params representation of MediaGroupConfig.
+func (config MediaGroupConfig) params() (Params, error) {
+	params, err := config.BaseChat.params()
 	if err != nil {
-		return v, err
+		return params, err
 	}
 
-	data, err := json.Marshal(config.InputMedia)
+	media, _, err := config.inputMedia()
 	if err != nil {
-		return v, err
+		return params, err
 	}
 
-	v.Add("media", string(data))
+	err = params.AddInterface("media", media)
 
-	return v, nil
+	return params, err
 }
 
-func (config MediaGroupConfig) method() string {
-	return "sendMediaGroup"
+// files returns the files uploaded with the media.
+func (config MediaGroupConfig) files() ([]RequestFile, error) {
+	_, files, err := config.inputMedia()
+	return files, err
 }
 
-// LocationConfig contains information about a SendLocation request.
-type LocationConfig struct {
-	BaseChat
-	Latitude  float64 // required
-	Longitude float64 // required
-}
+// inputMedia returns the media with the files to upload replaced with
+// attach:// references, and the files.
+func (config MediaGroupConfig) inputMedia() ([]interface{}, []RequestFile, error) {
+	if len(config.InputMedia) < 2 || len(config.InputMedia) > 10 {
+		return nil, nil, errors.New(ErrBadMediaGroup)
+	}
 
-// values returns a url.Values representation of LocationConfig.
-func (config LocationConfig) values() (url.Values, error) {
-	v, err := config.BaseChat.values()
-	if err != nil {
-		return v, err
+	var files []RequestFile
+	media := make([]interface{}, len(config.InputMedia))
+	for i, m := range config.InputMedia {
+		im, ok := m.(inputMedia)
+		if !ok {
+			media[i] = m
+			continue
+		}
+
+		prepared, mediaFiles, err := im.prepare("file-" + strconv.Itoa(i))
+		if err != nil {
+			return nil, nil, err
+		}
+		media[i] = prepared
+		files = append(files, mediaFiles...)
 	}
 
-	v.Add("latitude", strconv.FormatFloat(config.Latitude, 'f', 6, 64))
-	v.Add("longitude", strconv.FormatFloat(config.Longitude, 'f', 6, 64))
+	return media, files, nil
+}
 
-	return v, nil
+func (config MediaGroupConfig) method() string {
+	return "sendMediaGroup"
+}
+
+// SendPaidMediaConfig contains information about a sendPaidMedia request.
+type SendPaidMediaConfig struct {
+	BaseChat
+	// StarCount is the number of stars that must be paid to access
+	// the media, 1-10000.
+	StarCount int // required
+	// Media are one to ten InputPaidMediaPhoto or InputPaidMediaVideo.
+	Media []interface{} // required
+	// Payload is a bot-defined paid media payload, 0-128 bytes. It is not
+	// displayed to the user, use it for internal processes.
+	Payload               string
+	Caption               string
+	ParseMode             string
+	CaptionEntities       []MessageEntity
+	ShowCaptionAboveMedia bool
+}
+
+// params returns a Params representation of SendPaidMediaConfig.
+func (config SendPaidMediaConfig) params() (Params, error) {
+	params, err := config.BaseChat.params()
+	if err != nil {
+		return params, err
+	}
+
+	media, _, err := config.inputMedia()
+	if err != nil {
+		return params, err
+	}
+
+	params.AddNonZero("star_count", config.StarCount)
+	params.AddNonEmpty("payload", config.Payload)
+	params.AddNonEmpty("caption", config.Caption)
+	params.AddNonEmpty("parse_mode", config.ParseMode)
+	params.AddBool("show_caption_above_media", config.ShowCaptionAboveMedia)
+	if err = params.AddInterface("caption_entities", config.CaptionEntities); err != nil {
+		return params, err
+	}
+	err = params.AddInterface("media", media)
+
+	return params, err
+}
+
+// files returns the files uploaded with the media.
+func (config SendPaidMediaConfig) files() ([]RequestFile, error) {
+	_, files, err := config.inputMedia()
+	return files, err
+}
+
+// inputMedia returns the media with the files to upload replaced with
+// attach:// references, and the files.
+func (config SendPaidMediaConfig) inputMedia() ([]interface{}, []RequestFile, error) {
+	if len(config.Media) < 1 || len(config.Media) > 10 {
+		return nil, nil, errors.New(ErrBadPaidMedia)
+	}
+
+	var files []RequestFile
+	media := make([]interface{}, len(config.Media))
+	for i, m := range config.Media {
+		im, ok := m.(inputMedia)
+		if !ok {
+			media[i] = m
+			continue
+		}
+
+		prepared, mediaFiles, err := im.prepare("file-" + strconv.Itoa(i))
+		if err != nil {
+			return nil, nil, err
+		}
+		media[i] = prepared
+		files = append(files, mediaFiles...)
+	}
+
+	return media, files, nil
+}
+
+func (config SendPaidMediaConfig) method() string {
+	return "sendPaidMedia"
+}
+
+// SendChecklistConfig contains information about a sendChecklist request.
+// Checklists can only be sent on behalf of a business account,
+// so BusinessConnectionID is required.
+type SendChecklistConfig struct {
+	BaseChat
+	Checklist InputChecklist // required
+}
+
+// params returns a Params representation of SendChecklistConfig.
+func (config SendChecklistConfig) params() (Params, error) {
+	params, err := config.BaseChat.params()
+	if err != nil {
+		return params, err
+	}
+
+	err = params.AddInterface("checklist", config.Checklist)
+
+	return params, err
+}
+
+func (config SendChecklistConfig) method() string {
+	return "sendChecklist"
+}
+
+// LocationConfig contains information about a SendLocation request.
+type LocationConfig struct {
+	BaseChat
+	Latitude  float64 // required
+	Longitude float64 // required
+	// HorizontalAccuracy is the radius of uncertainty for the location,
+	// measured in meters, 0-1500.
+	HorizontalAccuracy float64
+	// LivePeriod is the period in seconds for which the location will be
+	// updated, 60-86400, or LivePeriodIndefinite.
+	LivePeriod int
+	// Heading is the direction in which the user is moving, in degrees,
+	// 1-360. Only for live locations.
+	Heading int
+	// ProximityAlertRadius is the maximum distance in meters for proximity
+	// alerts about approaching another chat member, 1-100000.
+	// Only for live locations.
+	ProximityAlertRadius int
+}
+
+// params returns a Params representation of LocationConfig.
+func (config LocationConfig) params() (Params, error) {
+	params, err := config.BaseChat.params()
+	if err != nil {
+		return params, err
+	}
+
+	params["latitude"] = strconv.FormatFloat(config.Latitude, 'f', 6, 64)
+	params["longitude"] = strconv.FormatFloat(config.Longitude, 'f', 6, 64)
+	params.AddNonZeroFloat("horizontal_accuracy", config.HorizontalAccuracy)
+	params.AddNonZero("live_period", config.LivePeriod)
+	params.AddNonZero("heading", config.Heading)
+	params.AddNonZero("proximity_alert_radius", config.ProximityAlertRadius)
+
+	return params, nil
 }
 
 // method returns Telegram API method name for sending Location.
@@ -724,28 +1029,33 @@ func (config LocationConfig) method() string {
 // VenueConfig contains information about a SendVenue request.
 type VenueConfig struct {
 	BaseChat
-	Latitude     float64 // required
-	Longitude    float64 // required
-	Title        string  // required
-	Address      string  // required
-	FoursquareID string
-}
-
-func (config VenueConfig) values() (url.Values, error) {
-	v, err := config.BaseChat.values()
+	Latitude        float64 // required
+	Longitude       float64 // required
+	Title           string  // required
+	Address         string  // required
+	FoursquareID    string
+	FoursquareType  string
+	GooglePlaceID   string
+	GooglePlaceType string
+}
+
+// params returns a Params representation of VenueConfig.
+func (config VenueConfig) params() (Params, error) {
+	params, err := config.BaseChat.params()
 	if err != nil {
-		return v, err
+		return params, err
 	}
 
-	v.Add("latitude", strconv.FormatFloat(config.Latitude, 'f', 6, 64))
-	v.Add("longitude", strconv.FormatFloat(config.Longitude, 'f', 6, 64))
-	v.Add("title", config.Title)
-	v.Add("address", config.Address)
-	if config.FoursquareID != "" {
-		v.Add("foursquare_id", config.FoursquareID)
-	}
+	params["latitude"] = strconv.FormatFloat(config.Latitude, 'f', 6, 64)
+	params["longitude"] = strconv.FormatFloat(config.Longitude, 'f', 6, 64)
+	params["title"] = config.Title
+	params["address"] = config.Address
+	params.AddNonEmpty("foursquare_id", config.FoursquareID)
+	params.AddNonEmpty("foursquare_type", config.FoursquareType)
+	params.AddNonEmpty("google_place_id", config.GooglePlaceID)
+	params.AddNonEmpty("google_place_type", config.GooglePlaceType)
 
-	return v, nil
+	return params, nil
 }
 
 func (config VenueConfig) method() string {
@@ -758,81 +1068,134 @@ type ContactConfig struct {
 	PhoneNumber string
 	FirstName   string
 	LastName    string
+	VCard       string
 }
 
-func (config ContactConfig) values() (url.Values, error) {
-	v, err := config.BaseChat.values()
+// params returns a Params representation of ContactConfig.
+func (config ContactConfig) params() (Params, error) {
+	params, err := config.BaseChat.params()
 	if err != nil {
-		return v, err
+		return params, err
 	}
 
-	v.Add("phone_number", config.PhoneNumber)
-	v.Add("first_name", config.FirstName)
-	v.Add("last_name", config.LastName)
+	params["phone_number"] = config.PhoneNumber
+	params["first_name"] = config.FirstName
+	params.AddNonEmpty("last_name", config.LastName)
+	params.AddNonEmpty("vcard", config.VCard)
 
-	return v, nil
+	return params, nil
 }
 
 func (config ContactConfig) method() string {
 	return "sendContact"
 }
 
+// Constant values for the types of polls.
+const (
+	PollTypeRegular = "regular"
+	PollTypeQuiz    = "quiz"
+)
+
 // SendPollConfig allows you to send a poll.
 type SendPollConfig struct {
 	BaseChat
-	Question              string
-	Options               []string
+	Question          string
+	QuestionParseMode string
+	QuestionEntities  []MessageEntity
+	// Options are the answer options as plain text.
+	// They are ignored if InputOptions is set.
+	Options []string
+	// InputOptions are the answer options with their formatting.
+	InputOptions          []InputPollOption
 	IsAnonymous           bool
 	Type                  string
 	AllowsMultipleAnswers bool
-	CorrectOptionID       int64
-	Explanation           string
-	ExplanationParseMode  string
-	OpenPeriod            int
-	CloseDate             int
-	IsClosed              bool
-}
-
-func (config SendPollConfig) values() (url.Values, error) {
+	// CorrectOptionID is the 0-based identifier of the correct option,
+	// only sent for quizzes.
+	CorrectOptionID      int64
+	Explanation          string
+	ExplanationParseMode string
+	ExplanationEntities  []MessageEntity
+	OpenPeriod           int
+	CloseDate            int
+	IsClosed             bool
+}
+
+// params returns a Params representation of SendPollConfig.
+func (config SendPollConfig) params() (Params, error) {
 	params, err := config.BaseChat.params()
 	if err != nil {
-		return params.toValues(), err
+		return params, err
 	}
 
 	params["question"] = config.Question
-	err = params.AddInterface("options", config.Options)
+	params.AddNonEmpty("question_parse_mode", config.QuestionParseMode)
+	if err = params.AddInterface("question_entities", config.QuestionEntities); err != nil {
+		return params, err
+	}
+
+	options := config.InputOptions
+	if options == nil {
+		for _, text := range config.Options {
+			options = append(options, InputPollOption{Text: text})
+		}
+	}
+	if err = params.AddInterface("options", options); err != nil {
+		return params, err
+	}
+
 	params["is_anonymous"] = strconv.FormatBool(config.IsAnonymous)
 	params.AddNonEmpty("type", config.Type)
 	params["allows_multiple_answers"] = strconv.FormatBool(config.AllowsMultipleAnswers)
-	params["correct_option_id"] = strconv.FormatInt(config.CorrectOptionID, 10)
+	if config.Type == PollTypeQuiz {
+		params["correct_option_id"] = strconv.FormatInt(config.CorrectOptionID, 10)
+	}
 	params.AddBool("is_closed", config.IsClosed)
 	params.AddNonEmpty("explanation", config.Explanation)
 	params.AddNonEmpty("explanation_parse_mode", config.ExplanationParseMode)
+	if err = params.AddInterface("explanation_entities", config.ExplanationEntities); err != nil {
+		return params, err
+	}
 	params.AddNonZero("open_period", config.OpenPeriod)
 	params.AddNonZero("close_date", config.CloseDate)
 
-	return params.toValues(), err
+	return params, nil
 }
 
 func (SendPollConfig) method() string {
 	return "sendPoll"
 }
 
+// StopPollConfig allows you to stop a poll sent by the bot.
+type StopPollConfig struct {
+	BaseEdit
+}
+
+// params returns a Params representation of StopPollConfig.
+func (config StopPollConfig) params() (Params, error) {
+	return config.BaseEdit.params()
+}
+
+func (StopPollConfig) method() string {
+	return "stopPoll"
+}
+
 // GameConfig allows you to send a game.
 type GameConfig struct {
 	BaseChat
 	GameShortName string
 }
 
-func (config GameConfig) values() (url.Values, error) {
-	v, err := config.BaseChat.values()
+// params returns a Params representation of GameConfig.
+func (config GameConfig) params() (Params, error) {
+	params, err := config.BaseChat.params()
 	if err != nil {
-		return v, err
+		return params, err
 	}
 
-	v.Add("game_short_name", config.GameShortName)
+	params["game_short_name"] = config.GameShortName
 
-	return v, nil
+	return params, nil
 }
 
 func (config GameConfig) method() string {
@@ -841,9 +1204,13 @@ func (config GameConfig) method() string {
 
 // SetGameScoreConfig allows you to update the game score in a chat.
 type SetGameScoreConfig struct {
-	UserID             int
-	Score              int
-	Force              bool
+	UserID int
+	Score  int
+	// Force allows the score to decrease, which is useful to fix mistakes
+	// or ban cheaters.
+	Force bool
+	// DisableEditMessage keeps the game message from being edited
+	// to include the scoreboard.
 	DisableEditMessage bool
 	ChatID             int64
 	ChannelUsername    string
@@ -851,24 +1218,24 @@ type SetGameScoreConfig struct {
 	InlineMessageID    string
 }
 
-func (config SetGameScoreConfig) values() (url.Values, error) {
-	v := url.Values{}
+// params returns a Params representation of SetGameScoreConfig.
+func (config SetGameScoreConfig) params() (Params, error) {
+	params := make(Params)
 
-	v.Add("user_id", strconv.Itoa(config.UserID))
-	v.Add("score", strconv.Itoa(config.Score))
-	if config.InlineMessageID == "" {
-		if config.ChannelUsername == "" {
-			v.Add("chat_id", strconv.FormatInt(config.ChatID, 10))
-		} else {
-			v.Add("chat_id", config.ChannelUsername)
-		}
-		v.Add("message_id", strconv.Itoa(config.MessageID))
-	} else {
-		v.Add("inline_message_id", config.InlineMessageID)
+	params.AddNonZero("user_id", config.UserID)
+	params["score"] = strconv.Itoa(config.Score)
+	params.AddBool("force", config.Force)
+	params.AddBool("disable_edit_message", config.DisableEditMessage)
+
+	if config.InlineMessageID != "" {
+		params["inline_message_id"] = config.InlineMessageID
+		return params, nil
 	}
-	v.Add("disable_edit_message", strconv.FormatBool(config.DisableEditMessage))
 
-	return v, nil
+	err := params.AddFirstValid("chat_id", config.ChannelUsername, config.ChatID)
+	params.AddNonZero("message_id", config.MessageID)
+
+	return params, err
 }
 
 func (config SetGameScoreConfig) method() string {
@@ -884,22 +1251,21 @@ type GetGameHighScoresConfig struct {
 	InlineMessageID string
 }
 
-func (config GetGameHighScoresConfig) values() (url.Values, error) {
-	v := url.Values{}
+// params returns a Params representation of GetGameHighScoresConfig.
+func (config GetGameHighScoresConfig) params() (Params, error) {
+	params := make(Params)
 
-	v.Add("user_id", strconv.Itoa(config.UserID))
-	if config.InlineMessageID == "" {
-		if config.ChannelUsername == "" {
-			v.Add("chat_id", strconv.Itoa(config.ChatID))
-		} else {
-			v.Add("chat_id", config.ChannelUsername)
-		}
-		v.Add("message_id", strconv.Itoa(config.MessageID))
-	} else {
-		v.Add("inline_message_id", config.InlineMessageID)
+	params.AddNonZero("user_id", config.UserID)
+
+	if config.InlineMessageID != "" {
+		params["inline_message_id"] = config.InlineMessageID
+		return params, nil
 	}
 
-	return v, nil
+	err := params.AddFirstValid("chat_id", config.ChannelUsername, config.ChatID)
+	params.AddNonZero("message_id", config.MessageID)
+
+	return params, err
 }
 
 func (config GetGameHighScoresConfig) method() string {
@@ -912,14 +1278,16 @@ type ChatActionConfig struct {
 	Action string // required
 }
 
-// values returns a url.Values representation of ChatActionConfig.
-func (config ChatActionConfig) values() (url.Values, error) {
-	v, err := config.BaseChat.values()
+// params returns a Params representation of ChatActionConfig.
+func (config ChatActionConfig) params() (Params, error) {
+	params, err := config.BaseChat.params()
 	if err != nil {
-		return v, err
+		return params, err
 	}
-	v.Add("action", config.Action)
-	return v, nil
+
+	params["action"] = config.Action
+
+	return params, nil
 }
 
 // method returns Telegram API method name for sending ChatAction.
@@ -930,22 +1298,25 @@ func (config ChatActionConfig) method() string {
 // EditMessageTextConfig allows you to modify the text in a message.
 type EditMessageTextConfig struct {
 	BaseEdit
-	Text                  string
-	ParseMode             string
-	DisableWebPagePreview bool
+	Text      string
+	ParseMode string
+	// LinkPreviewOptions control the link preview of the message,
+	// such as disabling it or showing it above the text.
+	LinkPreviewOptions *LinkPreviewOptions
 }
 
-func (config EditMessageTextConfig) values() (url.Values, error) {
-	v, err := config.BaseEdit.values()
+// params returns a Params representation of EditMessageTextConfig.
+func (config EditMessageTextConfig) params() (Params, error) {
+	params, err := config.BaseEdit.params()
 	if err != nil {
-		return v, err
+		return params, err
 	}
 
-	v.Add("text", config.Text)
-	v.Add("parse_mode", config.ParseMode)
-	v.Add("disable_web_page_preview", strconv.FormatBool(config.DisableWebPagePreview))
+	params["text"] = config.Text
+	params.AddNonEmpty("parse_mode", config.ParseMode)
+	err = params.AddInterface("link_preview_options", config.LinkPreviewOptions)
 
-	return v, nil
+	return params, err
 }
 
 func (config EditMessageTextConfig) method() string {
@@ -955,39 +1326,168 @@ func (config EditMessageTextConfig) method() string {
 // EditMessageCaptionConfig allows you to modify the caption of a message.
 type EditMessageCaptionConfig struct {
 	BaseEdit
-	Caption   string
-	ParseMode string
+	Caption               string
+	ParseMode             string
+	CaptionEntities       []MessageEntity
+	ShowCaptionAboveMedia bool
 }
 
-func (config EditMessageCaptionConfig) values() (url.Values, error) {
-	v, _ := config.BaseEdit.values()
-
-	v.Add("caption", config.Caption)
-	if config.ParseMode != "" {
-		v.Add("parse_mode", config.ParseMode)
+// params returns a Params representation of EditMessageCaptionConfig.
+func (config EditMessageCaptionConfig) params() (Params, error) {
+	params, err := config.BaseEdit.params()
+	if err != nil {
+		return params, err
 	}
 
-	return v, nil
+	params["caption"] = config.Caption
+	params.AddNonEmpty("parse_mode", config.ParseMode)
+	params.AddBool("show_caption_above_media", config.ShowCaptionAboveMedia)
+	err = params.AddInterface("caption_entities", config.CaptionEntities)
+
+	return params, err
 }
 
 func (config EditMessageCaptionConfig) method() string {
 	return "editMessageCaption"
 }
 
+// EditMessageMediaConfig allows you to replace the media of a message,
+// such as a photo swapped behind an inline keyboard.
+type EditMessageMediaConfig struct {
+	BaseEdit
+	// Media is the new InputMediaPhoto, InputMediaVideo, InputMediaAnimation,
+	// InputMediaAudio or InputMediaDocument. Its File is uploaded
+	// with the request.
+	Media interface{}
+}
+
+// params returns a Params representation of EditMessageMediaConfig.
+func (config EditMessageMediaConfig) params() (Params, error) {
+	params, err := config.BaseEdit.params()
+	if err != nil {
+		return params, err
+	}
+
+	media, _, err := config.inputMedia()
+	if err != nil {
+		return params, err
+	}
+
+	err = params.AddInterface("media", media)
+
+	return params, err
+}
+
+// files returns the files uploaded with the media.
+func (config EditMessageMediaConfig) files() ([]RequestFile, error) {
+	_, files, err := config.inputMedia()
+	return files, err
+}
+
+// inputMedia returns the media with the files to upload replaced with
+// attach:// references, and the files.
+func (config EditMessageMediaConfig) inputMedia() (interface{}, []RequestFile, error) {
+	im, ok := config.Media.(inputMedia)
+	if !ok {
+		return config.Media, nil, nil
+	}
+
+	return im.prepare("media-file")
+}
+
+func (config EditMessageMediaConfig) method() string {
+	return "editMessageMedia"
+}
+
+// EditMessageChecklistConfig allows you to modify a checklist sent
+// on behalf of a business account.
+type EditMessageChecklistConfig struct {
+	BaseEdit
+	Checklist InputChecklist // required
+}
+
+// params returns a Params representation of EditMessageChecklistConfig.
+func (config EditMessageChecklistConfig) params() (Params, error) {
+	params, err := config.BaseEdit.params()
+	if err != nil {
+		return params, err
+	}
+
+	err = params.AddInterface("checklist", config.Checklist)
+
+	return params, err
+}
+
+func (config EditMessageChecklistConfig) method() string {
+	return "editMessageChecklist"
+}
+
 // EditMessageReplyMarkupConfig allows you to modify the reply markup
 // of a message.
 type EditMessageReplyMarkupConfig struct {
 	BaseEdit
 }
 
-func (config EditMessageReplyMarkupConfig) values() (url.Values, error) {
-	return config.BaseEdit.values()
+// params returns a Params representation of EditMessageReplyMarkupConfig.
+func (config EditMessageReplyMarkupConfig) params() (Params, error) {
+	return config.BaseEdit.params()
 }
 
 func (config EditMessageReplyMarkupConfig) method() string {
 	return "editMessageReplyMarkup"
 }
 
+// EditMessageLiveLocationConfig allows you to move a live location,
+// until its LivePeriod expires or it is stopped.
+type EditMessageLiveLocationConfig struct {
+	BaseEdit
+	Latitude  float64 // required
+	Longitude float64 // required
+	// LivePeriod extends the live period of the location, in seconds from
+	// when it was sent, or is LivePeriodIndefinite. The current period
+	// is kept if it is zero.
+	LivePeriod           int
+	HorizontalAccuracy   float64
+	Heading              int
+	ProximityAlertRadius int
+}
+
+// params returns a Params representation of EditMessageLiveLocationConfig.
+func (config EditMessageLiveLocationConfig) params() (Params, error) {
+	params, err := config.BaseEdit.params()
+	if err != nil {
+		return params, err
+	}
+
+	params["latitude"] = strconv.FormatFloat(config.Latitude, 'f', 6, 64)
+	params["longitude"] = strconv.FormatFloat(config.Longitude, 'f', 6, 64)
+	params.AddNonZero("live_period", config.LivePeriod)
+	params.AddNonZeroFloat("horizontal_accuracy", config.HorizontalAccuracy)
+	params.AddNonZero("heading", config.Heading)
+	params.AddNonZero("proximity_alert_radius", config.ProximityAlertRadius)
+
+	return params, nil
+}
+
+func (config EditMessageLiveLocationConfig) method() string {
+	return "editMessageLiveLocation"
+}
+
+// StopMessageLiveLocationConfig allows you to stop updating a live location
+// before its LivePeriod expires.
+type StopMessageLiveLocationConfig struct {
+	BaseEdit
+}
+
+// params returns a Params representation of StopMessageLiveLocationConfig.
+func (config StopMessageLiveLocationConfig) params() (Params, error) {
+	return config.BaseEdit.params()
+}
+
+func (config StopMessageLiveLocationConfig) method() string {
+	return "stopMessageLiveLocation"
+}
+
 // UserProfilePhotosConfig contains information about a
 // GetUserProfilePhotos request.
 type UserProfilePhotosConfig struct {
@@ -1006,6 +1506,65 @@ type UpdateConfig struct {
 	Offset  int
 	Limit   int
 	Timeout int
+	// AllowedUpdates lists the types of updates to receive, such as
+	// UpdateTypeMessage. The previous setting is kept if it is empty.
+	//
+	// optional
+	AllowedUpdates []string
+	// ErrorBackoff controls the delay before GetUpdatesChan tries again
+	// after failing to get updates. Its MaxAttempts is ignored.
+	//
+	// optional, DefaultPollingBackoff is used if nil
+	ErrorBackoff *RetryPolicy
+	// OnError is called when GetUpdatesChan fails to get updates.
+	//
+	// optional, the error is logged if nil
+	OnError PollingErrorHandler
+	// OffsetStore keeps the ID of the last update received with
+	// GetUpdatesChan. Receiving starts after the stored update,
+	// if it is past Offset. The ID is stored as soon as the update
+	// is passed to the channel, so it is delivered at most once.
+	//
+	// optional
+	OffsetStore OffsetStore
+	// Committer keeps the ID of the last update received with
+	// GetUpdatesChan once it is committed as handled, instead
+	// of OffsetStore. Receiving starts after the stored update,
+	// if it is past Offset.
+	//
+	// optional
+	Committer *OffsetCommitter
+}
+
+// PollingErrorHandler handles an error of GetUpdatesChan, with the number
+// of failures in a row and the delay before the next attempt. Updates
+// stop being received if it returns false, such as for an invalid token:
+//
+//	func(err error, failures int, retryIn time.Duration) bool {
+//		if apiErr, ok := tgbotapi.AsError(err); ok && apiErr.Code == http.StatusUnauthorized {
+//			return false
+//		}
+//		log.Printf("Failed to get updates, retrying in %s: %v", retryIn, err)
+//		return true
+//	}
+type PollingErrorHandler func(err error, failures int, retryIn time.Duration) bool
+
+// pollingBackoff returns the delay after the given number of failures
+// to get updates in a row, the last one with err.
+func (config UpdateConfig) pollingBackoff(failures int, err error) time.Duration {
+	policy := config.ErrorBackoff
+	if policy == nil {
+		policy = &DefaultPollingBackoff
+	}
+
+	delay := policy.Backoff(failures)
+	if apiErr, ok := AsError(err); ok {
+		if retryAfter := time.Duration(apiErr.RetryAfter) * time.Second; retryAfter > delay {
+			delay = retryAfter
+		}
+	}
+
+	return delay
 }
 
 // WebhookConfig contains information about a SetWebhook request.
@@ -1013,6 +1572,46 @@ type WebhookConfig struct {
 	URL            *url.URL
 	Certificate    interface{}
 	MaxConnections int
+	// AllowedUpdates lists the types of updates to receive, such as
+	// UpdateTypeMessage. The previous setting is kept if it is empty.
+	//
+	// optional
+	AllowedUpdates []string
+	// DropPendingUpdates drops all pending updates.
+	//
+	// optional
+	DropPendingUpdates bool
+	// IPAddress is the fixed IP address which will be used to send webhook
+	// requests instead of the IP address resolved through DNS.
+	//
+	// optional
+	IPAddress string
+	// SecretToken is sent by Telegram in the X-Telegram-Bot-Api-Secret-Token
+	// header of every webhook request, to ensure that the request comes
+	// from the webhook. It may be created with GenerateSecretToken.
+	//
+	// optional
+	SecretToken string
+}
+
+// DeleteWebhookConfig contains information about a deleteWebhook request.
+type DeleteWebhookConfig struct {
+	// DropPendingUpdates drops all pending updates.
+	DropPendingUpdates bool
+}
+
+// params returns a Params representation of DeleteWebhookConfig.
+func (config DeleteWebhookConfig) params() (Params, error) {
+	params := make(Params)
+
+	params.AddBool("drop_pending_updates", config.DropPendingUpdates)
+
+	return params, nil
+}
+
+// method returns Telegram API method name for deleting a webhook.
+func (config DeleteWebhookConfig) method() string {
+	return "deleteWebhook"
 }
 
 // FileBytes contains information about a set of bytes to upload
@@ -1023,8 +1622,7 @@ type FileBytes struct {
 }
 
 // FileReader contains information about a reader to upload as a File.
-// If Size is -1, it will read the entire Reader into memory to
-// calculate a Size.
+// Size is -1 if it is unknown, the Reader is streamed either way.
 type FileReader struct {
 	Name   string
 	Reader io.Reader
@@ -1042,6 +1640,64 @@ type InlineConfig struct {
 	SwitchPMParameter string        `json:"switch_pm_parameter"`
 }
 
+// SavePreparedInlineMessageConfig stores a message that can be sent by a user
+// of a Mini App, with the shareMessage method of the Mini App.
+type SavePreparedInlineMessageConfig struct {
+	UserID int // required
+	// Result is the InlineQueryResult to send, such as
+	// InlineQueryResultArticle.
+	Result interface{} // required
+	// AllowUserChats, AllowBotChats, AllowGroupChats and AllowChannelChats
+	// select the types of chats the message can be sent to.
+	AllowUserChats    bool
+	AllowBotChats     bool
+	AllowGroupChats   bool
+	AllowChannelChats bool
+}
+
+func (config SavePreparedInlineMessageConfig) method() string {
+	return "savePreparedInlineMessage"
+}
+
+// params returns a Params representation of SavePreparedInlineMessageConfig.
+func (config SavePreparedInlineMessageConfig) params() (Params, error) {
+	params := make(Params)
+
+	params.AddNonZero("user_id", config.UserID)
+	params.AddBool("allow_user_chats", config.AllowUserChats)
+	params.AddBool("allow_bot_chats", config.AllowBotChats)
+	params.AddBool("allow_group_chats", config.AllowGroupChats)
+	params.AddBool("allow_channel_chats", config.AllowChannelChats)
+	err := params.AddInterface("result", config.Result)
+
+	return params, err
+}
+
+// AnswerWebAppQueryConfig sets the result of an interaction with a Web App
+// and sends a corresponding message on behalf of the user to the chat
+// from which the query originated.
+type AnswerWebAppQueryConfig struct {
+	// WebAppQueryID is the unique identifier for the query to be answered.
+	WebAppQueryID string // required
+	// Result is the InlineQueryResult describing the message to be sent,
+	// such as InlineQueryResultArticle.
+	Result interface{} // required
+}
+
+func (config AnswerWebAppQueryConfig) method() string {
+	return "answerWebAppQuery"
+}
+
+// params returns a Params representation of AnswerWebAppQueryConfig.
+func (config AnswerWebAppQueryConfig) params() (Params, error) {
+	params := make(Params)
+
+	params["web_app_query_id"] = config.WebAppQueryID
+	err := params.AddInterface("result", config.Result)
+
+	return params, err
+}
+
 // CallbackConfig contains information on making a CallbackQuery response.
 type CallbackConfig struct {
 	CallbackQueryID string `json:"callback_query_id"`
@@ -1060,122 +1716,509 @@ type ChatMemberConfig struct {
 	UserID             int
 }
 
-// KickChatMemberConfig contains extra fields to kick user
-type KickChatMemberConfig struct {
-	ChatMemberConfig
-	UntilDate int64
-}
+// params returns a Params representation of ChatMemberConfig.
+func (config ChatMemberConfig) params() (Params, error) {
+	params := make(Params)
 
-// RestrictChatMemberConfig contains fields to restrict members of chat
-type RestrictChatMemberConfig struct {
-	ChatMemberConfig
-	UntilDate             int64
-	CanSendMessages       *bool
-	CanSendMediaMessages  *bool
-	CanSendOtherMessages  *bool
-	CanAddWebPagePreviews *bool
-}
+	err := params.AddFirstValid("chat_id",
+		config.SuperGroupUsername, config.ChannelUsername, config.ChatID)
+	params.AddNonZero("user_id", config.UserID)
 
-// PromoteChatMemberConfig contains fields to promote members of chat
-type PromoteChatMemberConfig struct {
-	ChatMemberConfig
-	CanChangeInfo      *bool
-	CanPostMessages    *bool
-	CanEditMessages    *bool
-	CanDeleteMessages  *bool
-	CanInviteUsers     *bool
-	CanRestrictMembers *bool
-	CanPinMessages     *bool
-	CanPromoteMembers  *bool
+	return params, err
 }
 
-// ChatConfig contains information about getting information on a chat.
-type ChatConfig struct {
+// BanChatSenderChatConfig contains information for banning a channel chat
+// in a supergroup or channel. Until the chat is unbanned, its owner can't
+// send messages on behalf of any of their channels.
+type BanChatSenderChatConfig struct {
 	ChatID             int64
 	SuperGroupUsername string
+	ChannelUsername    string
+	SenderChatID       int64 // required
 }
 
-// ChatConfigWithUser contains information about getting information on
-// a specific user within a chat.
-type ChatConfigWithUser struct {
+func (config BanChatSenderChatConfig) method() string {
+	return "banChatSenderChat"
+}
+
+// params returns a Params representation of BanChatSenderChatConfig.
+func (config BanChatSenderChatConfig) params() (Params, error) {
+	params := make(Params)
+
+	err := params.AddFirstValid("chat_id",
+		config.SuperGroupUsername, config.ChannelUsername, config.ChatID)
+	params.AddNonZero64("sender_chat_id", config.SenderChatID)
+
+	return params, err
+}
+
+// UnbanChatSenderChatConfig contains information for unbanning a previously
+// banned channel chat in a supergroup or channel.
+type UnbanChatSenderChatConfig struct {
 	ChatID             int64
 	SuperGroupUsername string
-	UserID             int
+	ChannelUsername    string
+	SenderChatID       int64 // required
 }
 
-// InvoiceConfig contains information for sendInvoice request.
-type InvoiceConfig struct {
-	BaseChat
-	Title               string          // required
-	Description         string          // required
-	Payload             string          // required
-	ProviderToken       string          // required
-	StartParameter      string          // required
-	Currency            string          // required
-	Prices              *[]LabeledPrice // required
-	PhotoURL            string
-	PhotoSize           int
-	PhotoWidth          int
-	PhotoHeight         int
-	NeedName            bool
-	NeedPhoneNumber     bool
-	NeedEmail           bool
-	NeedShippingAddress bool
-	IsFlexible          bool
+func (config UnbanChatSenderChatConfig) method() string {
+	return "unbanChatSenderChat"
 }
 
-func (config InvoiceConfig) values() (url.Values, error) {
-	v, err := config.BaseChat.values()
-	if err != nil {
-		return v, err
-	}
-	v.Add("title", config.Title)
-	v.Add("description", config.Description)
-	v.Add("payload", config.Payload)
-	v.Add("provider_token", config.ProviderToken)
-	v.Add("start_parameter", config.StartParameter)
-	v.Add("currency", config.Currency)
-	data, err := json.Marshal(config.Prices)
-	if err != nil {
-		return v, err
-	}
-	v.Add("prices", string(data))
-	if config.PhotoURL != "" {
-		v.Add("photo_url", config.PhotoURL)
-	}
-	if config.PhotoSize != 0 {
-		v.Add("photo_size", strconv.Itoa(config.PhotoSize))
-	}
-	if config.PhotoWidth != 0 {
-		v.Add("photo_width", strconv.Itoa(config.PhotoWidth))
-	}
-	if config.PhotoHeight != 0 {
-		v.Add("photo_height", strconv.Itoa(config.PhotoHeight))
-	}
-	if config.NeedName {
-		v.Add("need_name", strconv.FormatBool(config.NeedName))
-	}
-	if config.NeedPhoneNumber {
-		v.Add("need_phone_number", strconv.FormatBool(config.NeedPhoneNumber))
-	}
-	if config.NeedEmail {
-		v.Add("need_email", strconv.FormatBool(config.NeedEmail))
-	}
-	if config.NeedShippingAddress {
-		v.Add("need_shipping_address", strconv.FormatBool(config.NeedShippingAddress))
-	}
-	if config.IsFlexible {
-		v.Add("is_flexible", strconv.FormatBool(config.IsFlexible))
-	}
+// params returns a Params representation of UnbanChatSenderChatConfig.
+func (config UnbanChatSenderChatConfig) params() (Params, error) {
+	params := make(Params)
 
-	return v, nil
+	err := params.AddFirstValid("chat_id",
+		config.SuperGroupUsername, config.ChannelUsername, config.ChatID)
+	params.AddNonZero64("sender_chat_id", config.SenderChatID)
+
+	return params, err
 }
 
-func (config InvoiceConfig) method() string {
-	return "sendInvoice"
+// ApproveChatJoinRequestConfig contains information for approving
+// a chat join request.
+type ApproveChatJoinRequestConfig struct {
+	ChatID             int64
+	SuperGroupUsername string
+	ChannelUsername    string
+	UserID             int // required
 }
 
-// ShippingConfig contains information for answerShippingQuery request.
+func (config ApproveChatJoinRequestConfig) method() string {
+	return "approveChatJoinRequest"
+}
+
+// params returns a Params representation of ApproveChatJoinRequestConfig.
+func (config ApproveChatJoinRequestConfig) params() (Params, error) {
+	params := make(Params)
+
+	err := params.AddFirstValid("chat_id",
+		config.SuperGroupUsername, config.ChannelUsername, config.ChatID)
+	params.AddNonZero("user_id", config.UserID)
+
+	return params, err
+}
+
+// DeclineChatJoinRequestConfig contains information for declining
+// a chat join request.
+type DeclineChatJoinRequestConfig struct {
+	ChatID             int64
+	SuperGroupUsername string
+	ChannelUsername    string
+	UserID             int // required
+}
+
+func (config DeclineChatJoinRequestConfig) method() string {
+	return "declineChatJoinRequest"
+}
+
+// params returns a Params representation of DeclineChatJoinRequestConfig.
+func (config DeclineChatJoinRequestConfig) params() (Params, error) {
+	params := make(Params)
+
+	err := params.AddFirstValid("chat_id",
+		config.SuperGroupUsername, config.ChannelUsername, config.ChatID)
+	params.AddNonZero("user_id", config.UserID)
+
+	return params, err
+}
+
+// KickChatMemberConfig contains extra fields to kick user
+type KickChatMemberConfig struct {
+	ChatMemberConfig
+	UntilDate int64
+}
+
+// UnbanChatMemberConfig contains extra fields to unban user
+type UnbanChatMemberConfig struct {
+	ChatMemberConfig
+	// OnlyIfBanned does nothing if the user is not banned. Otherwise,
+	// a user who is a member of the chat is removed from it.
+	OnlyIfBanned bool
+}
+
+func (config UnbanChatMemberConfig) method() string {
+	return "unbanChatMember"
+}
+
+// params returns a Params representation of UnbanChatMemberConfig.
+func (config UnbanChatMemberConfig) params() (Params, error) {
+	params, err := config.ChatMemberConfig.params()
+	if err != nil {
+		return params, err
+	}
+
+	params.AddBool("only_if_banned", config.OnlyIfBanned)
+
+	return params, nil
+}
+
+// RestrictChatMemberConfig contains fields to restrict members of chat
+type RestrictChatMemberConfig struct {
+	ChatMemberConfig
+	UntilDate             int64
+	CanSendMessages       *bool
+	CanSendMediaMessages  *bool
+	CanSendOtherMessages  *bool
+	CanAddWebPagePreviews *bool
+}
+
+// PromoteChatMemberConfig contains fields to promote members of chat
+type PromoteChatMemberConfig struct {
+	ChatMemberConfig
+	CanChangeInfo       *bool
+	CanPostMessages     *bool
+	CanEditMessages     *bool
+	CanDeleteMessages   *bool
+	CanInviteUsers      *bool
+	CanRestrictMembers  *bool
+	CanPinMessages      *bool
+	CanPromoteMembers   *bool
+	IsAnonymous         *bool
+	CanManageChat       *bool
+	CanManageVideoChats *bool
+	CanManageTopics     *bool
+	CanPostStories      *bool
+	CanEditStories      *bool
+	CanDeleteStories    *bool
+}
+
+// ChatConfig contains information about getting information on a chat.
+type ChatConfig struct {
+	ChatID             int64
+	SuperGroupUsername string
+}
+
+// params returns a Params representation of ChatConfig.
+func (config ChatConfig) params() (Params, error) {
+	params := make(Params)
+
+	err := params.AddFirstValid("chat_id", config.SuperGroupUsername, config.ChatID)
+
+	return params, err
+}
+
+// CreateChatInviteLinkConfig contains information for creating an additional
+// invite link for a chat. The link can be revoked with RevokeChatInviteLinkConfig.
+type CreateChatInviteLinkConfig struct {
+	ChatConfig
+	Name string
+	// ExpireDate is the point in time (Unix timestamp) when the link expires.
+	ExpireDate int
+	// MemberLimit is the maximum number of users that can be members of the
+	// chat simultaneously after joining via the link, 1-99999.
+	MemberLimit int
+	// CreatesJoinRequest makes users joining via the link need to be
+	// approved by chat administrators. MemberLimit can't be set with it.
+	CreatesJoinRequest bool
+}
+
+func (config CreateChatInviteLinkConfig) method() string {
+	return "createChatInviteLink"
+}
+
+// params returns a Params representation of CreateChatInviteLinkConfig.
+func (config CreateChatInviteLinkConfig) params() (Params, error) {
+	params, err := config.ChatConfig.params()
+	if err != nil {
+		return params, err
+	}
+
+	params.AddNonEmpty("name", config.Name)
+	params.AddNonZero("expire_date", config.ExpireDate)
+	params.AddNonZero("member_limit", config.MemberLimit)
+	params.AddBool("creates_join_request", config.CreatesJoinRequest)
+
+	return params, nil
+}
+
+// EditChatInviteLinkConfig contains information for editing a non-primary
+// invite link created by the bot.
+type EditChatInviteLinkConfig struct {
+	ChatConfig
+	InviteLink         string // required
+	Name               string
+	ExpireDate         int
+	MemberLimit        int
+	CreatesJoinRequest bool
+}
+
+func (config EditChatInviteLinkConfig) method() string {
+	return "editChatInviteLink"
+}
+
+// params returns a Params representation of EditChatInviteLinkConfig.
+func (config EditChatInviteLinkConfig) params() (Params, error) {
+	params, err := config.ChatConfig.params()
+	if err != nil {
+		return params, err
+	}
+
+	params["invite_link"] = config.InviteLink
+	params.AddNonEmpty("name", config.Name)
+	params.AddNonZero("expire_date", config.ExpireDate)
+	params.AddNonZero("member_limit", config.MemberLimit)
+	params.AddBool("creates_join_request", config.CreatesJoinRequest)
+
+	return params, nil
+}
+
+// CreateChatSubscriptionInviteLinkConfig contains information for creating
+// a subscription invite link for a channel chat, paid in Telegram Stars.
+type CreateChatSubscriptionInviteLinkConfig struct {
+	ChatConfig
+	Name string
+	// SubscriptionPeriod is the number of seconds the subscription is active
+	// for before the next payment. Currently, it must always be 2592000
+	// (30 days).
+	SubscriptionPeriod int // required
+	// SubscriptionPrice is the amount of Telegram Stars a user must pay
+	// initially and after each subsequent subscription period, 1-10000.
+	SubscriptionPrice int // required
+}
+
+func (config CreateChatSubscriptionInviteLinkConfig) method() string {
+	return "createChatSubscriptionInviteLink"
+}
+
+// params returns a Params representation of CreateChatSubscriptionInviteLinkConfig.
+func (config CreateChatSubscriptionInviteLinkConfig) params() (Params, error) {
+	params, err := config.ChatConfig.params()
+	if err != nil {
+		return params, err
+	}
+
+	params.AddNonEmpty("name", config.Name)
+	params.AddNonZero("subscription_period", config.SubscriptionPeriod)
+	params.AddNonZero("subscription_price", config.SubscriptionPrice)
+
+	return params, nil
+}
+
+// EditChatSubscriptionInviteLinkConfig contains information for editing
+// a subscription invite link created by the bot.
+type EditChatSubscriptionInviteLinkConfig struct {
+	ChatConfig
+	InviteLink string // required
+	Name       string
+}
+
+func (config EditChatSubscriptionInviteLinkConfig) method() string {
+	return "editChatSubscriptionInviteLink"
+}
+
+// params returns a Params representation of EditChatSubscriptionInviteLinkConfig.
+func (config EditChatSubscriptionInviteLinkConfig) params() (Params, error) {
+	params, err := config.ChatConfig.params()
+	if err != nil {
+		return params, err
+	}
+
+	params["invite_link"] = config.InviteLink
+	params.AddNonEmpty("name", config.Name)
+
+	return params, nil
+}
+
+// RevokeChatInviteLinkConfig contains information for revoking an invite
+// link created by the bot. If the primary link is revoked,
+// a new link is automatically generated.
+type RevokeChatInviteLinkConfig struct {
+	ChatConfig
+	InviteLink string // required
+}
+
+func (config RevokeChatInviteLinkConfig) method() string {
+	return "revokeChatInviteLink"
+}
+
+// params returns a Params representation of RevokeChatInviteLinkConfig.
+func (config RevokeChatInviteLinkConfig) params() (Params, error) {
+	params, err := config.ChatConfig.params()
+	if err != nil {
+		return params, err
+	}
+
+	params["invite_link"] = config.InviteLink
+
+	return params, nil
+}
+
+// ChatConfigWithUser contains information about getting information on
+// a specific user within a chat.
+type ChatConfigWithUser struct {
+	ChatID             int64
+	SuperGroupUsername string
+	UserID             int
+}
+
+// params returns a Params representation of ChatConfigWithUser.
+func (config ChatConfigWithUser) params() (Params, error) {
+	params := make(Params)
+
+	err := params.AddFirstValid("chat_id", config.SuperGroupUsername, config.ChatID)
+	params.AddNonZero("user_id", config.UserID)
+
+	return params, err
+}
+
+// CurrencyStars is the currency of payments in Telegram Stars. Invoices in
+// stars have no ProviderToken, and exactly one price.
+const CurrencyStars = "XTR"
+
+// SubscriptionPeriodMonth is the only supported subscription period
+// of invoice links, 30 days.
+const SubscriptionPeriodMonth = 30 * 24 * 60 * 60
+
+// InvoiceConfig contains information for sendInvoice request.
+type InvoiceConfig struct {
+	BaseChat
+	Title          string // required
+	Description    string // required
+	Payload        string // required
+	ProviderToken  string // must be empty for payments in stars
+	StartParameter string
+	Currency       string          // required
+	Prices         *[]LabeledPrice // required
+	// MaxTipAmount is the maximum accepted amount for tips in the smallest
+	// units of the currency. Tips are not supported for payments in stars.
+	MaxTipAmount int
+	// SuggestedTipAmounts are at most 4 increasing suggested amounts of tips,
+	// not larger than MaxTipAmount.
+	SuggestedTipAmounts []int
+	// ProviderData is JSON-serialized data about the invoice,
+	// shared with the payment provider.
+	ProviderData              string
+	PhotoURL                  string
+	PhotoSize                 int
+	PhotoWidth                int
+	PhotoHeight               int
+	NeedName                  bool
+	NeedPhoneNumber           bool
+	NeedEmail                 bool
+	NeedShippingAddress       bool
+	SendPhoneNumberToProvider bool
+	SendEmailToProvider       bool
+	// IsFlexible is set if the final price depends on the shipping method.
+	IsFlexible bool
+}
+
+// params returns a Params representation of InvoiceConfig.
+func (config InvoiceConfig) params() (Params, error) {
+	params, err := config.BaseChat.params()
+	if err != nil {
+		return params, err
+	}
+
+	params["title"] = config.Title
+	params["description"] = config.Description
+	params["payload"] = config.Payload
+	params.AddNonEmpty("provider_token", config.ProviderToken)
+	params.AddNonEmpty("start_parameter", config.StartParameter)
+	params["currency"] = config.Currency
+	if err = params.AddInterface("prices", config.Prices); err != nil {
+		return params, err
+	}
+	params.AddNonZero("max_tip_amount", config.MaxTipAmount)
+	if err = params.AddInterface("suggested_tip_amounts", config.SuggestedTipAmounts); err != nil {
+		return params, err
+	}
+	params.AddNonEmpty("provider_data", config.ProviderData)
+	params.AddNonEmpty("photo_url", config.PhotoURL)
+	params.AddNonZero("photo_size", config.PhotoSize)
+	params.AddNonZero("photo_width", config.PhotoWidth)
+	params.AddNonZero("photo_height", config.PhotoHeight)
+	params.AddBool("need_name", config.NeedName)
+	params.AddBool("need_phone_number", config.NeedPhoneNumber)
+	params.AddBool("need_email", config.NeedEmail)
+	params.AddBool("need_shipping_address", config.NeedShippingAddress)
+	params.AddBool("send_phone_number_to_provider", config.SendPhoneNumberToProvider)
+	params.AddBool("send_email_to_provider", config.SendEmailToProvider)
+	params.AddBool("is_flexible", config.IsFlexible)
+
+	return params, nil
+}
+
+func (config InvoiceConfig) method() string {
+	return "sendInvoice"
+}
+
+// InvoiceLinkConfig contains information for createInvoiceLink request.
+type InvoiceLinkConfig struct {
+	Title         string          // required
+	Description   string          // required
+	Payload       string          // required
+	ProviderToken string          // must be empty for payments in stars
+	Currency      string          // required
+	Prices        *[]LabeledPrice // required
+	// SubscriptionPeriod is the number of seconds the subscription will
+	// be active for before the next payment. It must be 2592000 (30 days)
+	// and the invoice must be in stars.
+	//
+	// optional
+	SubscriptionPeriod int
+	// BusinessConnectionID is the business connection on behalf of which
+	// the link is created. Only links for subscriptions in stars can be
+	// created on behalf of business accounts.
+	//
+	// optional
+	BusinessConnectionID      string
+	MaxTipAmount              int
+	SuggestedTipAmounts       []int
+	ProviderData              string
+	PhotoURL                  string
+	PhotoSize                 int
+	PhotoWidth                int
+	PhotoHeight               int
+	NeedName                  bool
+	NeedPhoneNumber           bool
+	NeedEmail                 bool
+	NeedShippingAddress       bool
+	SendPhoneNumberToProvider bool
+	SendEmailToProvider       bool
+	IsFlexible                bool
+}
+
+// params returns a Params representation of InvoiceLinkConfig.
+func (config InvoiceLinkConfig) params() (Params, error) {
+	params := make(Params)
+
+	params["title"] = config.Title
+	params["description"] = config.Description
+	params["payload"] = config.Payload
+	params.AddNonEmpty("provider_token", config.ProviderToken)
+	params["currency"] = config.Currency
+	if err := params.AddInterface("prices", config.Prices); err != nil {
+		return params, err
+	}
+	params.AddNonZero("subscription_period", config.SubscriptionPeriod)
+	params.AddNonEmpty("business_connection_id", config.BusinessConnectionID)
+	params.AddNonZero("max_tip_amount", config.MaxTipAmount)
+	if err := params.AddInterface("suggested_tip_amounts", config.SuggestedTipAmounts); err != nil {
+		return params, err
+	}
+	params.AddNonEmpty("provider_data", config.ProviderData)
+	params.AddNonEmpty("photo_url", config.PhotoURL)
+	params.AddNonZero("photo_size", config.PhotoSize)
+	params.AddNonZero("photo_width", config.PhotoWidth)
+	params.AddNonZero("photo_height", config.PhotoHeight)
+	params.AddBool("need_name", config.NeedName)
+	params.AddBool("need_phone_number", config.NeedPhoneNumber)
+	params.AddBool("need_email", config.NeedEmail)
+	params.AddBool("need_shipping_address", config.NeedShippingAddress)
+	params.AddBool("send_phone_number_to_provider", config.SendPhoneNumberToProvider)
+	params.AddBool("send_email_to_provider", config.SendEmailToProvider)
+	params.AddBool("is_flexible", config.IsFlexible)
+
+	return params, nil
+}
+
+func (config InvoiceLinkConfig) method() string {
+	return "createInvoiceLink"
+}
+
+// ShippingConfig contains information for answerShippingQuery request.
 type ShippingConfig struct {
 	ShippingQueryID string // required
 	OK              bool   // required
@@ -1201,18 +2244,39 @@ func (config DeleteMessageConfig) method() string {
 	return "deleteMessage"
 }
 
-func (config DeleteMessageConfig) values() (url.Values, error) {
-	v := url.Values{}
+// params returns a Params representation of DeleteMessageConfig.
+func (config DeleteMessageConfig) params() (Params, error) {
+	params := make(Params)
+
+	err := params.AddFirstValid("chat_id", config.ChannelUsername, config.ChatID)
+	params.AddNonZero("message_id", config.MessageID)
 
-	if config.ChannelUsername == "" {
-		v.Add("chat_id", strconv.FormatInt(config.ChatID, 10))
-	} else {
-		v.Add("chat_id", config.ChannelUsername)
-	}
+	return params, err
+}
+
+// DeleteMessagesConfig contains information of up to 100 messages
+// in a chat to delete at once.
+type DeleteMessagesConfig struct {
+	ChannelUsername string
+	ChatID          int64
+	MessageIDs      []int
+}
+
+func (config DeleteMessagesConfig) method() string {
+	return "deleteMessages"
+}
+
+// params returns a Params representation of DeleteMessagesConfig.
+func (config DeleteMessagesConfig) params() (Params, error) {
+	params := make(Params)
 
-	v.Add("message_id", strconv.Itoa(config.MessageID))
+	err := params.AddFirstValid("chat_id", config.ChannelUsername, config.ChatID)
+	if err != nil {
+		return params, err
+	}
+	err = params.AddInterface("message_ids", config.MessageIDs)
 
-	return v, nil
+	return params, err
 }
 
 // PinChatMessageConfig contains information of a message in a chat to pin.
@@ -1226,31 +2290,88 @@ func (config PinChatMessageConfig) method() string {
 	return "pinChatMessage"
 }
 
-func (config PinChatMessageConfig) values() (url.Values, error) {
-	v := url.Values{}
+// params returns a Params representation of PinChatMessageConfig.
+func (config PinChatMessageConfig) params() (Params, error) {
+	params := make(Params)
 
-	v.Add("chat_id", strconv.FormatInt(config.ChatID, 10))
-	v.Add("message_id", strconv.Itoa(config.MessageID))
-	v.Add("disable_notification", strconv.FormatBool(config.DisableNotification))
+	params.AddNonZero64("chat_id", config.ChatID)
+	params.AddNonZero("message_id", config.MessageID)
+	params.AddBool("disable_notification", config.DisableNotification)
 
-	return v, nil
+	return params, nil
 }
 
 // UnpinChatMessageConfig contains information of chat to unpin.
 type UnpinChatMessageConfig struct {
 	ChatID int64
+	// MessageID is the message to unpin.
+	//
+	// optional, the most recent pinned message is unpinned if zero
+	MessageID int
 }
 
 func (config UnpinChatMessageConfig) method() string {
 	return "unpinChatMessage"
 }
 
-func (config UnpinChatMessageConfig) values() (url.Values, error) {
-	v := url.Values{}
+// params returns a Params representation of UnpinChatMessageConfig.
+func (config UnpinChatMessageConfig) params() (Params, error) {
+	params := make(Params)
+
+	params.AddNonZero64("chat_id", config.ChatID)
+	params.AddNonZero("message_id", config.MessageID)
+
+	return params, nil
+}
+
+// UnpinAllChatMessagesConfig contains information of chat to unpin
+// all the messages of.
+type UnpinAllChatMessagesConfig struct {
+	ChatID int64
+}
+
+func (config UnpinAllChatMessagesConfig) method() string {
+	return "unpinAllChatMessages"
+}
+
+// params returns a Params representation of UnpinAllChatMessagesConfig.
+func (config UnpinAllChatMessagesConfig) params() (Params, error) {
+	params := make(Params)
+
+	params.AddNonZero64("chat_id", config.ChatID)
+
+	return params, nil
+}
+
+// SetChatPermissionsConfig contains information for changing the default
+// permissions of all the members of a group or supergroup.
+type SetChatPermissionsConfig struct {
+	ChatID             int64
+	SuperGroupUsername string
+	Permissions        *ChatPermissions // required
+	// UseIndependentChatPermissions keeps each of the granular media
+	// permissions as it is. Otherwise, CanSendPolls implies CanSendMessages,
+	// and the media permissions imply CanSendMessages too, or
+	// CanSendOtherMessages and CanAddWebPagePreviews respectively.
+	UseIndependentChatPermissions bool
+}
+
+func (config SetChatPermissionsConfig) method() string {
+	return "setChatPermissions"
+}
 
-	v.Add("chat_id", strconv.FormatInt(config.ChatID, 10))
+// params returns a Params representation of SetChatPermissionsConfig.
+func (config SetChatPermissionsConfig) params() (Params, error) {
+	params := make(Params)
+
+	err := params.AddFirstValid("chat_id", config.SuperGroupUsername, config.ChatID)
+	if err != nil {
+		return params, err
+	}
+	params.AddBool("use_independent_chat_permissions", config.UseIndependentChatPermissions)
+	err = params.AddInterface("permissions", config.Permissions)
 
-	return v, nil
+	return params, err
 }
 
 // SetChatTitleConfig contains information for change chat title.
@@ -1263,13 +2384,14 @@ func (config SetChatTitleConfig) method() string {
 	return "setChatTitle"
 }
 
-func (config SetChatTitleConfig) values() (url.Values, error) {
-	v := url.Values{}
+// params returns a Params representation of SetChatTitleConfig.
+func (config SetChatTitleConfig) params() (Params, error) {
+	params := make(Params)
 
-	v.Add("chat_id", strconv.FormatInt(config.ChatID, 10))
-	v.Add("title", config.Title)
+	params.AddNonZero64("chat_id", config.ChatID)
+	params["title"] = config.Title
 
-	return v, nil
+	return params, nil
 }
 
 // SetChatDescriptionConfig contains information for change chat description.
@@ -1282,13 +2404,14 @@ func (config SetChatDescriptionConfig) method() string {
 	return "setChatDescription"
 }
 
-func (config SetChatDescriptionConfig) values() (url.Values, error) {
-	v := url.Values{}
+// params returns a Params representation of SetChatDescriptionConfig.
+func (config SetChatDescriptionConfig) params() (Params, error) {
+	params := make(Params)
 
-	v.Add("chat_id", strconv.FormatInt(config.ChatID, 10))
-	v.Add("description", config.Description)
+	params.AddNonZero64("chat_id", config.ChatID)
+	params["description"] = config.Description
 
-	return v, nil
+	return params, nil
 }
 
 // SetChatPhotoConfig contains information for change chat photo
@@ -1315,12 +2438,13 @@ func (config DeleteChatPhotoConfig) method() string {
 	return "deleteChatPhoto"
 }
 
-func (config DeleteChatPhotoConfig) values() (url.Values, error) {
-	v := url.Values{}
+// params returns a Params representation of DeleteChatPhotoConfig.
+func (config DeleteChatPhotoConfig) params() (Params, error) {
+	params := make(Params)
 
-	v.Add("chat_id", strconv.FormatInt(config.ChatID, 10))
+	params.AddNonZero64("chat_id", config.ChatID)
 
-	return v, nil
+	return params, nil
 }
 
 // GetStickerSetConfig contains information for get sticker set.
@@ -1332,35 +2456,1761 @@ func (config GetStickerSetConfig) method() string {
 	return "getStickerSet"
 }
 
-func (config GetStickerSetConfig) values() (url.Values, error) {
-	v := url.Values{}
-	v.Add("name", config.Name)
-	return v, nil
+// params returns a Params representation of GetStickerSetConfig.
+func (config GetStickerSetConfig) params() (Params, error) {
+	params := make(Params)
+
+	params["name"] = config.Name
+
+	return params, nil
 }
 
-// DiceConfig contains information about a sendDice request.
-type DiceConfig struct {
-	BaseChat
-	// Emoji on which the dice throw animation is based.
-	// Currently, must be one of “🎲”, “🎯”, or “🏀”.
-	// Dice can have values 1-6 for “🎲” and “🎯”, and values 1-5 for “🏀”.
-	// Defaults to “🎲”
-	Emoji string
+// GetCustomEmojiStickersConfig gets information about custom emoji stickers.
+type GetCustomEmojiStickersConfig struct {
+	CustomEmojiIDs []string // required, up to 200 identifiers
+}
+
+func (config GetCustomEmojiStickersConfig) method() string {
+	return "getCustomEmojiStickers"
+}
+
+// params returns a Params representation of GetCustomEmojiStickersConfig.
+func (config GetCustomEmojiStickersConfig) params() (Params, error) {
+	params := make(Params)
+
+	err := params.AddInterface("custom_emoji_ids", config.CustomEmojiIDs)
+
+	return params, err
+}
+
+// Constant values for the formats of stickers.
+const (
+	StickerFormatStatic   = "static"
+	StickerFormatAnimated = "animated"
+	StickerFormatVideo    = "video"
+)
+
+// Constant values for the types of stickers and sticker sets.
+const (
+	StickerTypeRegular     = "regular"
+	StickerTypeMask        = "mask"
+	StickerTypeCustomEmoji = "custom_emoji"
+)
+
+// UploadStickerFileConfig uploads a sticker file for later use in
+// CreateNewStickerSetConfig, AddStickerToSetConfig or ReplaceStickerInSetConfig.
+type UploadStickerFileConfig struct {
+	UserID int // required
+	// Sticker is the file to upload, as a file path, FileBytes, FileReader
+	// or RequestFileData. It is a WEBP, PNG, TGS or WEBM file.
+	Sticker       interface{} // required
+	StickerFormat string      // required
+}
+
+func (config UploadStickerFileConfig) method() string {
+	return "uploadStickerFile"
+}
+
+// params returns a Params representation of UploadStickerFileConfig.
+func (config UploadStickerFileConfig) params() (Params, error) {
+	params := make(Params)
+
+	params.AddNonZero("user_id", config.UserID)
+	params["sticker_format"] = config.StickerFormat
+
+	return params, nil
 }
 
-// values returns a url.Values representation of DiceConfig.
-func (config DiceConfig) values() (url.Values, error) {
-	v, err := config.BaseChat.values()
+// files returns the sticker file.
+func (config UploadStickerFileConfig) files() ([]RequestFile, error) {
+	data, err := fileData(config.Sticker)
 	if err != nil {
-		return v, err
+		return nil, err
 	}
-	if config.Emoji != "" {
-		v.Add("emoji", config.Emoji)
-	}
-	return v, nil
+
+	return []RequestFile{{Name: "sticker", Data: data}}, nil
 }
 
-// method returns Telegram API method name for sending Dice.
-func (config DiceConfig) method() string {
+// CreateNewStickerSetConfig creates a new sticker set owned by a user.
+type CreateNewStickerSetConfig struct {
+	UserID int // required
+	// Name of the set, used in t.me/addstickers/ URLs. It can only contain
+	// letters, digits and underscores, and must end with "_by_<bot username>".
+	Name     string         // required
+	Title    string         // required
+	Stickers []InputSticker // required, 1-50 stickers
+	// StickerType is the type of the stickers in the set,
+	// StickerTypeRegular by default.
+	StickerType string
+	// NeedsRepainting repaints the custom emoji of the set in the color of
+	// the text, for custom emoji sticker sets only.
+	NeedsRepainting bool
+}
+
+func (config CreateNewStickerSetConfig) method() string {
+	return "createNewStickerSet"
+}
+
+// params returns a Params representation of CreateNewStickerSetConfig.
+func (config CreateNewStickerSetConfig) params() (Params, error) {
+	params := make(Params)
+
+	stickers, _, err := config.inputStickers()
+	if err != nil {
+		return params, err
+	}
+
+	params.AddNonZero("user_id", config.UserID)
+	params["name"] = config.Name
+	params["title"] = config.Title
+	params.AddNonEmpty("sticker_type", config.StickerType)
+	params.AddBool("needs_repainting", config.NeedsRepainting)
+	err = params.AddInterface("stickers", stickers)
+
+	return params, err
+}
+
+// files returns the files uploaded with the stickers.
+func (config CreateNewStickerSetConfig) files() ([]RequestFile, error) {
+	_, files, err := config.inputStickers()
+	return files, err
+}
+
+// inputStickers returns the stickers with the files to upload replaced
+// with attach:// references, and the files.
+func (config CreateNewStickerSetConfig) inputStickers() ([]interface{}, []RequestFile, error) {
+	var files []RequestFile
+	stickers := make([]interface{}, len(config.Stickers))
+	for i, sticker := range config.Stickers {
+		prepared, stickerFiles, err := sticker.prepare("file-" + strconv.Itoa(i))
+		if err != nil {
+			return nil, nil, err
+		}
+		stickers[i] = prepared
+		files = append(files, stickerFiles...)
+	}
+
+	return stickers, files, nil
+}
+
+// AddStickerToSetConfig adds a sticker to a set created by the bot.
+type AddStickerToSetConfig struct {
+	UserID  int          // required
+	Name    string       // required
+	Sticker InputSticker // required
+}
+
+func (config AddStickerToSetConfig) method() string {
+	return "addStickerToSet"
+}
+
+// params returns a Params representation of AddStickerToSetConfig.
+func (config AddStickerToSetConfig) params() (Params, error) {
+	params := make(Params)
+
+	sticker, _, err := config.Sticker.prepare("sticker-file")
+	if err != nil {
+		return params, err
+	}
+
+	params.AddNonZero("user_id", config.UserID)
+	params["name"] = config.Name
+	err = params.AddInterface("sticker", sticker)
+
+	return params, err
+}
+
+// files returns the file uploaded with the sticker.
+func (config AddStickerToSetConfig) files() ([]RequestFile, error) {
+	_, files, err := config.Sticker.prepare("sticker-file")
+	return files, err
+}
+
+// SetStickerPositionInSetConfig moves a sticker in a set created by the bot.
+type SetStickerPositionInSetConfig struct {
+	// Sticker is the file_id of the sticker.
+	Sticker string // required
+	// Position is the new zero-based position of the sticker in the set.
+	Position int
+}
+
+func (config SetStickerPositionInSetConfig) method() string {
+	return "setStickerPositionInSet"
+}
+
+// params returns a Params representation of SetStickerPositionInSetConfig.
+func (config SetStickerPositionInSetConfig) params() (Params, error) {
+	params := make(Params)
+
+	params["sticker"] = config.Sticker
+	params["position"] = strconv.Itoa(config.Position)
+
+	return params, nil
+}
+
+// DeleteStickerFromSetConfig deletes a sticker from a set created by the bot.
+type DeleteStickerFromSetConfig struct {
+	// Sticker is the file_id of the sticker.
+	Sticker string // required
+}
+
+func (config DeleteStickerFromSetConfig) method() string {
+	return "deleteStickerFromSet"
+}
+
+// params returns a Params representation of DeleteStickerFromSetConfig.
+func (config DeleteStickerFromSetConfig) params() (Params, error) {
+	params := make(Params)
+
+	params["sticker"] = config.Sticker
+
+	return params, nil
+}
+
+// ReplaceStickerInSetConfig replaces a sticker in a set created by the bot.
+// It is equivalent to deleting the sticker, adding the new one, and moving
+// it to the position of the old one.
+type ReplaceStickerInSetConfig struct {
+	UserID int    // required
+	Name   string // required
+	// OldSticker is the file_id of the replaced sticker.
+	OldSticker string       // required
+	Sticker    InputSticker // required
+}
+
+func (config ReplaceStickerInSetConfig) method() string {
+	return "replaceStickerInSet"
+}
+
+// params returns a Params representation of ReplaceStickerInSetConfig.
+func (config ReplaceStickerInSetConfig) params() (Params, error) {
+	params := make(Params)
+
+	sticker, _, err := config.Sticker.prepare("sticker-file")
+	if err != nil {
+		return params, err
+	}
+
+	params.AddNonZero("user_id", config.UserID)
+	params["name"] = config.Name
+	params["old_sticker"] = config.OldSticker
+	err = params.AddInterface("sticker", sticker)
+
+	return params, err
+}
+
+// files returns the file uploaded with the sticker.
+func (config ReplaceStickerInSetConfig) files() ([]RequestFile, error) {
+	_, files, err := config.Sticker.prepare("sticker-file")
+	return files, err
+}
+
+// SetStickerEmojiListConfig changes the emoji associated with a regular or
+// custom emoji sticker in a set created by the bot.
+type SetStickerEmojiListConfig struct {
+	// Sticker is the file_id of the sticker.
+	Sticker   string   // required
+	EmojiList []string // required, 1-20 emoji
+}
+
+func (config SetStickerEmojiListConfig) method() string {
+	return "setStickerEmojiList"
+}
+
+// params returns a Params representation of SetStickerEmojiListConfig.
+func (config SetStickerEmojiListConfig) params() (Params, error) {
+	params := make(Params)
+
+	params["sticker"] = config.Sticker
+	err := params.AddInterface("emoji_list", config.EmojiList)
+
+	return params, err
+}
+
+// SetStickerKeywordsConfig changes the search keywords of a regular or
+// custom emoji sticker in a set created by the bot.
+type SetStickerKeywordsConfig struct {
+	// Sticker is the file_id of the sticker.
+	Sticker string // required
+	// Keywords are 0-20 search keywords, with a total length of up to
+	// 64 characters. The keywords are removed if it is empty.
+	Keywords []string
+}
+
+func (config SetStickerKeywordsConfig) method() string {
+	return "setStickerKeywords"
+}
+
+// params returns a Params representation of SetStickerKeywordsConfig.
+func (config SetStickerKeywordsConfig) params() (Params, error) {
+	params := make(Params)
+
+	params["sticker"] = config.Sticker
+	err := params.AddInterface("keywords", config.Keywords)
+
+	return params, err
+}
+
+// SetStickerMaskPositionConfig changes the mask position of a mask sticker
+// in a set created by the bot.
+type SetStickerMaskPositionConfig struct {
+	// Sticker is the file_id of the sticker.
+	Sticker string // required
+	// MaskPosition is the new position of the mask.
+	// The mask position is removed if it is nil.
+	MaskPosition *MaskPosition
+}
+
+func (config SetStickerMaskPositionConfig) method() string {
+	return "setStickerMaskPosition"
+}
+
+// params returns a Params representation of SetStickerMaskPositionConfig.
+func (config SetStickerMaskPositionConfig) params() (Params, error) {
+	params := make(Params)
+
+	params["sticker"] = config.Sticker
+	err := params.AddInterface("mask_position", config.MaskPosition)
+
+	return params, err
+}
+
+// SetStickerSetTitleConfig changes the title of a set created by the bot.
+type SetStickerSetTitleConfig struct {
+	Name  string // required
+	Title string // required
+}
+
+func (config SetStickerSetTitleConfig) method() string {
+	return "setStickerSetTitle"
+}
+
+// params returns a Params representation of SetStickerSetTitleConfig.
+func (config SetStickerSetTitleConfig) params() (Params, error) {
+	params := make(Params)
+
+	params["name"] = config.Name
+	params["title"] = config.Title
+
+	return params, nil
+}
+
+// SetStickerSetThumbnailConfig changes the thumbnail of a regular or mask
+// sticker set created by the bot.
+type SetStickerSetThumbnailConfig struct {
+	Name   string // required
+	UserID int    // required
+	// Thumbnail is the file_id of an existing file, an HTTP URL, or a file
+	// to upload as a file path, FileBytes, FileReader or RequestFileData.
+	// Its format must match Format.
+	//
+	// optional, the first sticker is used as the thumbnail if nil
+	Thumbnail interface{}
+	Format    string // required
+}
+
+func (config SetStickerSetThumbnailConfig) method() string {
+	return "setStickerSetThumbnail"
+}
+
+// params returns a Params representation of SetStickerSetThumbnailConfig.
+func (config SetStickerSetThumbnailConfig) params() (Params, error) {
+	params := make(Params)
+
+	params["name"] = config.Name
+	params.AddNonZero("user_id", config.UserID)
+	params["format"] = config.Format
+
+	return params, nil
+}
+
+// files returns the thumbnail, if any.
+func (config SetStickerSetThumbnailConfig) files() ([]RequestFile, error) {
+	if config.Thumbnail == nil {
+		return nil, nil
+	}
+
+	data, err := fileData(config.Thumbnail)
+	if err != nil {
+		return nil, err
+	}
+
+	return []RequestFile{{Name: "thumbnail", Data: data}}, nil
+}
+
+// DeleteStickerSetConfig deletes a sticker set created by the bot.
+type DeleteStickerSetConfig struct {
+	Name string // required
+}
+
+func (config DeleteStickerSetConfig) method() string {
+	return "deleteStickerSet"
+}
+
+// params returns a Params representation of DeleteStickerSetConfig.
+func (config DeleteStickerSetConfig) params() (Params, error) {
+	params := make(Params)
+
+	params["name"] = config.Name
+
+	return params, nil
+}
+
+// Emoji on which the dice throw animation of sendDice is based.
+const (
+	// DiceEmoji is a die, with values 1-6.
+	DiceEmoji = "🎲"
+	// DartsEmoji is a dart board, with values 1-6.
+	DartsEmoji = "🎯"
+	// BasketballEmoji is a basketball, with values 1-5.
+	BasketballEmoji = "🏀"
+	// FootballEmoji is a football, with values 1-5.
+	FootballEmoji = "⚽"
+	// BowlingEmoji is a bowling alley, with values 1-6.
+	BowlingEmoji = "🎳"
+	// SlotMachineEmoji is a slot machine, with values 1-64.
+	SlotMachineEmoji = "🎰"
+)
+
+// DiceConfig contains information about a sendDice request.
+type DiceConfig struct {
+	BaseChat
+	// Emoji on which the dice throw animation is based.
+	// Must be one of DiceEmoji, DartsEmoji, BasketballEmoji,
+	// FootballEmoji, BowlingEmoji or SlotMachineEmoji.
+	// Defaults to DiceEmoji
+	Emoji string
+}
+
+// params returns a Params representation of DiceConfig.
+func (config DiceConfig) params() (Params, error) {
+	params, err := config.BaseChat.params()
+	if err != nil {
+		return params, err
+	}
+
+	params.AddNonEmpty("emoji", config.Emoji)
+
+	return params, nil
+}
+
+// method returns Telegram API method name for sending Dice.
+func (config DiceConfig) method() string {
 	return "sendDice"
 }
+
+// Constant values for the types of BotCommandScope.
+const (
+	BotCommandScopeTypeDefault               = "default"
+	BotCommandScopeTypeAllPrivateChats       = "all_private_chats"
+	BotCommandScopeTypeAllGroupChats         = "all_group_chats"
+	BotCommandScopeTypeAllChatAdministrators = "all_chat_administrators"
+	BotCommandScopeTypeChat                  = "chat"
+	BotCommandScopeTypeChatAdministrators    = "chat_administrators"
+	BotCommandScopeTypeChatMember            = "chat_member"
+)
+
+// GetMyCommandsConfig gets the list of the bot's commands
+// for a scope and language.
+type GetMyCommandsConfig struct {
+	// Scope of the commands.
+	//
+	// optional, the default scope is used if nil
+	Scope *BotCommandScope
+	// LanguageCode is a two-letter ISO 639-1 language code.
+	//
+	// optional, the commands for users without a dedicated
+	// language are used if empty
+	LanguageCode string
+}
+
+// params returns a Params representation of GetMyCommandsConfig.
+func (config GetMyCommandsConfig) params() (Params, error) {
+	params := make(Params)
+
+	params.AddNonEmpty("language_code", config.LanguageCode)
+	err := params.AddInterface("scope", config.Scope)
+
+	return params, err
+}
+
+func (config GetMyCommandsConfig) method() string {
+	return "getMyCommands"
+}
+
+// SetMyCommandsConfig changes the list of the bot's commands
+// for a scope and language.
+type SetMyCommandsConfig struct {
+	// Commands are the bot commands, at most 100.
+	Commands []BotCommand
+	// Scope of the commands.
+	//
+	// optional, the default scope is used if nil
+	Scope *BotCommandScope
+	// LanguageCode is a two-letter ISO 639-1 language code.
+	//
+	// optional, the commands apply to all the users of the scope
+	// without a dedicated language if empty
+	LanguageCode string
+}
+
+// params returns a Params representation of SetMyCommandsConfig.
+func (config SetMyCommandsConfig) params() (Params, error) {
+	params := make(Params)
+
+	commands := config.Commands
+	if commands == nil {
+		commands = []BotCommand{}
+	}
+	if err := params.AddInterface("commands", commands); err != nil {
+		return params, err
+	}
+	params.AddNonEmpty("language_code", config.LanguageCode)
+	err := params.AddInterface("scope", config.Scope)
+
+	return params, err
+}
+
+func (config SetMyCommandsConfig) method() string {
+	return "setMyCommands"
+}
+
+// DeleteMyCommandsConfig deletes the list of the bot's commands
+// for a scope and language, so the commands of a broader scope
+// are shown instead.
+type DeleteMyCommandsConfig struct {
+	// Scope of the commands.
+	//
+	// optional, the default scope is used if nil
+	Scope *BotCommandScope
+	// LanguageCode is a two-letter ISO 639-1 language code.
+	//
+	// optional
+	LanguageCode string
+}
+
+// params returns a Params representation of DeleteMyCommandsConfig.
+func (config DeleteMyCommandsConfig) params() (Params, error) {
+	params := make(Params)
+
+	params.AddNonEmpty("language_code", config.LanguageCode)
+	err := params.AddInterface("scope", config.Scope)
+
+	return params, err
+}
+
+func (config DeleteMyCommandsConfig) method() string {
+	return "deleteMyCommands"
+}
+
+// SetMyNameConfig changes the bot's name.
+type SetMyNameConfig struct {
+	// Name is the new name, 0-64 characters.
+	//
+	// optional, an empty name removes the dedicated one
+	// of the language
+	Name string
+	// LanguageCode is a two-letter ISO 639-1 language code.
+	//
+	// optional, the name is shown to all the users
+	// without a dedicated one if empty
+	LanguageCode string
+}
+
+// params returns a Params representation of SetMyNameConfig.
+func (config SetMyNameConfig) params() (Params, error) {
+	params := make(Params)
+
+	params.AddNonEmpty("name", config.Name)
+	params.AddNonEmpty("language_code", config.LanguageCode)
+
+	return params, nil
+}
+
+func (config SetMyNameConfig) method() string {
+	return "setMyName"
+}
+
+// GetMyNameConfig gets the bot's name for a language.
+type GetMyNameConfig struct {
+	// LanguageCode is a two-letter ISO 639-1 language code.
+	//
+	// optional
+	LanguageCode string
+}
+
+// params returns a Params representation of GetMyNameConfig.
+func (config GetMyNameConfig) params() (Params, error) {
+	params := make(Params)
+
+	params.AddNonEmpty("language_code", config.LanguageCode)
+
+	return params, nil
+}
+
+func (config GetMyNameConfig) method() string {
+	return "getMyName"
+}
+
+// SetMyDescriptionConfig changes the bot's description,
+// shown in the chat with the bot if the chat is empty.
+type SetMyDescriptionConfig struct {
+	// Description is the new description, 0-512 characters.
+	//
+	// optional, an empty description removes the dedicated one
+	// of the language
+	Description string
+	// LanguageCode is a two-letter ISO 639-1 language code.
+	//
+	// optional, the description is shown to all the users
+	// without a dedicated one if empty
+	LanguageCode string
+}
+
+// params returns a Params representation of SetMyDescriptionConfig.
+func (config SetMyDescriptionConfig) params() (Params, error) {
+	params := make(Params)
+
+	params.AddNonEmpty("description", config.Description)
+	params.AddNonEmpty("language_code", config.LanguageCode)
+
+	return params, nil
+}
+
+func (config SetMyDescriptionConfig) method() string {
+	return "setMyDescription"
+}
+
+// GetMyDescriptionConfig gets the bot's description for a language.
+type GetMyDescriptionConfig struct {
+	// LanguageCode is a two-letter ISO 639-1 language code.
+	//
+	// optional
+	LanguageCode string
+}
+
+// params returns a Params representation of GetMyDescriptionConfig.
+func (config GetMyDescriptionConfig) params() (Params, error) {
+	params := make(Params)
+
+	params.AddNonEmpty("language_code", config.LanguageCode)
+
+	return params, nil
+}
+
+func (config GetMyDescriptionConfig) method() string {
+	return "getMyDescription"
+}
+
+// SetMyShortDescriptionConfig changes the bot's short description,
+// shown on the bot's profile page.
+type SetMyShortDescriptionConfig struct {
+	// ShortDescription is the new short description, 0-120 characters.
+	//
+	// optional, an empty short description removes the dedicated one
+	// of the language
+	ShortDescription string
+	// LanguageCode is a two-letter ISO 639-1 language code.
+	//
+	// optional, the short description is shown to all the users
+	// without a dedicated one if empty
+	LanguageCode string
+}
+
+// params returns a Params representation of SetMyShortDescriptionConfig.
+func (config SetMyShortDescriptionConfig) params() (Params, error) {
+	params := make(Params)
+
+	params.AddNonEmpty("short_description", config.ShortDescription)
+	params.AddNonEmpty("language_code", config.LanguageCode)
+
+	return params, nil
+}
+
+func (config SetMyShortDescriptionConfig) method() string {
+	return "setMyShortDescription"
+}
+
+// GetMyShortDescriptionConfig gets the bot's short description for a language.
+type GetMyShortDescriptionConfig struct {
+	// LanguageCode is a two-letter ISO 639-1 language code.
+	//
+	// optional
+	LanguageCode string
+}
+
+// params returns a Params representation of GetMyShortDescriptionConfig.
+func (config GetMyShortDescriptionConfig) params() (Params, error) {
+	params := make(Params)
+
+	params.AddNonEmpty("language_code", config.LanguageCode)
+
+	return params, nil
+}
+
+func (config GetMyShortDescriptionConfig) method() string {
+	return "getMyShortDescription"
+}
+
+// Constant values for the types of MenuButton.
+const (
+	MenuButtonTypeCommands = "commands"
+	MenuButtonTypeWebApp   = "web_app"
+	MenuButtonTypeDefault  = "default"
+)
+
+// SetChatMenuButtonConfig changes the bot's menu button
+// in a private chat, or the default menu button.
+type SetChatMenuButtonConfig struct {
+	// ChatID is the private chat of the button.
+	//
+	// optional, the default menu button is changed if zero
+	ChatID int64
+	// MenuButton is the new button.
+	//
+	// optional, the default button is used if nil
+	MenuButton *MenuButton
+}
+
+// params returns a Params representation of SetChatMenuButtonConfig.
+func (config SetChatMenuButtonConfig) params() (Params, error) {
+	params := make(Params)
+
+	params.AddNonZero64("chat_id", config.ChatID)
+	err := params.AddInterface("menu_button", config.MenuButton)
+
+	return params, err
+}
+
+func (config SetChatMenuButtonConfig) method() string {
+	return "setChatMenuButton"
+}
+
+// GetChatMenuButtonConfig gets the bot's menu button
+// in a private chat, or the default menu button.
+type GetChatMenuButtonConfig struct {
+	// ChatID is the private chat of the button.
+	//
+	// optional, the default menu button is returned if zero
+	ChatID int64
+}
+
+// params returns a Params representation of GetChatMenuButtonConfig.
+func (config GetChatMenuButtonConfig) params() (Params, error) {
+	params := make(Params)
+
+	params.AddNonZero64("chat_id", config.ChatID)
+
+	return params, nil
+}
+
+func (config GetChatMenuButtonConfig) method() string {
+	return "getChatMenuButton"
+}
+
+// SetMyDefaultAdministratorRightsConfig changes the default administrator
+// rights requested by the bot when it is added as an administrator to
+// groups or channels. These rights are suggested to users, but they are
+// free to modify the list before adding the bot.
+type SetMyDefaultAdministratorRightsConfig struct {
+	// Rights are the new default administrator rights.
+	//
+	// optional, the default rights are cleared if nil
+	Rights *ChatAdministratorRights
+	// ForChannels changes the default administrator rights
+	// of the bot in channels, rather than in groups and supergroups.
+	ForChannels bool
+}
+
+// params returns a Params representation of SetMyDefaultAdministratorRightsConfig.
+func (config SetMyDefaultAdministratorRightsConfig) params() (Params, error) {
+	params := make(Params)
+
+	params.AddBool("for_channels", config.ForChannels)
+	err := params.AddInterface("rights", config.Rights)
+
+	return params, err
+}
+
+func (config SetMyDefaultAdministratorRightsConfig) method() string {
+	return "setMyDefaultAdministratorRights"
+}
+
+// GetMyDefaultAdministratorRightsConfig gets the default administrator
+// rights of the bot.
+type GetMyDefaultAdministratorRightsConfig struct {
+	// ForChannels gets the default administrator rights of the bot
+	// in channels, rather than in groups and supergroups.
+	ForChannels bool
+}
+
+// params returns a Params representation of GetMyDefaultAdministratorRightsConfig.
+func (config GetMyDefaultAdministratorRightsConfig) params() (Params, error) {
+	params := make(Params)
+
+	params.AddBool("for_channels", config.ForChannels)
+
+	return params, nil
+}
+
+func (config GetMyDefaultAdministratorRightsConfig) method() string {
+	return "getMyDefaultAdministratorRights"
+}
+
+// Constant values for the colors of forum topic icons, in RGB format.
+const (
+	ForumTopicIconColorBlue   = 0x6FB9F0
+	ForumTopicIconColorYellow = 0xFFD67E
+	ForumTopicIconColorViolet = 0xCB86DB
+	ForumTopicIconColorGreen  = 0x8EEE98
+	ForumTopicIconColorRose   = 0xFF93B2
+	ForumTopicIconColorRed    = 0xFB6F5F
+)
+
+// BaseForumTopic is a base type for the configs managing a forum topic.
+type BaseForumTopic struct {
+	ChatConfig
+	MessageThreadID int // required
+}
+
+// params returns a Params representation of BaseForumTopic.
+func (topic BaseForumTopic) params() (Params, error) {
+	params, err := topic.ChatConfig.params()
+	if err != nil {
+		return params, err
+	}
+
+	params.AddNonZero("message_thread_id", topic.MessageThreadID)
+
+	return params, nil
+}
+
+// CreateForumTopicConfig contains information for creating a topic
+// in a forum supergroup chat.
+type CreateForumTopicConfig struct {
+	ChatConfig
+	Name string // required
+	// IconColor is one of the ForumTopicIconColor constants.
+	IconColor int
+	// IconCustomEmojiID is a custom emoji from GetForumTopicIconStickers.
+	IconCustomEmojiID string
+}
+
+func (config CreateForumTopicConfig) method() string {
+	return "createForumTopic"
+}
+
+// params returns a Params representation of CreateForumTopicConfig.
+func (config CreateForumTopicConfig) params() (Params, error) {
+	params, err := config.ChatConfig.params()
+	if err != nil {
+		return params, err
+	}
+
+	params["name"] = config.Name
+	params.AddNonZero("icon_color", config.IconColor)
+	params.AddNonEmpty("icon_custom_emoji_id", config.IconCustomEmojiID)
+
+	return params, nil
+}
+
+// EditForumTopicConfig contains information for editing the name and icon
+// of a forum topic.
+type EditForumTopicConfig struct {
+	BaseForumTopic
+	// Name is the new name of the topic.
+	//
+	// optional, the name is kept if empty
+	Name string
+	// IconCustomEmojiID is the new custom emoji of the topic icon,
+	// or an empty string to remove the icon.
+	//
+	// optional, the icon is kept if nil
+	IconCustomEmojiID *string
+}
+
+func (config EditForumTopicConfig) method() string {
+	return "editForumTopic"
+}
+
+// params returns a Params representation of EditForumTopicConfig.
+func (config EditForumTopicConfig) params() (Params, error) {
+	params, err := config.BaseForumTopic.params()
+	if err != nil {
+		return params, err
+	}
+
+	params.AddNonEmpty("name", config.Name)
+	if config.IconCustomEmojiID != nil {
+		params["icon_custom_emoji_id"] = *config.IconCustomEmojiID
+	}
+
+	return params, nil
+}
+
+// CloseForumTopicConfig contains information for closing an open forum topic.
+type CloseForumTopicConfig struct {
+	BaseForumTopic
+}
+
+func (config CloseForumTopicConfig) method() string {
+	return "closeForumTopic"
+}
+
+// ReopenForumTopicConfig contains information for reopening a closed
+// forum topic.
+type ReopenForumTopicConfig struct {
+	BaseForumTopic
+}
+
+func (config ReopenForumTopicConfig) method() string {
+	return "reopenForumTopic"
+}
+
+// DeleteForumTopicConfig contains information for deleting a forum topic
+// along with all its messages.
+type DeleteForumTopicConfig struct {
+	BaseForumTopic
+}
+
+func (config DeleteForumTopicConfig) method() string {
+	return "deleteForumTopic"
+}
+
+// UnpinAllForumTopicMessagesConfig contains information for clearing
+// the list of pinned messages in a forum topic.
+type UnpinAllForumTopicMessagesConfig struct {
+	BaseForumTopic
+}
+
+func (config UnpinAllForumTopicMessagesConfig) method() string {
+	return "unpinAllForumTopicMessages"
+}
+
+// EditGeneralForumTopicConfig contains information for renaming
+// the General topic of a forum.
+type EditGeneralForumTopicConfig struct {
+	ChatConfig
+	Name string // required
+}
+
+func (config EditGeneralForumTopicConfig) method() string {
+	return "editGeneralForumTopic"
+}
+
+// params returns a Params representation of EditGeneralForumTopicConfig.
+func (config EditGeneralForumTopicConfig) params() (Params, error) {
+	params, err := config.ChatConfig.params()
+	if err != nil {
+		return params, err
+	}
+
+	params["name"] = config.Name
+
+	return params, nil
+}
+
+// CloseGeneralForumTopicConfig contains information for closing
+// the General topic of a forum.
+type CloseGeneralForumTopicConfig struct {
+	ChatConfig
+}
+
+func (config CloseGeneralForumTopicConfig) method() string {
+	return "closeGeneralForumTopic"
+}
+
+// ReopenGeneralForumTopicConfig contains information for reopening
+// the General topic of a forum. It is unhidden if it was hidden.
+type ReopenGeneralForumTopicConfig struct {
+	ChatConfig
+}
+
+func (config ReopenGeneralForumTopicConfig) method() string {
+	return "reopenGeneralForumTopic"
+}
+
+// HideGeneralForumTopicConfig contains information for hiding
+// the General topic of a forum. It is closed if it was open.
+type HideGeneralForumTopicConfig struct {
+	ChatConfig
+}
+
+func (config HideGeneralForumTopicConfig) method() string {
+	return "hideGeneralForumTopic"
+}
+
+// UnhideGeneralForumTopicConfig contains information for unhiding
+// the General topic of a forum.
+type UnhideGeneralForumTopicConfig struct {
+	ChatConfig
+}
+
+func (config UnhideGeneralForumTopicConfig) method() string {
+	return "unhideGeneralForumTopic"
+}
+
+// UnpinAllGeneralForumTopicMessagesConfig contains information for clearing
+// the list of pinned messages in the General topic of a forum.
+type UnpinAllGeneralForumTopicMessagesConfig struct {
+	ChatConfig
+}
+
+func (config UnpinAllGeneralForumTopicMessagesConfig) method() string {
+	return "unpinAllGeneralForumTopicMessages"
+}
+
+// Constant values for the types of ReactionType.
+const (
+	ReactionTypeEmoji       = "emoji"
+	ReactionTypeCustomEmoji = "custom_emoji"
+	ReactionTypePaid        = "paid"
+)
+
+// SetMessageReactionConfig changes the chosen reactions of the bot on a message.
+type SetMessageReactionConfig struct {
+	ChatID          int64
+	ChannelUsername string
+	MessageID       int // required
+	// Reaction is the new list of reactions of the bot. Bots can only set
+	// one reaction, unless they are administrators, and can only use the
+	// reactions allowed in the chat. The paid reaction can't be used.
+	//
+	// optional, the reactions are removed if empty
+	Reaction []ReactionType
+	// IsBig sets the reaction with a big animation.
+	IsBig bool
+}
+
+func (config SetMessageReactionConfig) method() string {
+	return "setMessageReaction"
+}
+
+// params returns a Params representation of SetMessageReactionConfig.
+func (config SetMessageReactionConfig) params() (Params, error) {
+	params := make(Params)
+
+	err := params.AddFirstValid("chat_id", config.ChannelUsername, config.ChatID)
+	if err != nil {
+		return params, err
+	}
+	params.AddNonZero("message_id", config.MessageID)
+	params.AddBool("is_big", config.IsBig)
+	err = params.AddInterface("reaction", config.Reaction)
+
+	return params, err
+}
+
+// Constant values for the sources of ChatBoostSource.
+const (
+	ChatBoostSourcePremium  = "premium"
+	ChatBoostSourceGiftCode = "gift_code"
+	ChatBoostSourceGiveaway = "giveaway"
+)
+
+// GetUserChatBoostsConfig gets the list of boosts added to a chat by a user.
+type GetUserChatBoostsConfig struct {
+	ChatID          int64
+	ChannelUsername string
+	UserID          int // required
+}
+
+func (config GetUserChatBoostsConfig) method() string {
+	return "getUserChatBoosts"
+}
+
+// params returns a Params representation of GetUserChatBoostsConfig.
+func (config GetUserChatBoostsConfig) params() (Params, error) {
+	params := make(Params)
+
+	err := params.AddFirstValid("chat_id", config.ChannelUsername, config.ChatID)
+	params.AddNonZero("user_id", config.UserID)
+
+	return params, err
+}
+
+// GetBusinessConnectionConfig gets information about the connection
+// of the bot with a business account.
+type GetBusinessConnectionConfig struct {
+	BusinessConnectionID string // required
+}
+
+func (config GetBusinessConnectionConfig) method() string {
+	return "getBusinessConnection"
+}
+
+// params returns a Params representation of GetBusinessConnectionConfig.
+func (config GetBusinessConnectionConfig) params() (Params, error) {
+	params := make(Params)
+
+	params["business_connection_id"] = config.BusinessConnectionID
+
+	return params, nil
+}
+
+// BaseBusiness is base type of the configs managing a business account
+// on behalf of which the bot acts.
+type BaseBusiness struct {
+	BusinessConnectionID string // required
+}
+
+// params returns a Params representation of BaseBusiness.
+func (business BaseBusiness) params() (Params, error) {
+	params := make(Params)
+
+	params["business_connection_id"] = business.BusinessConnectionID
+
+	return params, nil
+}
+
+// ReadBusinessMessageConfig marks a message as read on behalf of a business
+// account. The bot must have the can_read_messages business bot right.
+type ReadBusinessMessageConfig struct {
+	BaseBusiness
+	// ChatID is the chat in which the message was received. The chat must
+	// have been active in the last 24 hours.
+	ChatID    int64 // required
+	MessageID int   // required
+}
+
+func (config ReadBusinessMessageConfig) method() string {
+	return "readBusinessMessage"
+}
+
+// params returns a Params representation of ReadBusinessMessageConfig.
+func (config ReadBusinessMessageConfig) params() (Params, error) {
+	params, err := config.BaseBusiness.params()
+	if err != nil {
+		return params, err
+	}
+
+	params.AddNonZero64("chat_id", config.ChatID)
+	params.AddNonZero("message_id", config.MessageID)
+
+	return params, nil
+}
+
+// DeleteBusinessMessagesConfig deletes messages on behalf of a business
+// account. The bot must have the can_delete_sent_messages business bot right
+// to delete messages sent by the bot itself, or the can_delete_all_messages
+// right to delete any message.
+type DeleteBusinessMessagesConfig struct {
+	BaseBusiness
+	// MessageIDs are 1-100 messages to delete, all from the same chat.
+	MessageIDs []int // required
+}
+
+func (config DeleteBusinessMessagesConfig) method() string {
+	return "deleteBusinessMessages"
+}
+
+// params returns a Params representation of DeleteBusinessMessagesConfig.
+func (config DeleteBusinessMessagesConfig) params() (Params, error) {
+	params, err := config.BaseBusiness.params()
+	if err != nil {
+		return params, err
+	}
+
+	err = params.AddInterface("message_ids", config.MessageIDs)
+
+	return params, err
+}
+
+// SetBusinessAccountNameConfig changes the first and last name
+// of a managed business account.
+type SetBusinessAccountNameConfig struct {
+	BaseBusiness
+	FirstName string // required
+	LastName  string
+}
+
+func (config SetBusinessAccountNameConfig) method() string {
+	return "setBusinessAccountName"
+}
+
+// params returns a Params representation of SetBusinessAccountNameConfig.
+func (config SetBusinessAccountNameConfig) params() (Params, error) {
+	params, err := config.BaseBusiness.params()
+	if err != nil {
+		return params, err
+	}
+
+	params["first_name"] = config.FirstName
+	params.AddNonEmpty("last_name", config.LastName)
+
+	return params, nil
+}
+
+// SetBusinessAccountUsernameConfig changes the username
+// of a managed business account.
+type SetBusinessAccountUsernameConfig struct {
+	BaseBusiness
+	// Username is the new username, without @.
+	//
+	// optional, the username is removed if empty
+	Username string
+}
+
+func (config SetBusinessAccountUsernameConfig) method() string {
+	return "setBusinessAccountUsername"
+}
+
+// params returns a Params representation of SetBusinessAccountUsernameConfig.
+func (config SetBusinessAccountUsernameConfig) params() (Params, error) {
+	params, err := config.BaseBusiness.params()
+	if err != nil {
+		return params, err
+	}
+
+	params.AddNonEmpty("username", config.Username)
+
+	return params, nil
+}
+
+// SetBusinessAccountBioConfig changes the bio of a managed business account.
+type SetBusinessAccountBioConfig struct {
+	BaseBusiness
+	// Bio is the new bio, 0-140 characters.
+	//
+	// optional, the bio is removed if empty
+	Bio string
+}
+
+func (config SetBusinessAccountBioConfig) method() string {
+	return "setBusinessAccountBio"
+}
+
+// params returns a Params representation of SetBusinessAccountBioConfig.
+func (config SetBusinessAccountBioConfig) params() (Params, error) {
+	params, err := config.BaseBusiness.params()
+	if err != nil {
+		return params, err
+	}
+
+	params.AddNonEmpty("bio", config.Bio)
+
+	return params, nil
+}
+
+// SetBusinessAccountProfilePhotoConfig changes the profile photo
+// of a managed business account.
+type SetBusinessAccountProfilePhotoConfig struct {
+	BaseBusiness
+	Photo InputProfilePhoto // required
+	// IsPublic sets the public photo, which is visible even if the main
+	// photo is hidden by the privacy settings of the business account.
+	IsPublic bool
+}
+
+func (config SetBusinessAccountProfilePhotoConfig) method() string {
+	return "setBusinessAccountProfilePhoto"
+}
+
+// params returns a Params representation of SetBusinessAccountProfilePhotoConfig.
+func (config SetBusinessAccountProfilePhotoConfig) params() (Params, error) {
+	params, err := config.BaseBusiness.params()
+	if err != nil {
+		return params, err
+	}
+
+	photo, _, err := config.Photo.prepare("photo-file")
+	if err != nil {
+		return params, err
+	}
+
+	params.AddBool("is_public", config.IsPublic)
+	err = params.AddInterface("photo", photo)
+
+	return params, err
+}
+
+// files returns the file uploaded with the photo.
+func (config SetBusinessAccountProfilePhotoConfig) files() ([]RequestFile, error) {
+	_, files, err := config.Photo.prepare("photo-file")
+	return files, err
+}
+
+// RemoveBusinessAccountProfilePhotoConfig removes the current profile photo
+// of a managed business account.
+type RemoveBusinessAccountProfilePhotoConfig struct {
+	BaseBusiness
+	// IsPublic removes the public photo, rather than the main one.
+	// The main photo is replaced by the previous one.
+	IsPublic bool
+}
+
+func (config RemoveBusinessAccountProfilePhotoConfig) method() string {
+	return "removeBusinessAccountProfilePhoto"
+}
+
+// params returns a Params representation of RemoveBusinessAccountProfilePhotoConfig.
+func (config RemoveBusinessAccountProfilePhotoConfig) params() (Params, error) {
+	params, err := config.BaseBusiness.params()
+	if err != nil {
+		return params, err
+	}
+
+	params.AddBool("is_public", config.IsPublic)
+
+	return params, nil
+}
+
+// GetBusinessAccountStarBalanceConfig gets the amount of stars owned by
+// a managed business account. The bot must have the can_view_gifts_and_stars
+// business bot right.
+type GetBusinessAccountStarBalanceConfig struct {
+	BaseBusiness
+}
+
+func (config GetBusinessAccountStarBalanceConfig) method() string {
+	return "getBusinessAccountStarBalance"
+}
+
+// TransferBusinessAccountStarsConfig transfers stars from the business
+// account balance to the balance of the bot. The bot must have
+// the can_transfer_stars business bot right.
+type TransferBusinessAccountStarsConfig struct {
+	BaseBusiness
+	// StarCount is the number of stars to transfer, 1-10000.
+	StarCount int // required
+}
+
+func (config TransferBusinessAccountStarsConfig) method() string {
+	return "transferBusinessAccountStars"
+}
+
+// params returns a Params representation of TransferBusinessAccountStarsConfig.
+func (config TransferBusinessAccountStarsConfig) params() (Params, error) {
+	params, err := config.BaseBusiness.params()
+	if err != nil {
+		return params, err
+	}
+
+	params.AddNonZero("star_count", config.StarCount)
+
+	return params, nil
+}
+
+// SetBusinessAccountGiftSettingsConfig changes the privacy settings
+// of the incoming gifts of a managed business account. The bot must have
+// the can_change_gift_settings business bot right.
+type SetBusinessAccountGiftSettingsConfig struct {
+	BaseBusiness
+	// ShowGiftButton shows a button for sending a gift to the user
+	// or by the business account in the input field.
+	ShowGiftButton bool
+	// AcceptedGiftTypes are the types of gifts accepted by the account.
+	AcceptedGiftTypes AcceptedGiftTypes
+}
+
+func (config SetBusinessAccountGiftSettingsConfig) method() string {
+	return "setBusinessAccountGiftSettings"
+}
+
+// params returns a Params representation of SetBusinessAccountGiftSettingsConfig.
+func (config SetBusinessAccountGiftSettingsConfig) params() (Params, error) {
+	params, err := config.BaseBusiness.params()
+	if err != nil {
+		return params, err
+	}
+
+	params["show_gift_button"] = strconv.FormatBool(config.ShowGiftButton)
+	err = params.AddInterface("accepted_gift_types", config.AcceptedGiftTypes)
+
+	return params, err
+}
+
+// GetBusinessAccountGiftsConfig gets the gifts received and owned by
+// a managed business account. The bot must have the can_view_gifts_and_stars
+// business bot right.
+type GetBusinessAccountGiftsConfig struct {
+	BaseBusiness
+	ExcludeUnsaved   bool
+	ExcludeSaved     bool
+	ExcludeUnlimited bool
+	ExcludeLimited   bool
+	ExcludeUnique    bool
+	// SortByPrice sorts the gifts by price, rather than by send date.
+	SortByPrice bool
+	// Offset is the NextOffset of the previous page of gifts.
+	Offset string
+	// Limit is the maximum number of gifts, 1-100. Defaults to 100.
+	Limit int
+}
+
+func (config GetBusinessAccountGiftsConfig) method() string {
+	return "getBusinessAccountGifts"
+}
+
+// params returns a Params representation of GetBusinessAccountGiftsConfig.
+func (config GetBusinessAccountGiftsConfig) params() (Params, error) {
+	params, err := config.BaseBusiness.params()
+	if err != nil {
+		return params, err
+	}
+
+	params.AddBool("exclude_unsaved", config.ExcludeUnsaved)
+	params.AddBool("exclude_saved", config.ExcludeSaved)
+	params.AddBool("exclude_unlimited", config.ExcludeUnlimited)
+	params.AddBool("exclude_limited", config.ExcludeLimited)
+	params.AddBool("exclude_unique", config.ExcludeUnique)
+	params.AddBool("sort_by_price", config.SortByPrice)
+	params.AddNonEmpty("offset", config.Offset)
+	params.AddNonZero("limit", config.Limit)
+
+	return params, nil
+}
+
+// ConvertGiftToStarsConfig converts a regular gift to stars. The bot must have
+// the can_convert_gifts_to_stars business bot right.
+type ConvertGiftToStarsConfig struct {
+	BaseBusiness
+	OwnedGiftID string // required
+}
+
+func (config ConvertGiftToStarsConfig) method() string {
+	return "convertGiftToStars"
+}
+
+// params returns a Params representation of ConvertGiftToStarsConfig.
+func (config ConvertGiftToStarsConfig) params() (Params, error) {
+	params, err := config.BaseBusiness.params()
+	if err != nil {
+		return params, err
+	}
+
+	params["owned_gift_id"] = config.OwnedGiftID
+
+	return params, nil
+}
+
+// UpgradeGiftConfig upgrades a regular gift to a unique gift. The bot must
+// have the can_transfer_and_upgrade_gifts business bot right, and the
+// can_transfer_stars right if the upgrade is paid.
+type UpgradeGiftConfig struct {
+	BaseBusiness
+	OwnedGiftID string // required
+	// KeepOriginalDetails keeps the text, sender and receiver of the gift.
+	KeepOriginalDetails bool
+	// StarCount is the amount of stars paid for the upgrade from the
+	// business account balance, if the upgrade wasn't prepaid by the sender.
+	StarCount int
+}
+
+func (config UpgradeGiftConfig) method() string {
+	return "upgradeGift"
+}
+
+// params returns a Params representation of UpgradeGiftConfig.
+func (config UpgradeGiftConfig) params() (Params, error) {
+	params, err := config.BaseBusiness.params()
+	if err != nil {
+		return params, err
+	}
+
+	params["owned_gift_id"] = config.OwnedGiftID
+	params.AddBool("keep_original_details", config.KeepOriginalDetails)
+	params.AddNonZero("star_count", config.StarCount)
+
+	return params, nil
+}
+
+// TransferGiftConfig transfers a unique gift to another user. The bot must
+// have the can_transfer_and_upgrade_gifts business bot right, and the
+// can_transfer_stars right if the transfer is paid.
+type TransferGiftConfig struct {
+	BaseBusiness
+	OwnedGiftID string // required
+	// NewOwnerChatID is the chat which will own the gift. The chat must
+	// be active in the last 24 hours.
+	NewOwnerChatID int64 // required
+	// StarCount is the amount of stars paid for the transfer from the
+	// business account balance.
+	StarCount int
+}
+
+func (config TransferGiftConfig) method() string {
+	return "transferGift"
+}
+
+// params returns a Params representation of TransferGiftConfig.
+func (config TransferGiftConfig) params() (Params, error) {
+	params, err := config.BaseBusiness.params()
+	if err != nil {
+		return params, err
+	}
+
+	params["owned_gift_id"] = config.OwnedGiftID
+	params.AddNonZero64("new_owner_chat_id", config.NewOwnerChatID)
+	params.AddNonZero("star_count", config.StarCount)
+
+	return params, nil
+}
+
+// RefundStarPaymentConfig refunds a successful payment in Telegram Stars.
+type RefundStarPaymentConfig struct {
+	UserID                  int    // required
+	TelegramPaymentChargeID string // required
+}
+
+func (config RefundStarPaymentConfig) method() string {
+	return "refundStarPayment"
+}
+
+// params returns a Params representation of RefundStarPaymentConfig.
+func (config RefundStarPaymentConfig) params() (Params, error) {
+	params := make(Params)
+
+	params.AddNonZero("user_id", config.UserID)
+	params["telegram_payment_charge_id"] = config.TelegramPaymentChargeID
+
+	return params, nil
+}
+
+// GetStarTransactionsConfig gets a page of the Telegram Star transactions
+// of the bot, from the newest.
+type GetStarTransactionsConfig struct {
+	// Offset is the number of transactions to skip.
+	Offset int
+	// Limit is the maximum number of transactions, 1-100. Defaults to 100.
+	Limit int
+}
+
+func (config GetStarTransactionsConfig) method() string {
+	return "getStarTransactions"
+}
+
+// params returns a Params representation of GetStarTransactionsConfig.
+func (config GetStarTransactionsConfig) params() (Params, error) {
+	params := make(Params)
+
+	params.AddNonZero("offset", config.Offset)
+	params.AddNonZero("limit", config.Limit)
+
+	return params, nil
+}
+
+// EditUserStarSubscriptionConfig cancels or re-enables the extension
+// of a subscription paid in Telegram Stars.
+type EditUserStarSubscriptionConfig struct {
+	UserID                  int    // required
+	TelegramPaymentChargeID string // required
+	// IsCanceled cancels the extension of the subscription, which stays
+	// active until the end of the current period. It is re-enabled if false,
+	// which is only possible for subscriptions canceled by the bot.
+	IsCanceled bool
+}
+
+func (config EditUserStarSubscriptionConfig) method() string {
+	return "editUserStarSubscription"
+}
+
+// params returns a Params representation of EditUserStarSubscriptionConfig.
+func (config EditUserStarSubscriptionConfig) params() (Params, error) {
+	params := make(Params)
+
+	params.AddNonZero("user_id", config.UserID)
+	params["telegram_payment_charge_id"] = config.TelegramPaymentChargeID
+	params["is_canceled"] = strconv.FormatBool(config.IsCanceled)
+
+	return params, nil
+}
+
+// SendGiftConfig sends a gift to a user or a channel chat, paid from the
+// balance of the bot in stars. The gift can't be converted to stars
+// by the receiver.
+type SendGiftConfig struct {
+	// UserID is the user that will receive the gift.
+	UserID int
+	// ChatID or ChannelUsername is the channel chat that will receive the
+	// gift, if UserID is not set.
+	ChatID          int64
+	ChannelUsername string
+	GiftID          string // required
+	// PayForUpgrade pays for the upgrade of the gift to a unique one
+	// from the balance of the bot, making the upgrade free for the receiver.
+	PayForUpgrade bool
+	// Text shown along with the gift, 0-128 characters. Only the bold, italic,
+	// underline, strikethrough, spoiler and custom_emoji entities are kept.
+	Text          string
+	TextParseMode string
+	TextEntities  []MessageEntity
+}
+
+func (config SendGiftConfig) method() string {
+	return "sendGift"
+}
+
+// params returns a Params representation of SendGiftConfig.
+func (config SendGiftConfig) params() (Params, error) {
+	params := make(Params)
+
+	if config.UserID != 0 {
+		params.AddNonZero("user_id", config.UserID)
+	} else {
+		err := params.AddFirstValid("chat_id", config.ChannelUsername, config.ChatID)
+		if err != nil {
+			return params, err
+		}
+	}
+
+	params["gift_id"] = config.GiftID
+	params.AddBool("pay_for_upgrade", config.PayForUpgrade)
+	params.AddNonEmpty("text", config.Text)
+	params.AddNonEmpty("text_parse_mode", config.TextParseMode)
+	err := params.AddInterface("text_entities", config.TextEntities)
+
+	return params, err
+}
+
+// GiftPremiumSubscriptionConfig gifts a Telegram Premium subscription
+// to a user, paid from the balance of the bot in stars.
+type GiftPremiumSubscriptionConfig struct {
+	UserID int // required
+	// MonthCount is the number of months the subscription will be active
+	// for, 3, 6 or 12.
+	MonthCount int // required
+	// StarCount is the number of stars to pay, 1000 for 3 months, 1500 for
+	// 6 months and 2500 for 12 months.
+	StarCount     int // required
+	Text          string
+	TextParseMode string
+	TextEntities  []MessageEntity
+}
+
+func (config GiftPremiumSubscriptionConfig) method() string {
+	return "giftPremiumSubscription"
+}
+
+// params returns a Params representation of GiftPremiumSubscriptionConfig.
+func (config GiftPremiumSubscriptionConfig) params() (Params, error) {
+	params := make(Params)
+
+	params.AddNonZero("user_id", config.UserID)
+	params.AddNonZero("month_count", config.MonthCount)
+	params.AddNonZero("star_count", config.StarCount)
+	params.AddNonEmpty("text", config.Text)
+	params.AddNonEmpty("text_parse_mode", config.TextParseMode)
+	err := params.AddInterface("text_entities", config.TextEntities)
+
+	return params, err
+}
+
+// VerifyUserConfig verifies a user on behalf of the organization
+// represented by the bot.
+type VerifyUserConfig struct {
+	UserID int // required
+	// CustomDescription of the verification, 0-70 characters. It must be
+	// empty if the organization isn't allowed to provide a custom
+	// verification description.
+	CustomDescription string
+}
+
+func (config VerifyUserConfig) method() string {
+	return "verifyUser"
+}
+
+// params returns a Params representation of VerifyUserConfig.
+func (config VerifyUserConfig) params() (Params, error) {
+	params := make(Params)
+
+	params.AddNonZero("user_id", config.UserID)
+	params.AddNonEmpty("custom_description", config.CustomDescription)
+
+	return params, nil
+}
+
+// VerifyChatConfig verifies a chat on behalf of the organization
+// represented by the bot.
+type VerifyChatConfig struct {
+	ChatID          int64
+	ChannelUsername string
+	// CustomDescription of the verification, 0-70 characters. It must be
+	// empty if the organization isn't allowed to provide a custom
+	// verification description.
+	CustomDescription string
+}
+
+func (config VerifyChatConfig) method() string {
+	return "verifyChat"
+}
+
+// params returns a Params representation of VerifyChatConfig.
+func (config VerifyChatConfig) params() (Params, error) {
+	params := make(Params)
+
+	err := params.AddFirstValid("chat_id", config.ChannelUsername, config.ChatID)
+	params.AddNonEmpty("custom_description", config.CustomDescription)
+
+	return params, err
+}
+
+// RemoveUserVerificationConfig removes the verification of a user
+// who is verified on behalf of the organization represented by the bot.
+type RemoveUserVerificationConfig struct {
+	UserID int // required
+}
+
+func (config RemoveUserVerificationConfig) method() string {
+	return "removeUserVerification"
+}
+
+// params returns a Params representation of RemoveUserVerificationConfig.
+func (config RemoveUserVerificationConfig) params() (Params, error) {
+	params := make(Params)
+
+	params.AddNonZero("user_id", config.UserID)
+
+	return params, nil
+}
+
+// RemoveChatVerificationConfig removes the verification of a chat
+// which is verified on behalf of the organization represented by the bot.
+type RemoveChatVerificationConfig struct {
+	ChatID          int64
+	ChannelUsername string
+}
+
+func (config RemoveChatVerificationConfig) method() string {
+	return "removeChatVerification"
+}
+
+// params returns a Params representation of RemoveChatVerificationConfig.
+func (config RemoveChatVerificationConfig) params() (Params, error) {
+	params := make(Params)
+
+	err := params.AddFirstValid("chat_id", config.ChannelUsername, config.ChatID)
+
+	return params, err
+}
+
+// SetUserEmojiStatusConfig changes the emoji status of a user that
+// allowed the bot to do so from its Mini App, with the
+// requestEmojiStatusAccess method of the Mini App.
+type SetUserEmojiStatusConfig struct {
+	UserID int // required
+	// EmojiStatusCustomEmojiID is the custom emoji of the status.
+	//
+	// optional, the status is removed if empty
+	EmojiStatusCustomEmojiID string
+	// EmojiStatusExpirationDate is the point in time (Unix timestamp)
+	// when the status expires.
+	//
+	// optional
+	EmojiStatusExpirationDate int64
+}
+
+func (config SetUserEmojiStatusConfig) method() string {
+	return "setUserEmojiStatus"
+}
+
+// params returns a Params representation of SetUserEmojiStatusConfig.
+func (config SetUserEmojiStatusConfig) params() (Params, error) {
+	params := make(Params)
+
+	params.AddNonZero("user_id", config.UserID)
+	params.AddNonEmpty("emoji_status_custom_emoji_id", config.EmojiStatusCustomEmojiID)
+	params.AddNonZero64("emoji_status_expiration_date", config.EmojiStatusExpirationDate)
+
+	return params, nil
+}
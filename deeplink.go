@@ -0,0 +1,102 @@
+package tgbotapi
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/url"
+)
+
+// Maximum lengths of deep link payloads.
+const (
+	// MaxStartPayloadLength is the maximum length of start and
+	// startgroup payloads.
+	MaxStartPayloadLength = 64
+	// MaxStartAppPayloadLength is the maximum length of startapp payloads.
+	MaxStartAppPayloadLength = 512
+)
+
+// NewStartLink builds a t.me link opening a private chat with the bot
+// botUserName, which sends it /start with payload.
+func NewStartLink(botUserName, payload string) (string, error) {
+	return deepLink(botUserName, "start", payload, MaxStartPayloadLength)
+}
+
+// NewStartGroupLink builds a t.me link adding the bot botUserName to a group,
+// which sends it /start with payload.
+func NewStartGroupLink(botUserName, payload string) (string, error) {
+	return deepLink(botUserName, "startgroup", payload, MaxStartPayloadLength)
+}
+
+// NewStartAppLink builds a t.me link opening the main Mini App of the bot
+// botUserName, which gets payload as its start parameter.
+func NewStartAppLink(botUserName, payload string) (string, error) {
+	return deepLink(botUserName, "startapp", payload, MaxStartAppPayloadLength)
+}
+
+// EncodeStartPayload encodes arbitrary data as a deep link payload with
+// unpadded base64url. Up to 48 bytes fit in a start payload.
+func EncodeStartPayload(data []byte) (string, error) {
+	payload := base64.RawURLEncoding.EncodeToString(data)
+	if len(payload) > MaxStartPayloadLength {
+		return "", errors.New(ErrBadStartPayload)
+	}
+
+	return payload, nil
+}
+
+// DecodeStartPayload decodes a deep link payload encoded with
+// EncodeStartPayload.
+func DecodeStartPayload(payload string) ([]byte, error) {
+	data, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, errors.New(ErrBadStartPayload)
+	}
+
+	return data, nil
+}
+
+// StartPayload returns the payload of the deep link a /start command
+// message was sent from, or an empty string if the message is not a
+// /start command or has no payload.
+func (m *Message) StartPayload() string {
+	if m.Command() != "start" {
+		return ""
+	}
+
+	return m.CommandArguments()
+}
+
+// deepLink builds a t.me link to the bot botUserName with payload as
+// the parameter param.
+func deepLink(botUserName, param, payload string, maxLength int) (string, error) {
+	if !validStartPayload(payload, maxLength) {
+		return "", errors.New(ErrBadStartPayload)
+	}
+
+	link := url.URL{
+		Scheme:   "https",
+		Host:     "t.me",
+		Path:     botUserName,
+		RawQuery: param + "=" + payload,
+	}
+
+	return link.String(), nil
+}
+
+// validStartPayload returns true if payload only has the characters
+// allowed in deep links and is at most maxLength long.
+func validStartPayload(payload string, maxLength int) bool {
+	if len(payload) > maxLength {
+		return false
+	}
+
+	for _, c := range payload {
+		switch {
+		case c >= 'A' && c <= 'Z', c >= 'a' && c <= 'z', c >= '0' && c <= '9', c == '_', c == '-':
+		default:
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,65 @@
+package tgbotapi_test
+
+import (
+	"strings"
+	"testing"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartLinks(t *testing.T) {
+	link, err := tgbotapi.NewStartLink("shop_bot", "ref-42")
+	require.NoError(t, err)
+	require.Equal(t, "https://t.me/shop_bot?start=ref-42", link)
+
+	link, err = tgbotapi.NewStartGroupLink("shop_bot", "group_1")
+	require.NoError(t, err)
+	require.Equal(t, "https://t.me/shop_bot?startgroup=group_1", link)
+
+	link, err = tgbotapi.NewStartAppLink("shop_bot", strings.Repeat("a", 100))
+	require.NoError(t, err)
+	require.Equal(t, "https://t.me/shop_bot?startapp="+strings.Repeat("a", 100), link)
+
+	_, err = tgbotapi.NewStartLink("shop_bot", "with space")
+	require.EqualError(t, err, tgbotapi.ErrBadStartPayload)
+
+	_, err = tgbotapi.NewStartLink("shop_bot", strings.Repeat("a", 65))
+	require.EqualError(t, err, tgbotapi.ErrBadStartPayload)
+}
+
+func TestStartPayloadCodec(t *testing.T) {
+	data := []byte{0xff, 0xfe, 'i', 'd', '=', '4', '2'}
+	payload, err := tgbotapi.EncodeStartPayload(data)
+	require.NoError(t, err)
+
+	link, err := tgbotapi.NewStartLink("shop_bot", payload)
+	require.NoError(t, err)
+	require.True(t, strings.HasSuffix(link, "?start="+payload))
+
+	decoded, err := tgbotapi.DecodeStartPayload(payload)
+	require.NoError(t, err)
+	require.Equal(t, data, decoded)
+
+	_, err = tgbotapi.EncodeStartPayload(make([]byte, 48))
+	require.NoError(t, err)
+	_, err = tgbotapi.EncodeStartPayload(make([]byte, 49))
+	require.EqualError(t, err, tgbotapi.ErrBadStartPayload)
+
+	_, err = tgbotapi.DecodeStartPayload("not base64!")
+	require.EqualError(t, err, tgbotapi.ErrBadStartPayload)
+}
+
+func TestMessageStartPayload(t *testing.T) {
+	message := tgbotapi.Message{
+		Text:     "/start ref-42",
+		Entities: &[]tgbotapi.MessageEntity{{Type: "bot_command", Offset: 0, Length: 6}},
+	}
+	require.Equal(t, "ref-42", message.StartPayload())
+
+	message.Text = "/help ref-42"
+	message.Entities = &[]tgbotapi.MessageEntity{{Type: "bot_command", Offset: 0, Length: 5}}
+	require.Empty(t, message.StartPayload())
+
+	require.Empty(t, (&tgbotapi.Message{Text: "start ref-42"}).StartPayload())
+}
@@ -0,0 +1,176 @@
+package tgbotapi
+
+import (
+	"context"
+	"runtime/debug"
+	"sync"
+)
+
+// DefaultDispatcherWorkers is the number of workers of a Dispatcher
+// created with zero workers.
+const DefaultDispatcherWorkers = 4
+
+// Dispatcher handles updates from an UpdatesChannel concurrently
+// with a pool of workers.
+type Dispatcher struct {
+	// Workers is the number of updates handled at the same time.
+	//
+	// optional, DefaultDispatcherWorkers is used if zero
+	Workers int
+	// OrderByChat makes the updates of the same chat always be handled
+	// by the same worker, one after another in the order they came.
+	// Updates without a chat are spread across the workers.
+	OrderByChat bool
+	// OnPanic is called when the handler panics while handling
+	// an update. The worker carries on with the next update.
+	//
+	// optional, the panic is logged with Logger if nil
+	OnPanic func(update Update, recovered interface{})
+	// Logger logs recovered panics when OnPanic is nil, and the errors
+	// of the Committer.
+	//
+	// optional, the package logger is used if nil
+	Logger Logger
+	// Committer commits every update once it is handled,
+	// even if the handler panicked.
+	//
+	// optional
+	Committer *OffsetCommitter
+
+	handler func(update Update)
+}
+
+// NewDispatcher creates a Dispatcher calling handler from the given
+// number of workers.
+func NewDispatcher(workers int, handler func(update Update)) *Dispatcher {
+	return &Dispatcher{
+		Workers: workers,
+		handler: handler,
+	}
+}
+
+// Run handles the updates until the channel is closed or ctx is done,
+// then waits until the updates already taken from the channel are
+// handled. It returns ctx.Err() if ctx is done first.
+//
+// To drain the updates gracefully, stop receiving them, for example
+// with BotAPI.Shutdown, and wait for Run to return.
+func (d *Dispatcher) Run(ctx context.Context, updates UpdatesChannel) error {
+	workers := d.Workers
+	if workers <= 0 {
+		workers = DefaultDispatcherWorkers
+	}
+
+	queues := make([]chan Update, workers)
+	if !d.OrderByChat {
+		// All the workers share a single queue.
+		queue := make(chan Update)
+		for i := range queues {
+			queues[i] = queue
+		}
+	} else {
+		for i := range queues {
+			queues[i] = make(chan Update)
+		}
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for _, queue := range queues {
+		go func(queue <-chan Update) {
+			defer wg.Done()
+			for update := range queue {
+				d.handle(update)
+			}
+		}(queue)
+	}
+
+	err := d.dispatch(ctx, updates, queues)
+
+	if d.OrderByChat {
+		for _, queue := range queues {
+			close(queue)
+		}
+	} else {
+		close(queues[0])
+	}
+	wg.Wait()
+
+	return err
+}
+
+// dispatch passes the updates to the queues of the workers.
+func (d *Dispatcher) dispatch(ctx context.Context, updates UpdatesChannel, queues []chan Update) error {
+	for {
+		var update Update
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case u, ok := <-updates:
+			if !ok {
+				return nil
+			}
+			update = u
+		}
+
+		queue := queues[d.worker(update, len(queues))]
+		select {
+		case queue <- update:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// worker returns the index of the worker that should handle update.
+func (d *Dispatcher) worker(update Update, workers int) int {
+	if !d.OrderByChat {
+		return 0
+	}
+
+	key := int64(update.UpdateID)
+	if chat := update.FromChat(); chat != nil {
+		key = chat.ID
+	}
+
+	index := int(key % int64(workers))
+	if index < 0 {
+		index = -index
+	}
+	return index
+}
+
+// handle calls the handler, recovering from its panics.
+func (d *Dispatcher) handle(update Update) {
+	defer d.commit(update)
+	defer func() {
+		if r := recover(); r != nil {
+			if d.OnPanic != nil {
+				d.OnPanic(update, r)
+				return
+			}
+
+			d.logger().Error("Update handler panicked", "update_id", update.UpdateID, "panic", r, "stack", string(debug.Stack()))
+		}
+	}()
+
+	d.handler(update)
+}
+
+// commit commits the handled update with the Committer.
+func (d *Dispatcher) commit(update Update) {
+	if d.Committer == nil {
+		return
+	}
+
+	if err := d.Committer.Commit(update.UpdateID); err != nil {
+		d.logger().Error("Failed to store update offset", "update_id", update.UpdateID, "error", err)
+	}
+}
+
+func (d *Dispatcher) logger() Logger {
+	if d.Logger != nil {
+		return d.Logger
+	}
+	return NewStdLogger(log)
+}
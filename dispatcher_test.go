@@ -0,0 +1,102 @@
+package tgbotapi_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func chatUpdate(updateID int, chatID int64) tgbotapi.Update {
+	return tgbotapi.Update{
+		UpdateID: updateID,
+		Message:  &tgbotapi.Message{Chat: &tgbotapi.Chat{ID: chatID}},
+	}
+}
+
+func TestDispatcherOrderByChat(t *testing.T) {
+	var mu sync.Mutex
+	handled := map[int64][]int{}
+
+	dispatcher := tgbotapi.NewDispatcher(3, func(update tgbotapi.Update) {
+		mu.Lock()
+		defer mu.Unlock()
+		chatID := update.FromChat().ID
+		handled[chatID] = append(handled[chatID], update.UpdateID)
+	})
+	dispatcher.OrderByChat = true
+
+	updates := make(chan tgbotapi.Update, 100)
+	for i := 0; i < 100; i++ {
+		updates <- chatUpdate(i, int64(i%5)-2)
+	}
+	close(updates)
+
+	require.NoError(t, dispatcher.Run(context.Background(), updates))
+
+	require.Len(t, handled, 5)
+	for _, ids := range handled {
+		require.Len(t, ids, 20)
+		for i := 1; i < len(ids); i++ {
+			require.Less(t, ids[i-1], ids[i])
+		}
+	}
+}
+
+func TestDispatcherRecoversPanic(t *testing.T) {
+	var mu sync.Mutex
+	var handled, panicked []int
+
+	dispatcher := tgbotapi.NewDispatcher(2, func(update tgbotapi.Update) {
+		if update.UpdateID%2 == 0 {
+			panic("boom")
+		}
+		mu.Lock()
+		handled = append(handled, update.UpdateID)
+		mu.Unlock()
+	})
+	dispatcher.OnPanic = func(update tgbotapi.Update, recovered interface{}) {
+		require.Equal(t, "boom", recovered)
+		mu.Lock()
+		panicked = append(panicked, update.UpdateID)
+		mu.Unlock()
+	}
+
+	updates := make(chan tgbotapi.Update, 10)
+	for i := 0; i < 10; i++ {
+		updates <- tgbotapi.Update{UpdateID: i}
+	}
+	close(updates)
+
+	require.NoError(t, dispatcher.Run(context.Background(), updates))
+	require.ElementsMatch(t, []int{1, 3, 5, 7, 9}, handled)
+	require.ElementsMatch(t, []int{0, 2, 4, 6, 8}, panicked)
+}
+
+func TestDispatcherDrainsOnCancel(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	done := false
+
+	dispatcher := tgbotapi.NewDispatcher(1, func(update tgbotapi.Update) {
+		close(started)
+		<-release
+		done = true
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	updates := make(chan tgbotapi.Update, 2)
+	updates <- tgbotapi.Update{UpdateID: 1}
+
+	result := make(chan error)
+	go func() { result <- dispatcher.Run(ctx, updates) }()
+
+	<-started
+	cancel()
+	close(release)
+
+	require.ErrorIs(t, <-result, context.Canceled)
+	require.True(t, done)
+}
@@ -0,0 +1,420 @@
+package tgbotapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// DownloadProgressFunc is called as a file is downloaded, with the number
+// of bytes downloaded so far and the size of the file, or -1 if the size
+// is unknown.
+type DownloadProgressFunc func(downloaded, total int64)
+
+// DownloadFile gets the file with fileID and writes its contents to w.
+//
+// onProgress is optional, and called as the file is downloaded.
+// The download is aborted as soon as ctx is done.
+func (bot *BotAPI) DownloadFile(ctx context.Context, fileID string, w io.Writer, onProgress ...DownloadProgressFunc) (*File, error) {
+	file, err := bot.GetFileWithContext(ctx, FileConfig{FileID: fileID})
+	if err != nil {
+		return nil, err
+	}
+
+	return file, bot.downloadFile(ctx, file, w, onProgress)
+}
+
+// DownloadFileToPath gets the file with fileID and saves it at filePath.
+//
+// The file is first written next to filePath, and only moved there once it
+// is downloaded, so filePath never holds a partial file.
+func (bot *BotAPI) DownloadFileToPath(ctx context.Context, fileID, filePath string, onProgress ...DownloadProgressFunc) (*File, error) {
+	tmp, err := ioutil.TempFile(filepath.Dir(filePath), filepath.Base(filePath)+".*.part")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+
+	file, err := bot.DownloadFile(ctx, fileID, tmp, onProgress...)
+	if err != nil {
+		tmp.Close()
+		return nil, err
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, err
+	}
+
+	return file, os.Rename(tmp.Name(), filePath)
+}
+
+// downloadFile writes the contents of file to w.
+func (bot *BotAPI) downloadFile(ctx context.Context, file *File, w io.Writer, onProgress []DownloadProgressFunc) error {
+	body, size, err := bot.openFile(ctx, file)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	if size < 0 && file.FileSize > 0 {
+		size = int64(file.FileSize)
+	}
+
+	if len(onProgress) != 0 {
+		w = &progressWriter{w: w, total: size, onProgress: onProgress}
+	}
+
+	_, err = io.Copy(w, &contextReader{ctx: ctx, r: body})
+	return err
+}
+
+// openFile opens the contents of file and returns its size,
+// or -1 if it is unknown.
+//
+// Files of a local Bot API server are read from its disk.
+func (bot *BotAPI) openFile(ctx context.Context, file *File) (io.ReadCloser, int64, error) {
+	if path.IsAbs(file.FilePath) {
+		f, err := os.Open(file.FilePath)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		fi, err := f.Stat()
+		if err != nil {
+			f.Close()
+			return nil, 0, err
+		}
+		return f, fi.Size(), nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", bot.fileURL(file.FilePath), nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	resp, err := bot.Client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, 0, Error{Code: resp.StatusCode, Message: resp.Status}
+	}
+
+	return resp.Body, resp.ContentLength, nil
+}
+
+// fileURL returns the URL to download the file at filePath
+// from the API server of the bot.
+func (bot *BotAPI) fileURL(filePath string) string {
+	if bot.testEnvironment {
+		filePath = "test/" + filePath
+	}
+
+	// The files are served next to the methods, under /file/bot<token>/.
+	endpoint := strings.Replace(bot.apiEndpoint, "/bot%s/", "/file/bot%s/", 1)
+	return fmt.Sprintf(endpoint, bot.Token, filePath)
+}
+
+// progressWriter reports the bytes written through it.
+type progressWriter struct {
+	w          io.Writer
+	written    int64
+	total      int64
+	onProgress []DownloadProgressFunc
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	p.written += int64(n)
+	for _, onProgress := range p.onProgress {
+		onProgress(p.written, p.total)
+	}
+	return n, err
+}
+
+// contextReader fails reads once ctx is done.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (r *contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.r.Read(p)
+}
+
+// DefaultDownloadChunkSize is the size of the ranges requested at once by
+// DownloadFileRanged when RangedDownloadConfig.ChunkSize is not set.
+const DefaultDownloadChunkSize = 8 << 20
+
+// RangedDownloadConfig controls how DownloadFileRanged downloads a file.
+type RangedDownloadConfig struct {
+	// ChunkSize is the number of bytes requested at once.
+	//
+	// optional, DefaultDownloadChunkSize is used if zero
+	ChunkSize int64
+	// Parallel is the number of chunks downloaded at the same time.
+	//
+	// optional, chunks are downloaded one by one if zero
+	Parallel int
+	// OnProgress is called as the file is downloaded.
+	// It is never called concurrently.
+	//
+	// optional
+	OnProgress DownloadProgressFunc
+}
+
+// DownloadFileRanged gets the file with fileID and saves it at filePath,
+// requesting it in chunks with HTTP Range requests. It is meant for
+// large files served by a local Bot API server.
+//
+// The file is written to filePath with a ".part" suffix until it is complete.
+// If the download is interrupted, the part keeps the downloaded beginning of
+// the file, and calling DownloadFileRanged again resumes from there.
+func (bot *BotAPI) DownloadFileRanged(ctx context.Context, fileID, filePath string, config RangedDownloadConfig) (*File, error) {
+	file, err := bot.GetFileWithContext(ctx, FileConfig{FileID: fileID})
+	if err != nil {
+		return nil, err
+	}
+
+	partPath := filePath + ".part"
+	part, err := os.OpenFile(partPath, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bot.downloadRanges(ctx, file, part, config)
+	if closeErr := part.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return file, os.Rename(partPath, filePath)
+}
+
+// downloadRanges downloads the rest of file into part. On failure, part
+// is truncated to the part of the file downloaded without gaps.
+func (bot *BotAPI) downloadRanges(ctx context.Context, file *File, part *os.File, config RangedDownloadConfig) error {
+	fi, err := part.Stat()
+	if err != nil {
+		return err
+	}
+
+	offset, total := fi.Size(), int64(file.FileSize)
+	if total <= 0 {
+		// Without the size, the rest of the file is requested at once.
+		total = -1
+	} else if offset > total {
+		if err := part.Truncate(0); err != nil {
+			return err
+		}
+		offset = 0
+	}
+
+	chunkSize := config.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = DefaultDownloadChunkSize
+	}
+
+	var chunks []fileRange
+	if total < 0 {
+		chunks = []fileRange{{start: offset, end: -1}}
+	}
+	for start := offset; start < total; start += chunkSize {
+		end := start + chunkSize - 1
+		if end >= total {
+			end = total - 1
+		}
+		chunks = append(chunks, fileRange{start: start, end: end})
+	}
+
+	progress := &rangeProgress{downloaded: offset, total: total, onProgress: config.OnProgress}
+	written := make([]int64, len(chunks))
+	done := make([]bool, len(chunks))
+
+	err = runChunks(ctx, len(chunks), config.Parallel, func(ctx context.Context, i int) error {
+		w := &chunkWriter{file: part, offset: chunks[i].start, written: &written[i], progress: progress}
+		if err := bot.downloadRange(ctx, file, chunks[i], w); err != nil {
+			return err
+		}
+		done[i] = true
+		return nil
+	})
+	if err == nil {
+		return nil
+	}
+
+	// Keep what was downloaded up to the first gap, so the download
+	// can be resumed from there.
+	complete := offset
+	for i := range chunks {
+		complete += written[i]
+		if !done[i] {
+			break
+		}
+	}
+	if truncErr := part.Truncate(complete); truncErr != nil {
+		return truncErr
+	}
+
+	return err
+}
+
+// fileRange is a range of bytes of a file, with an inclusive end.
+// The range extends to the end of the file if end is -1.
+type fileRange struct {
+	start, end int64
+}
+
+// header returns the value of the Range header requesting r.
+func (r fileRange) header() string {
+	if r.end < 0 {
+		return fmt.Sprintf("bytes=%d-", r.start)
+	}
+	return fmt.Sprintf("bytes=%d-%d", r.start, r.end)
+}
+
+// downloadRange writes a range of the contents of file to w.
+func (bot *BotAPI) downloadRange(ctx context.Context, file *File, r fileRange, w io.Writer) error {
+	var body io.ReadCloser
+	if path.IsAbs(file.FilePath) {
+		f, err := os.Open(file.FilePath)
+		if err != nil {
+			return err
+		}
+		if _, err := f.Seek(r.start, io.SeekStart); err != nil {
+			f.Close()
+			return err
+		}
+		body = f
+	} else {
+		req, err := http.NewRequestWithContext(ctx, "GET", bot.fileURL(file.FilePath), nil)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Range", r.header())
+
+		resp, err := bot.Client.Do(req)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case resp.StatusCode == http.StatusPartialContent:
+		case resp.StatusCode == http.StatusOK && r.start == 0:
+			// The whole file is sent, which is fine for its beginning.
+		case resp.StatusCode == http.StatusOK:
+			resp.Body.Close()
+			return errors.New(ErrRangeNotSupported)
+		default:
+			resp.Body.Close()
+			return Error{Code: resp.StatusCode, Message: resp.Status}
+		}
+		body = resp.Body
+	}
+	defer body.Close()
+
+	var reader io.Reader = &contextReader{ctx: ctx, r: body}
+	if r.end >= 0 {
+		reader = io.LimitReader(reader, r.end-r.start+1)
+	}
+
+	_, err := io.Copy(w, reader)
+	return err
+}
+
+// runChunks calls download for the chunks numbered from 0 to n-1, running up
+// to parallel calls at the same time. It stops at the first error.
+func runChunks(ctx context.Context, n, parallel int, download func(ctx context.Context, i int) error) error {
+	if parallel <= 0 {
+		parallel = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+
+	chunks := make(chan int)
+	for w := 0; w < parallel; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range chunks {
+				if err := download(ctx, i); err != nil {
+					errOnce.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+
+feed:
+	for i := 0; i < n; i++ {
+		select {
+		case chunks <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(chunks)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+// chunkWriter writes a chunk of a file at its offset.
+type chunkWriter struct {
+	file     *os.File
+	offset   int64
+	written  *int64
+	progress *rangeProgress
+}
+
+func (w *chunkWriter) Write(b []byte) (int, error) {
+	n, err := w.file.WriteAt(b, w.offset+*w.written)
+	*w.written += int64(n)
+	w.progress.add(int64(n))
+	return n, err
+}
+
+// rangeProgress reports the progress of the chunks downloaded together.
+type rangeProgress struct {
+	mu         sync.Mutex
+	downloaded int64
+	total      int64
+	onProgress DownloadProgressFunc
+}
+
+func (p *rangeProgress) add(n int64) {
+	if p.onProgress == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.downloaded += n
+	p.onProgress(p.downloaded, p.total)
+}
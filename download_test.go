@@ -0,0 +1,138 @@
+package tgbotapi_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func newDownloadBot(t *testing.T, filePath, contents string) *tgbotapi.BotAPI {
+	return newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/getFile"):
+			_, _ = fmt.Fprintf(w, `{"ok":true,"result":{"file_id":%q,"file_size":%d,"file_path":%q}}`,
+				r.FormValue("file_id"), len(contents), filePath)
+		case r.URL.Path == "/file/bot"+TestToken+"/"+filePath:
+			_, _ = w.Write([]byte(contents))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+func TestDownloadFile(t *testing.T) {
+	bot := newDownloadBot(t, "documents/file_1.txt", "hello, world")
+
+	var progress [][2]int64
+	var buf bytes.Buffer
+	file, err := bot.DownloadFile(context.Background(), "id", &buf, func(downloaded, total int64) {
+		progress = append(progress, [2]int64{downloaded, total})
+	})
+	require.NoError(t, err)
+	require.Equal(t, "documents/file_1.txt", file.FilePath)
+	require.Equal(t, "hello, world", buf.String())
+	require.Equal(t, [2]int64{12, 12}, progress[len(progress)-1])
+
+	link, err := bot.GetFileDirectURL("id")
+	require.NoError(t, err)
+	require.True(t, strings.HasSuffix(link, "/file/bot"+TestToken+"/documents/file_1.txt"))
+}
+
+func TestDownloadFileToPath(t *testing.T) {
+	bot := newDownloadBot(t, "photos/file_2.jpg", "jpeg")
+	dir := t.TempDir()
+
+	_, err := bot.DownloadFileToPath(context.Background(), "id", filepath.Join(dir, "photo.jpg"))
+	require.NoError(t, err)
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, "photo.jpg"))
+	require.NoError(t, err)
+	require.Equal(t, "jpeg", string(data))
+
+	bot = newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/getFile") {
+			_, _ = w.Write([]byte(`{"ok":true,"result":{"file_id":"id","file_path":"photos/missing.jpg"}}`))
+			return
+		}
+		http.NotFound(w, r)
+	})
+	_, err = bot.DownloadFileToPath(context.Background(), "id", filepath.Join(dir, "missing.jpg"))
+	apiErr, ok := tgbotapi.AsError(err)
+	require.True(t, ok)
+	require.Equal(t, http.StatusNotFound, apiErr.Code)
+
+	entries, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
+
+func TestDownloadFileLocal(t *testing.T) {
+	local := filepath.Join(t.TempDir(), "file_3.mp4")
+	require.NoError(t, os.WriteFile(local, []byte("mp4"), 0o600))
+
+	bot := newDownloadBot(t, local, "mp4")
+	bot.LocalMode(true)
+
+	var buf bytes.Buffer
+	_, err := bot.DownloadFile(context.Background(), "id", &buf)
+	require.NoError(t, err)
+	require.Equal(t, "mp4", buf.String())
+}
+
+func TestDownloadFileRanged(t *testing.T) {
+	contents := strings.Repeat("0123456789", 10)
+
+	var failAt int64 = 45
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/getFile") {
+			_, _ = fmt.Fprintf(w, `{"ok":true,"result":{"file_id":"id","file_size":%d,"file_path":"videos/big.mp4"}}`, len(contents))
+			return
+		}
+
+		var start, end int64
+		_, err := fmt.Sscanf(r.Header.Get("Range"), "bytes=%d-%d", &start, &end)
+		require.NoError(t, err)
+		if failAt >= start && failAt <= end {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+
+		http.ServeContent(w, r, "big.mp4", time.Time{}, strings.NewReader(contents))
+	})
+
+	target := filepath.Join(t.TempDir(), "big.mp4")
+	config := tgbotapi.RangedDownloadConfig{ChunkSize: 10, Parallel: 3}
+
+	_, err := bot.DownloadFileRanged(context.Background(), "id", target, config)
+	require.Error(t, err)
+
+	part, err := ioutil.ReadFile(target + ".part")
+	require.NoError(t, err)
+	require.LessOrEqual(t, len(part), 40)
+	require.Equal(t, contents[:len(part)], string(part))
+
+	failAt = -1
+	var downloaded, total int64
+	config.OnProgress = func(d, t int64) { downloaded, total = d, t }
+	_, err = bot.DownloadFileRanged(context.Background(), "id", target, config)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), downloaded)
+	require.Equal(t, int64(100), total)
+
+	data, err := ioutil.ReadFile(target)
+	require.NoError(t, err)
+	require.Equal(t, contents, string(data))
+
+	_, err = os.Stat(target + ".part")
+	require.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,66 @@
+package tgbotapi
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// Descriptions of common API errors. The API prefixes them with the
+// reason, such as "Bad Request: chat not found".
+const (
+	descriptionBotBlocked         = "bot was blocked by the user"
+	descriptionChatNotFound       = "chat not found"
+	descriptionMessageNotModified = "message is not modified"
+)
+
+// AsError finds the first Error in the chain of err.
+func AsError(err error) (Error, bool) {
+	var apiErr Error
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	return Error{}, false
+}
+
+// IsForbidden returns true if err is an API error caused by the bot
+// having no rights for the request, such as writing to a user who
+// blocked the bot or to a chat the bot was kicked from.
+func IsForbidden(err error) bool {
+	apiErr, ok := AsError(err)
+	return ok && apiErr.Code == http.StatusForbidden
+}
+
+// IsBotBlockedByUser returns true if err is an API error caused by the
+// user having blocked the bot.
+func IsBotBlockedByUser(err error) bool {
+	return IsForbidden(err) && hasDescription(err, descriptionBotBlocked)
+}
+
+// IsChatNotFound returns true if err is an API error caused by an
+// unknown chat_id, or a chat the bot has never seen.
+func IsChatNotFound(err error) bool {
+	apiErr, ok := AsError(err)
+	return ok && apiErr.Code == http.StatusBadRequest && hasDescription(err, descriptionChatNotFound)
+}
+
+// IsMessageNotModified returns true if err is an API error caused by
+// editing a message without changing its content or markup.
+func IsMessageNotModified(err error) bool {
+	apiErr, ok := AsError(err)
+	return ok && apiErr.Code == http.StatusBadRequest && hasDescription(err, descriptionMessageNotModified)
+}
+
+// IsTooManyRequests returns true if err is an API error caused by
+// hitting the flood limits. RetryAfter of the Error holds the number
+// of seconds to wait before repeating the request.
+func IsTooManyRequests(err error) bool {
+	apiErr, ok := AsError(err)
+	return ok && apiErr.Code == http.StatusTooManyRequests
+}
+
+// hasDescription checks if the description of the API error contains s.
+func hasDescription(err error, s string) bool {
+	apiErr, ok := AsError(err)
+	return ok && strings.Contains(strings.ToLower(apiErr.Message), s)
+}
@@ -0,0 +1,43 @@
+package tgbotapi_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorHelpers(t *testing.T) {
+	blocked := tgbotapi.Error{Code: 403, Message: "Forbidden: bot was blocked by the user"}
+	kicked := tgbotapi.Error{Code: 403, Message: "Forbidden: bot was kicked from the group chat"}
+	notFound := tgbotapi.Error{Code: 400, Message: "Bad Request: chat not found"}
+	notModified := tgbotapi.Error{Code: 400, Message: "Bad Request: message is not modified: " +
+		"specified new message content and reply markup are exactly the same"}
+	flood := tgbotapi.Error{
+		Code:               429,
+		Message:            "Too Many Requests: retry after 5",
+		ResponseParameters: tgbotapi.ResponseParameters{RetryAfter: 5},
+	}
+
+	require.True(t, tgbotapi.IsForbidden(blocked))
+	require.True(t, tgbotapi.IsBotBlockedByUser(blocked))
+	require.True(t, tgbotapi.IsForbidden(kicked))
+	require.False(t, tgbotapi.IsBotBlockedByUser(kicked))
+	require.True(t, tgbotapi.IsChatNotFound(notFound))
+	require.False(t, tgbotapi.IsChatNotFound(notModified))
+	require.True(t, tgbotapi.IsMessageNotModified(notModified))
+	require.True(t, tgbotapi.IsTooManyRequests(flood))
+	require.False(t, tgbotapi.IsTooManyRequests(notFound))
+
+	wrapped := fmt.Errorf("notify user: %w", blocked)
+	require.True(t, tgbotapi.IsBotBlockedByUser(wrapped))
+
+	apiErr, ok := tgbotapi.AsError(wrapped)
+	require.True(t, ok)
+	require.Equal(t, blocked, apiErr)
+
+	require.False(t, tgbotapi.IsForbidden(errors.New("Forbidden: bot was blocked by the user")))
+	require.False(t, tgbotapi.IsForbidden(nil))
+}
@@ -0,0 +1,204 @@
+package tgbotapi
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+// RequestFileData is the data of a file sent with a request. It is either
+// uploaded in the request, or sent as a string, such as a file_id or URL.
+type RequestFileData interface {
+	// NeedsUpload returns true if the data must be uploaded.
+	NeedsUpload() bool
+	// UploadData returns the name of the file and its data to upload.
+	// If the reader is an io.Closer, it is closed after the upload.
+	UploadData() (string, io.Reader, error)
+	// SendData returns the data to send in place of an upload.
+	SendData() string
+}
+
+// RequestFile is a file sent in a request under Name, the name of the
+// request parameter or of the attachment referenced with attach://<Name>.
+type RequestFile struct {
+	Name string
+	Data RequestFileData
+}
+
+// inputMedia is an InputMedia type with files that may be uploaded.
+type inputMedia interface {
+	// prepare returns a copy of the media with the files to upload
+	// replaced with attach:// references to the returned files,
+	// named after prefix.
+	prepare(prefix string) (interface{}, []RequestFile, error)
+}
+
+// attachFile sets *ref to the reference to file in the JSON params,
+// and returns file in a slice if it must be uploaded under name.
+func attachFile(ref *string, name string, file interface{}) ([]RequestFile, error) {
+	if file == nil {
+		return nil, nil
+	}
+
+	data, err := fileData(file)
+	if err != nil {
+		return nil, err
+	}
+
+	if !data.NeedsUpload() {
+		*ref = data.SendData()
+		return nil, nil
+	}
+
+	*ref = "attach://" + name
+	return []RequestFile{{Name: name, Data: data}}, nil
+}
+
+// FilePath is the path of a local file to upload.
+type FilePath string
+
+// NeedsUpload returns true, the file is always uploaded.
+func (fp FilePath) NeedsUpload() bool {
+	return true
+}
+
+// UploadData opens the file.
+func (fp FilePath) UploadData() (string, io.Reader, error) {
+	file, err := os.Open(string(fp))
+	if err != nil {
+		return "", nil, err
+	}
+	return filepath.Base(string(fp)), file, nil
+}
+
+// SendData panics, as the file must be uploaded.
+func (fp FilePath) SendData() string {
+	panic("FilePath must be uploaded")
+}
+
+// FileURL is the URL of a file for Telegram to download.
+type FileURL string
+
+// NeedsUpload returns false, the URL is sent.
+func (fu FileURL) NeedsUpload() bool {
+	return false
+}
+
+// UploadData panics, as the URL is sent in place of the file.
+func (fu FileURL) UploadData() (string, io.Reader, error) {
+	panic("FileURL cannot be uploaded")
+}
+
+// SendData returns the URL.
+func (fu FileURL) SendData() string {
+	return string(fu)
+}
+
+// FileID is the ID of a file already stored on the Telegram servers.
+type FileID string
+
+// NeedsUpload returns false, the file_id is sent.
+func (fi FileID) NeedsUpload() bool {
+	return false
+}
+
+// UploadData panics, as the file_id is sent in place of the file.
+func (fi FileID) UploadData() (string, io.Reader, error) {
+	panic("FileID cannot be uploaded")
+}
+
+// SendData returns the file_id.
+func (fi FileID) SendData() string {
+	return string(fi)
+}
+
+// NeedsUpload returns true, the bytes are always uploaded.
+func (fb FileBytes) NeedsUpload() bool {
+	return true
+}
+
+// UploadData returns a reader of the bytes.
+func (fb FileBytes) UploadData() (string, io.Reader, error) {
+	return fb.Name, bytes.NewReader(fb.Bytes), nil
+}
+
+// SendData panics, as the bytes must be uploaded.
+func (fb FileBytes) SendData() string {
+	panic("FileBytes must be uploaded")
+}
+
+// NeedsUpload returns true, the reader is always uploaded.
+func (fr FileReader) NeedsUpload() bool {
+	return true
+}
+
+// UploadData returns the reader. It is never closed after the upload,
+// it is left to the owner of the reader.
+func (fr FileReader) UploadData() (string, io.Reader, error) {
+	return fr.Name, struct{ io.Reader }{fr.Reader}, nil
+}
+
+// SendData panics, as the reader must be uploaded.
+func (fr FileReader) SendData() string {
+	panic("FileReader must be uploaded")
+}
+
+// fileData converts a file given as a path, FileBytes, FileReader
+// or url.URL, as accepted by UploadFile, to RequestFileData.
+func fileData(file interface{}) (RequestFileData, error) {
+	switch f := file.(type) {
+	case RequestFileData:
+		return f, nil
+	case string:
+		return FilePath(f), nil
+	case url.URL:
+		return FileURL(f.String()), nil
+	case *url.URL:
+		return FileURL(f.String()), nil
+	default:
+		return nil, errors.New(ErrBadFileType)
+	}
+}
+
+// knownSize returns the size of the file data, or -1 if it is unknown
+// until the data is read.
+func knownSize(data RequestFileData) (int64, error) {
+	switch d := data.(type) {
+	case FilePath:
+		fi, err := os.Stat(string(d))
+		if err != nil {
+			return 0, err
+		}
+		return fi.Size(), nil
+	case FileBytes:
+		return int64(len(d.Bytes)), nil
+	case FileReader:
+		return d.Size, nil
+	default:
+		return -1, nil
+	}
+}
+
+// needsUpload returns true if any of the files must be uploaded.
+func needsUpload(files []RequestFile) bool {
+	for _, file := range files {
+		if file.Data.NeedsUpload() {
+			return true
+		}
+	}
+	return false
+}
+
+// repeatable returns false if any of the files can only be read once,
+// so a request uploading them can't be repeated.
+func repeatable(files []RequestFile) bool {
+	for _, file := range files {
+		if _, ok := file.Data.(FileReader); ok {
+			return false
+		}
+	}
+	return true
+}
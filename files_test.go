@@ -0,0 +1,117 @@
+package tgbotapi_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploadFiles(t *testing.T) {
+	var (
+		media, thumb string
+		files        = map[string]string{}
+	)
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		media = r.FormValue("media")
+		thumb = r.FormValue("thumb")
+
+		for name, headers := range r.MultipartForm.File {
+			file, err := headers[0].Open()
+			require.NoError(t, err)
+			data, err := ioutil.ReadAll(file)
+			require.NoError(t, err)
+			files[name] = headers[0].Filename + ":" + string(data)
+		}
+
+		_, _ = w.Write([]byte(`{"ok":true,"result":[]}`))
+	})
+
+	params := tgbotapi.Params{
+		"chat_id": "76918703",
+		"media":   `[{"type":"photo","media":"attach://first"},{"type":"photo","media":"attach://second"}]`,
+	}
+	_, err := bot.UploadFiles("sendMediaGroup", params, []tgbotapi.RequestFile{
+		{Name: "first", Data: tgbotapi.FileBytes{Name: "a.jpg", Bytes: []byte("aaa")}},
+		{Name: "second", Data: tgbotapi.FileReader{Name: "b.jpg", Reader: strings.NewReader("bbb"), Size: -1}},
+		{Name: "thumb", Data: tgbotapi.FileID("existing")},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, params["media"], media)
+	require.Equal(t, "existing", thumb)
+	require.Equal(t, map[string]string{"first": "a.jpg:aaa", "second": "b.jpg:bbb"}, files)
+}
+
+func TestUploadFilePath(t *testing.T) {
+	var name string
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		_, header, err := r.FormFile("photo")
+		require.NoError(t, err)
+		name = header.Filename
+		_, _ = w.Write([]byte(`{"ok":true,"result":{"message_id":1}}`))
+	})
+
+	_, err := bot.Send(tgbotapi.NewPhotoUpload(ChatID, tgbotapi.FilePath("tests/image.jpg")))
+	require.NoError(t, err)
+	require.Equal(t, "image.jpg", name)
+
+	_, err = bot.Send(tgbotapi.NewPhotoUpload(ChatID, tgbotapi.FilePath("tests/missing.jpg")))
+	require.Error(t, err)
+
+	_, err = bot.Send(tgbotapi.NewPhotoUpload(ChatID, 42))
+	require.EqualError(t, err, tgbotapi.ErrBadFileType)
+}
+
+func TestSendThumbnail(t *testing.T) {
+	var (
+		contentType string
+		fields      map[string]string
+		files       []string
+	)
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		fields = map[string]string{}
+		files = nil
+
+		if strings.HasPrefix(contentType, "multipart/") {
+			require.NoError(t, r.ParseMultipartForm(1<<20))
+			for name := range r.MultipartForm.File {
+				files = append(files, name)
+			}
+		} else {
+			require.NoError(t, r.ParseForm())
+		}
+		for key := range r.Form {
+			fields[key] = r.Form.Get(key)
+		}
+
+		_, _ = w.Write([]byte(`{"ok":true,"result":{"message_id":1}}`))
+	})
+
+	video := tgbotapi.NewVideoUpload(ChatID, "tests/video.mp4")
+	video.Thumbnail = tgbotapi.FileBytes{Name: "thumb.jpg", Bytes: []byte("jpeg")}
+	_, err := bot.Send(video)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"video", "thumbnail"}, files)
+
+	video = tgbotapi.NewVideoShare(ChatID, "video-id")
+	_, err = bot.Send(video)
+	require.NoError(t, err)
+	require.Equal(t, "application/x-www-form-urlencoded", contentType)
+	require.Equal(t, "video-id", fields["video"])
+
+	video.Thumbnail = "tests/image.jpg"
+	_, err = bot.Send(video)
+	require.NoError(t, err)
+	require.Equal(t, []string{"thumbnail"}, files)
+	require.Equal(t, "video-id", fields["video"])
+
+	video.Thumbnail = 42
+	_, err = bot.Send(video)
+	require.EqualError(t, err, tgbotapi.ErrBadFileType)
+}
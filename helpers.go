@@ -13,11 +13,37 @@ func NewMessage(chatID int64, text string) MessageConfig {
 			ChatID:           chatID,
 			ReplyToMessageID: 0,
 		},
-		Text:                  text,
-		DisableWebPagePreview: false,
+		Text: text,
+	}
+}
+
+// NewReplyParameters creates reply parameters replying to messageID
+// in the chat the message is sent to.
+func NewReplyParameters(messageID int) *ReplyParameters {
+	return &ReplyParameters{MessageID: messageID}
+}
+
+// NewQuoteReply creates reply parameters replying to messageID and quoting
+// quote, an exact part of its text.
+func NewQuoteReply(messageID int, quote string) *ReplyParameters {
+	return &ReplyParameters{
+		MessageID: messageID,
+		Quote:     quote,
 	}
 }
 
+// NewLinkPreview creates link preview options previewing url
+// rather than the first link of the text.
+func NewLinkPreview(url string) *LinkPreviewOptions {
+	return &LinkPreviewOptions{URL: url}
+}
+
+// NewLinkPreviewDisabled creates link preview options
+// disabling the link preview.
+func NewLinkPreviewDisabled() *LinkPreviewOptions {
+	return &LinkPreviewOptions{IsDisabled: true}
+}
+
 // NewDice creates a new DiceConfig.
 //
 // chatID is where to send it
@@ -50,6 +76,15 @@ func NewDeleteMessage(chatID int64, messageID int) DeleteMessageConfig {
 	}
 }
 
+// NewDeleteMessages creates a request to delete several messages of a chat.
+// Messages which can't be deleted are skipped.
+func NewDeleteMessages(chatID int64, messageIDs []int) DeleteMessagesConfig {
+	return DeleteMessagesConfig{
+		ChatID:     chatID,
+		MessageIDs: messageIDs,
+	}
+}
+
 // NewMessageToChannel creates a new Message that is sent to a channel
 // by username.
 //
@@ -76,6 +111,39 @@ func NewForward(chatID int64, fromChatID int64, messageID int) ForwardConfig {
 	}
 }
 
+// NewForwardMessages creates a new forward of several messages of a chat.
+//
+// The messages are forwarded in the order of messageIDs, which must be
+// strictly increasing.
+func NewForwardMessages(chatID int64, fromChatID int64, messageIDs []int) ForwardMessagesConfig {
+	return ForwardMessagesConfig{
+		ChatID:     chatID,
+		FromChatID: fromChatID,
+		MessageIDs: messageIDs,
+	}
+}
+
+// NewCopyMessage creates a new copy of a message.
+//
+// chatID is where to send it, fromChatID is the source chat,
+// and messageID is the ID of the original message.
+func NewCopyMessage(chatID int64, fromChatID int64, messageID int) CopyMessageConfig {
+	return CopyMessageConfig{
+		BaseChat:   BaseChat{ChatID: chatID},
+		FromChatID: fromChatID,
+		MessageID:  messageID,
+	}
+}
+
+// NewCopyMessages creates a new copy of several messages of a chat.
+func NewCopyMessages(chatID int64, fromChatID int64, messageIDs []int) CopyMessagesConfig {
+	return CopyMessagesConfig{
+		ChatID:     chatID,
+		FromChatID: fromChatID,
+		MessageIDs: messageIDs,
+	}
+}
+
 // NewPhotoUpload creates a new photo uploader.
 //
 // chatID is where to send it, file is a string path to the file,
@@ -316,7 +384,8 @@ func NewVoiceShare(chatID int64, fileID string) VoiceConfig {
 }
 
 // NewMediaGroup creates a new media group. Files should be an array of
-// two to ten InputMediaPhoto or InputMediaVideo.
+// two to ten InputMediaPhoto, InputMediaVideo, InputMediaAudio
+// or InputMediaDocument.
 func NewMediaGroup(chatID int64, files []interface{}) MediaGroupConfig {
 	return MediaGroupConfig{
 		BaseChat: BaseChat{
@@ -326,6 +395,79 @@ func NewMediaGroup(chatID int64, files []interface{}) MediaGroupConfig {
 	}
 }
 
+// NewPaidMedia creates a new post of media paid with starCount stars.
+func NewPaidMedia(chatID int64, starCount int, media ...interface{}) SendPaidMediaConfig {
+	return SendPaidMediaConfig{
+		BaseChat: BaseChat{
+			ChatID: chatID,
+		},
+		StarCount: starCount,
+		Media:     media,
+	}
+}
+
+// NewInputPaidMediaPhoto creates a new InputPaidMediaPhoto.
+func NewInputPaidMediaPhoto(media string) InputPaidMediaPhoto {
+	return InputPaidMediaPhoto{
+		Type:  "photo",
+		Media: media,
+	}
+}
+
+// NewInputPaidMediaVideo creates a new InputPaidMediaVideo.
+func NewInputPaidMediaVideo(media string) InputPaidMediaVideo {
+	return InputPaidMediaVideo{
+		Type:  "video",
+		Media: media,
+	}
+}
+
+// NewInputPaidMediaPhotoUpload creates a new InputPaidMediaPhoto uploading
+// file, a file path, FileBytes, FileReader or RequestFileData.
+func NewInputPaidMediaPhotoUpload(file interface{}) InputPaidMediaPhoto {
+	return InputPaidMediaPhoto{
+		Type: "photo",
+		File: file,
+	}
+}
+
+// NewInputPaidMediaVideoUpload creates a new InputPaidMediaVideo uploading file.
+func NewInputPaidMediaVideoUpload(file interface{}) InputPaidMediaVideo {
+	return InputPaidMediaVideo{
+		Type: "video",
+		File: file,
+	}
+}
+
+// NewInputChecklist creates a new checklist with tasks.
+func NewInputChecklist(title string, tasks ...InputChecklistTask) InputChecklist {
+	return InputChecklist{
+		Title: title,
+		Tasks: tasks,
+	}
+}
+
+// NewInputChecklistTask creates a new task of a checklist. The id must be
+// positive and unique among the tasks of the checklist.
+func NewInputChecklistTask(id int, text string) InputChecklistTask {
+	return InputChecklistTask{
+		ID:   id,
+		Text: text,
+	}
+}
+
+// NewChecklist creates a new checklist sent on behalf of the business account
+// of businessConnectionID.
+func NewChecklist(businessConnectionID string, chatID int64, checklist InputChecklist) SendChecklistConfig {
+	return SendChecklistConfig{
+		BaseChat: BaseChat{
+			ChatID:               chatID,
+			BusinessConnectionID: businessConnectionID,
+		},
+		Checklist: checklist,
+	}
+}
+
 // NewInputMediaPhoto creates a new InputMediaPhoto.
 func NewInputMediaPhoto(media string) InputMediaPhoto {
 	return InputMediaPhoto{
@@ -342,6 +484,71 @@ func NewInputMediaVideo(media string) InputMediaVideo {
 	}
 }
 
+// NewInputMediaAnimation creates a new InputMediaAnimation.
+func NewInputMediaAnimation(media string) InputMediaAnimation {
+	return InputMediaAnimation{
+		Type:  "animation",
+		Media: media,
+	}
+}
+
+// NewInputMediaAudio creates a new InputMediaAudio.
+func NewInputMediaAudio(media string) InputMediaAudio {
+	return InputMediaAudio{
+		Type:  "audio",
+		Media: media,
+	}
+}
+
+// NewInputMediaDocument creates a new InputMediaDocument.
+func NewInputMediaDocument(media string) InputMediaDocument {
+	return InputMediaDocument{
+		Type:  "document",
+		Media: media,
+	}
+}
+
+// NewInputMediaPhotoUpload creates a new InputMediaPhoto uploading file,
+// a file path, FileBytes, FileReader or RequestFileData.
+func NewInputMediaPhotoUpload(file interface{}) InputMediaPhoto {
+	return InputMediaPhoto{
+		Type: "photo",
+		File: file,
+	}
+}
+
+// NewInputMediaVideoUpload creates a new InputMediaVideo uploading file.
+func NewInputMediaVideoUpload(file interface{}) InputMediaVideo {
+	return InputMediaVideo{
+		Type: "video",
+		File: file,
+	}
+}
+
+// NewInputMediaAnimationUpload creates a new InputMediaAnimation uploading file.
+func NewInputMediaAnimationUpload(file interface{}) InputMediaAnimation {
+	return InputMediaAnimation{
+		Type: "animation",
+		File: file,
+	}
+}
+
+// NewInputMediaAudioUpload creates a new InputMediaAudio uploading file.
+func NewInputMediaAudioUpload(file interface{}) InputMediaAudio {
+	return InputMediaAudio{
+		Type: "audio",
+		File: file,
+	}
+}
+
+// NewInputMediaDocumentUpload creates a new InputMediaDocument uploading file.
+func NewInputMediaDocumentUpload(file interface{}) InputMediaDocument {
+	return InputMediaDocument{
+		Type: "document",
+		File: file,
+	}
+}
+
 // NewContact allows you to send a shared contact.
 func NewContact(chatID int64, phoneNumber, firstName string) ContactConfig {
 	return ContactConfig{
@@ -379,6 +586,45 @@ func NewVenue(chatID int64, title, address string, latitude, longitude float64)
 	}
 }
 
+// NewPoll allows you to send a regular, anonymous poll.
+func NewPoll(chatID int64, question string, options ...string) SendPollConfig {
+	return SendPollConfig{
+		BaseChat: BaseChat{
+			ChatID: chatID,
+		},
+		Question:    question,
+		Options:     options,
+		IsAnonymous: true,
+		Type:        PollTypeRegular,
+	}
+}
+
+// NewQuiz allows you to send an anonymous quiz, with a single correct answer.
+//
+// correctOptionID is the 0-based index of the correct option.
+func NewQuiz(chatID int64, question string, correctOptionID int64, options ...string) SendPollConfig {
+	return SendPollConfig{
+		BaseChat: BaseChat{
+			ChatID: chatID,
+		},
+		Question:        question,
+		Options:         options,
+		IsAnonymous:     true,
+		Type:            PollTypeQuiz,
+		CorrectOptionID: correctOptionID,
+	}
+}
+
+// NewStopPoll stops a poll sent by the bot, and shows its final results.
+func NewStopPoll(chatID int64, messageID int) StopPollConfig {
+	return StopPollConfig{
+		BaseEdit: BaseEdit{
+			ChatID:    chatID,
+			MessageID: messageID,
+		},
+	}
+}
+
 // NewChatAction sets a chat action.
 // Actions last for 5 seconds, or until your next action.
 //
@@ -675,6 +921,19 @@ func NewEditMessageText(chatID int64, messageID int, text string) EditMessageTex
 	}
 }
 
+// NewEditMessageChecklist allows you to edit a checklist sent on behalf
+// of the business account of businessConnectionID.
+func NewEditMessageChecklist(businessConnectionID string, chatID int64, messageID int, checklist InputChecklist) EditMessageChecklistConfig {
+	return EditMessageChecklistConfig{
+		BaseEdit: BaseEdit{
+			ChatID:               chatID,
+			MessageID:            messageID,
+			BusinessConnectionID: businessConnectionID,
+		},
+		Checklist: checklist,
+	}
+}
+
 // NewEditMessageTextAndMarkup allows you to edit the text and replymarkup of a message.
 func NewEditMessageTextAndMarkup(
 	chatID int64, messageID int,
@@ -702,6 +961,41 @@ func NewEditMessageCaption(chatID int64, messageID int, caption string) EditMess
 	}
 }
 
+// NewEditMessageMedia allows you to replace the media of a message.
+//
+// media is an InputMedia type, such as InputMediaPhoto.
+func NewEditMessageMedia(chatID int64, messageID int, media interface{}) EditMessageMediaConfig {
+	return EditMessageMediaConfig{
+		BaseEdit: BaseEdit{
+			ChatID:    chatID,
+			MessageID: messageID,
+		},
+		Media: media,
+	}
+}
+
+// NewEditMessageLiveLocation allows you to move a live location.
+func NewEditMessageLiveLocation(chatID int64, messageID int, latitude, longitude float64) EditMessageLiveLocationConfig {
+	return EditMessageLiveLocationConfig{
+		BaseEdit: BaseEdit{
+			ChatID:    chatID,
+			MessageID: messageID,
+		},
+		Latitude:  latitude,
+		Longitude: longitude,
+	}
+}
+
+// NewStopMessageLiveLocation allows you to stop updating a live location.
+func NewStopMessageLiveLocation(chatID int64, messageID int) StopMessageLiveLocationConfig {
+	return StopMessageLiveLocationConfig{
+		BaseEdit: BaseEdit{
+			ChatID:    chatID,
+			MessageID: messageID,
+		},
+	}
+}
+
 // NewEditMessageReplyMarkup allows you to edit the inline
 // keyboard markup.
 func NewEditMessageReplyMarkup(
@@ -762,6 +1056,37 @@ func NewKeyboardButtonLocation(text string) KeyboardButton {
 	}
 }
 
+// NewKeyboardButtonWebApp creates a keyboard button that launches
+// the Web App at url upon click.
+func NewKeyboardButtonWebApp(text, url string) KeyboardButton {
+	return KeyboardButton{
+		Text:   text,
+		WebApp: &WebAppInfo{URL: url},
+	}
+}
+
+// NewKeyboardButtonRequestUsers creates a keyboard button that shares
+// a user chosen upon click, in a “users_shared” message with requestID.
+func NewKeyboardButtonRequestUsers(text string, requestID int) KeyboardButton {
+	return KeyboardButton{
+		Text:         text,
+		RequestUsers: &KeyboardButtonRequestUsers{RequestID: requestID},
+	}
+}
+
+// NewKeyboardButtonRequestChat creates a keyboard button that shares
+// a group or, if isChannel, a channel chosen upon click, in
+// a “chat_shared” message with requestID.
+func NewKeyboardButtonRequestChat(text string, requestID int, isChannel bool) KeyboardButton {
+	return KeyboardButton{
+		Text: text,
+		RequestChat: &KeyboardButtonRequestChat{
+			RequestID:     requestID,
+			ChatIsChannel: isChannel,
+		},
+	}
+}
+
 // NewKeyboardButtonRow creates a row of keyboard buttons.
 func NewKeyboardButtonRow(buttons ...KeyboardButton) []KeyboardButton {
 	var row []KeyboardButton
@@ -817,6 +1142,51 @@ func NewInlineKeyboardButtonSwitch(text, sw string) InlineKeyboardButton {
 	}
 }
 
+// NewInlineKeyboardButtonWebApp creates an inline keyboard button with text
+// which launches the Web App at url.
+func NewInlineKeyboardButtonWebApp(text, url string) InlineKeyboardButton {
+	return InlineKeyboardButton{
+		Text:   text,
+		WebApp: &WebAppInfo{URL: url},
+	}
+}
+
+// NewInlineKeyboardButtonLoginURL creates an inline keyboard button with text
+// which authorizes the user on the website of loginURL.
+func NewInlineKeyboardButtonLoginURL(text string, loginURL LoginURL) InlineKeyboardButton {
+	return InlineKeyboardButton{
+		Text:     text,
+		LoginURL: &loginURL,
+	}
+}
+
+// NewInlineKeyboardButtonSwitchChosenChat creates an inline keyboard button
+// with text which switches the user to inline mode in a chosen chat.
+func NewInlineKeyboardButtonSwitchChosenChat(text string, chosenChat SwitchInlineQueryChosenChat) InlineKeyboardButton {
+	return InlineKeyboardButton{
+		Text:                        text,
+		SwitchInlineQueryChosenChat: &chosenChat,
+	}
+}
+
+// NewInlineKeyboardButtonCopyText creates an inline keyboard button with text
+// which copies copyText to the clipboard.
+func NewInlineKeyboardButtonCopyText(text, copyText string) InlineKeyboardButton {
+	return InlineKeyboardButton{
+		Text:     text,
+		CopyText: &CopyTextButton{Text: copyText},
+	}
+}
+
+// NewAnswerWebAppQuery answers the Web App query with result,
+// an InlineQueryResult such as InlineQueryResultArticle.
+func NewAnswerWebAppQuery(webAppQueryID string, result interface{}) AnswerWebAppQueryConfig {
+	return AnswerWebAppQueryConfig{
+		WebAppQueryID: webAppQueryID,
+		Result:        result,
+	}
+}
+
 // NewInlineKeyboardRow creates an inline keyboard row with buttons.
 func NewInlineKeyboardRow(buttons ...InlineKeyboardButton) []InlineKeyboardButton {
 	var row []InlineKeyboardButton
@@ -873,6 +1243,113 @@ func NewInvoice(
 		Prices:         prices}
 }
 
+// NewStarsInvoice creates a new invoice in Telegram Stars, for digital goods
+// and services, with a single price of amount stars.
+func NewStarsInvoice(chatID int64, title, description, payload string, amount int) InvoiceConfig {
+	return InvoiceConfig{
+		BaseChat:    BaseChat{ChatID: chatID},
+		Title:       title,
+		Description: description,
+		Payload:     payload,
+		Currency:    CurrencyStars,
+		Prices:      &[]LabeledPrice{{Label: title, Amount: amount}},
+	}
+}
+
+// NewStarsSubscriptionLink creates a new link to an invoice for a monthly
+// subscription of amount stars.
+func NewStarsSubscriptionLink(title, description, payload string, amount int) InvoiceLinkConfig {
+	return InvoiceLinkConfig{
+		Title:              title,
+		Description:        description,
+		Payload:            payload,
+		Currency:           CurrencyStars,
+		Prices:             &[]LabeledPrice{{Label: title, Amount: amount}},
+		SubscriptionPeriod: SubscriptionPeriodMonth,
+	}
+}
+
+// NewSendGift sends the gift with giftID to a user.
+func NewSendGift(userID int, giftID string) SendGiftConfig {
+	return SendGiftConfig{
+		UserID: userID,
+		GiftID: giftID,
+	}
+}
+
+// NewSendGiftToChat sends the gift with giftID to a channel chat.
+func NewSendGiftToChat(chatID int64, giftID string) SendGiftConfig {
+	return SendGiftConfig{
+		ChatID: chatID,
+		GiftID: giftID,
+	}
+}
+
+// NewSetUserEmojiStatus sets the emoji status of a user to the custom emoji
+// of customEmojiID, without expiration.
+func NewSetUserEmojiStatus(userID int, customEmojiID string) SetUserEmojiStatusConfig {
+	return SetUserEmojiStatusConfig{
+		UserID:                   userID,
+		EmojiStatusCustomEmojiID: customEmojiID,
+	}
+}
+
+// NewInputSticker creates a new sticker to add to a set from an existing
+// file_id or URL, in format and associated with emoji.
+func NewInputSticker(sticker, format string, emoji ...string) InputSticker {
+	return InputSticker{
+		Sticker:   sticker,
+		Format:    format,
+		EmojiList: emoji,
+	}
+}
+
+// NewInputStickerUpload creates a new sticker to add to a set uploading file,
+// a file path, FileBytes, FileReader or RequestFileData.
+func NewInputStickerUpload(file interface{}, format string, emoji ...string) InputSticker {
+	return InputSticker{
+		File:      file,
+		Format:    format,
+		EmojiList: emoji,
+	}
+}
+
+// NewSetStickerEmojiList changes the emoji associated with sticker.
+func NewSetStickerEmojiList(sticker string, emoji ...string) SetStickerEmojiListConfig {
+	return SetStickerEmojiListConfig{
+		Sticker:   sticker,
+		EmojiList: emoji,
+	}
+}
+
+// NewSetStickerKeywords changes the search keywords of sticker,
+// removing them if there are none.
+func NewSetStickerKeywords(sticker string, keywords ...string) SetStickerKeywordsConfig {
+	return SetStickerKeywordsConfig{
+		Sticker:  sticker,
+		Keywords: keywords,
+	}
+}
+
+// NewSetStickerMaskPosition changes the mask position of sticker,
+// removing it if position is nil.
+func NewSetStickerMaskPosition(sticker string, position *MaskPosition) SetStickerMaskPositionConfig {
+	return SetStickerMaskPositionConfig{
+		Sticker:      sticker,
+		MaskPosition: position,
+	}
+}
+
+// NewCreateNewStickerSet creates a new regular sticker set owned by userID.
+func NewCreateNewStickerSet(userID int, name, title string, stickers ...InputSticker) CreateNewStickerSetConfig {
+	return CreateNewStickerSetConfig{
+		UserID:   userID,
+		Name:     name,
+		Title:    title,
+		Stickers: stickers,
+	}
+}
+
 // NewSetChatPhotoUpload creates a new chat photo uploader.
 //
 // chatID is where to send it, file is a string path to the file,
@@ -903,3 +1380,187 @@ func NewSetChatPhotoShare(chatID int64, fileID string) SetChatPhotoConfig {
 		},
 	}
 }
+
+// NewBotCommandScopeDefault represents the default scope of bot commands.
+func NewBotCommandScopeDefault() BotCommandScope {
+	return BotCommandScope{Type: BotCommandScopeTypeDefault}
+}
+
+// NewBotCommandScopeAllPrivateChats represents the scope of bot commands,
+// covering all private chats.
+func NewBotCommandScopeAllPrivateChats() BotCommandScope {
+	return BotCommandScope{Type: BotCommandScopeTypeAllPrivateChats}
+}
+
+// NewBotCommandScopeAllGroupChats represents the scope of bot commands,
+// covering all group and supergroup chats.
+func NewBotCommandScopeAllGroupChats() BotCommandScope {
+	return BotCommandScope{Type: BotCommandScopeTypeAllGroupChats}
+}
+
+// NewBotCommandScopeAllChatAdministrators represents the scope of bot commands,
+// covering all group and supergroup chat administrators.
+func NewBotCommandScopeAllChatAdministrators() BotCommandScope {
+	return BotCommandScope{Type: BotCommandScopeTypeAllChatAdministrators}
+}
+
+// NewBotCommandScopeChat represents the scope of bot commands,
+// covering a specific chat.
+func NewBotCommandScopeChat(chatID int64) BotCommandScope {
+	return BotCommandScope{
+		Type:   BotCommandScopeTypeChat,
+		ChatID: chatID,
+	}
+}
+
+// NewBotCommandScopeChatAdministrators represents the scope of bot commands,
+// covering all administrators of a specific group or supergroup chat.
+func NewBotCommandScopeChatAdministrators(chatID int64) BotCommandScope {
+	return BotCommandScope{
+		Type:   BotCommandScopeTypeChatAdministrators,
+		ChatID: chatID,
+	}
+}
+
+// NewBotCommandScopeChatMember represents the scope of bot commands,
+// covering a specific member of a group or supergroup chat.
+func NewBotCommandScopeChatMember(chatID, userID int64) BotCommandScope {
+	return BotCommandScope{
+		Type:   BotCommandScopeTypeChatMember,
+		ChatID: chatID,
+		UserID: userID,
+	}
+}
+
+// NewSetMyCommands allows you to set the commands of the default scope.
+func NewSetMyCommands(commands ...BotCommand) SetMyCommandsConfig {
+	return SetMyCommandsConfig{
+		Commands: commands,
+	}
+}
+
+// NewSetMyCommandsWithScope allows you to set the commands of a scope.
+func NewSetMyCommandsWithScope(scope BotCommandScope, commands ...BotCommand) SetMyCommandsConfig {
+	return SetMyCommandsConfig{
+		Commands: commands,
+		Scope:    &scope,
+	}
+}
+
+// NewGetMyCommandsWithScope allows you to get the commands of a scope.
+func NewGetMyCommandsWithScope(scope BotCommandScope) GetMyCommandsConfig {
+	return GetMyCommandsConfig{
+		Scope: &scope,
+	}
+}
+
+// NewDeleteMyCommandsWithScope allows you to delete the commands of a scope.
+func NewDeleteMyCommandsWithScope(scope BotCommandScope) DeleteMyCommandsConfig {
+	return DeleteMyCommandsConfig{
+		Scope: &scope,
+	}
+}
+
+// NewMenuButtonCommands creates a menu button opening the bot's list of commands.
+func NewMenuButtonCommands() MenuButton {
+	return MenuButton{Type: MenuButtonTypeCommands}
+}
+
+// NewMenuButtonWebApp creates a menu button launching the Web App at url.
+func NewMenuButtonWebApp(text, url string) MenuButton {
+	return MenuButton{
+		Type:   MenuButtonTypeWebApp,
+		Text:   text,
+		WebApp: &WebAppInfo{URL: url},
+	}
+}
+
+// NewMenuButtonDefault creates a menu button resetting the button to the default one.
+func NewMenuButtonDefault() MenuButton {
+	return MenuButton{Type: MenuButtonTypeDefault}
+}
+
+// NewSetChatMenuButton allows you to change the menu button of a private chat.
+func NewSetChatMenuButton(chatID int64, button MenuButton) SetChatMenuButtonConfig {
+	return SetChatMenuButtonConfig{
+		ChatID:     chatID,
+		MenuButton: &button,
+	}
+}
+
+// NewApproveChatJoinRequest allows you to approve the join request of a user.
+func NewApproveChatJoinRequest(chatID int64, userID int) ApproveChatJoinRequestConfig {
+	return ApproveChatJoinRequestConfig{
+		ChatID: chatID,
+		UserID: userID,
+	}
+}
+
+// NewDeclineChatJoinRequest allows you to decline the join request of a user.
+func NewDeclineChatJoinRequest(chatID int64, userID int) DeclineChatJoinRequestConfig {
+	return DeclineChatJoinRequestConfig{
+		ChatID: chatID,
+		UserID: userID,
+	}
+}
+
+// NewReactionEmoji creates a reaction with an emoji, such as "👍".
+func NewReactionEmoji(emoji string) ReactionType {
+	return ReactionType{
+		Type:  ReactionTypeEmoji,
+		Emoji: emoji,
+	}
+}
+
+// NewReactionCustomEmoji creates a reaction with a custom emoji.
+func NewReactionCustomEmoji(customEmojiID string) ReactionType {
+	return ReactionType{
+		Type:          ReactionTypeCustomEmoji,
+		CustomEmojiID: customEmojiID,
+	}
+}
+
+// NewReactionPaid creates a paid reaction.
+func NewReactionPaid() ReactionType {
+	return ReactionType{
+		Type: ReactionTypePaid,
+	}
+}
+
+// NewSetMessageReaction sets the reactions of the bot on a message.
+// The reactions of the bot are removed if none are given.
+func NewSetMessageReaction(chatID int64, messageID int, reaction ...ReactionType) SetMessageReactionConfig {
+	return SetMessageReactionConfig{
+		ChatID:    chatID,
+		MessageID: messageID,
+		Reaction:  reaction,
+	}
+}
+
+// NewGetUserChatBoosts gets the boosts added to a chat by a user.
+func NewGetUserChatBoosts(chatID int64, userID int) GetUserChatBoostsConfig {
+	return GetUserChatBoostsConfig{
+		ChatID: chatID,
+		UserID: userID,
+	}
+}
+
+// NewInputProfilePhotoStatic creates a static profile photo. The photo is
+// given as a file path, FileBytes, FileReader or RequestFileData, and is
+// always uploaded.
+func NewInputProfilePhotoStatic(file interface{}) InputProfilePhoto {
+	return InputProfilePhoto{
+		Type: InputProfilePhotoStatic,
+		File: file,
+	}
+}
+
+// NewInputProfilePhotoAnimated creates an animated profile photo from an MPEG4
+// animation, shown starting from mainFrameTimestamp, in seconds.
+func NewInputProfilePhotoAnimated(file interface{}, mainFrameTimestamp float64) InputProfilePhoto {
+	return InputProfilePhoto{
+		Type:               InputProfilePhotoAnimated,
+		File:               file,
+		MainFrameTimestamp: mainFrameTimestamp,
+	}
+}
@@ -0,0 +1,134 @@
+package tgbotapi
+
+// KeyboardBuilder assembles the rows of a keyboard button by button.
+// The same builder can produce an inline keyboard with Build
+// or a reply keyboard with BuildReply:
+//
+//	markup := tgbotapi.NewKeyboard().
+//		Columns(2).
+//		Button("Yes", "answer:yes").
+//		Button("No", "answer:no").
+//		Row().
+//		If(canCancel, func(b *tgbotapi.KeyboardBuilder) {
+//			b.Button("Cancel", "answer:cancel")
+//		}).
+//		Build()
+type KeyboardBuilder struct {
+	columns int
+	rows    [][]keyboardButton
+	newRow  bool
+}
+
+// keyboardButton is a button as it appears in an inline keyboard
+// and in a reply keyboard.
+type keyboardButton struct {
+	inline InlineKeyboardButton
+	reply  KeyboardButton
+}
+
+// NewKeyboard creates an empty keyboard builder. Buttons are added
+// to a single row until Row is called, or Columns is set.
+func NewKeyboard() *KeyboardBuilder {
+	return &KeyboardBuilder{}
+}
+
+// Columns wraps the rows of the buttons added afterwards when they
+// have n buttons. Zero or less disables wrapping.
+func (b *KeyboardBuilder) Columns(n int) *KeyboardBuilder {
+	b.columns = n
+	return b
+}
+
+// Row starts a new row, adding the given buttons to it. The buttons
+// added afterwards go to the same row.
+func (b *KeyboardBuilder) Row(buttons ...InlineKeyboardButton) *KeyboardBuilder {
+	b.newRow = true
+	for _, button := range buttons {
+		b.InlineButton(button)
+	}
+	return b
+}
+
+// Button adds a button with the text and the callback data.
+// In a reply keyboard the button only sends its text.
+func (b *KeyboardBuilder) Button(text, data string) *KeyboardBuilder {
+	return b.add(keyboardButton{
+		inline: NewInlineKeyboardButtonData(text, data),
+		reply:  NewKeyboardButton(text),
+	})
+}
+
+// URL adds a button opening the url.
+// In a reply keyboard the button only sends its text.
+func (b *KeyboardBuilder) URL(text, url string) *KeyboardBuilder {
+	return b.add(keyboardButton{
+		inline: NewInlineKeyboardButtonURL(text, url),
+		reply:  NewKeyboardButton(text),
+	})
+}
+
+// InlineButton adds a prepared inline keyboard button.
+// In a reply keyboard the button only sends its text.
+func (b *KeyboardBuilder) InlineButton(button InlineKeyboardButton) *KeyboardBuilder {
+	return b.add(keyboardButton{
+		inline: button,
+		reply:  NewKeyboardButton(button.Text),
+	})
+}
+
+// ReplyButton adds a prepared reply keyboard button.
+// In an inline keyboard the button sends its text as the callback data.
+func (b *KeyboardBuilder) ReplyButton(button KeyboardButton) *KeyboardBuilder {
+	return b.add(keyboardButton{
+		inline: NewInlineKeyboardButtonData(button.Text, button.Text),
+		reply:  button,
+	})
+}
+
+// If calls add with the builder only if cond is true, to add
+// buttons conditionally without breaking the chain.
+func (b *KeyboardBuilder) If(cond bool, add func(b *KeyboardBuilder)) *KeyboardBuilder {
+	if cond {
+		add(b)
+	}
+	return b
+}
+
+// Build creates the inline keyboard markup.
+func (b *KeyboardBuilder) Build() InlineKeyboardMarkup {
+	rows := make([][]InlineKeyboardButton, 0, len(b.rows))
+	for _, row := range b.rows {
+		buttons := make([]InlineKeyboardButton, len(row))
+		for i, button := range row {
+			buttons[i] = button.inline
+		}
+		rows = append(rows, buttons)
+	}
+
+	return NewInlineKeyboardMarkup(rows...)
+}
+
+// BuildReply creates the reply keyboard markup.
+func (b *KeyboardBuilder) BuildReply() ReplyKeyboardMarkup {
+	rows := make([][]KeyboardButton, 0, len(b.rows))
+	for _, row := range b.rows {
+		buttons := make([]KeyboardButton, len(row))
+		for i, button := range row {
+			buttons[i] = button.reply
+		}
+		rows = append(rows, buttons)
+	}
+
+	return NewReplyKeyboard(rows...)
+}
+
+func (b *KeyboardBuilder) add(button keyboardButton) *KeyboardBuilder {
+	last := len(b.rows) - 1
+	if b.newRow || last < 0 || (b.columns > 0 && len(b.rows[last]) >= b.columns) {
+		b.rows = append(b.rows, nil)
+		last++
+		b.newRow = false
+	}
+	b.rows[last] = append(b.rows[last], button)
+	return b
+}
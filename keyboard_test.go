@@ -0,0 +1,60 @@
+package tgbotapi_test
+
+import (
+	"testing"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyboardBuilder(t *testing.T) {
+	builder := tgbotapi.NewKeyboard().
+		Columns(2).
+		Button("1", "n:1").
+		Button("2", "n:2").
+		Button("3", "n:3").
+		Row(tgbotapi.NewInlineKeyboardButtonData("Back", "back")).
+		If(false, func(b *tgbotapi.KeyboardBuilder) {
+			b.Button("Hidden", "hidden")
+		}).
+		If(true, func(b *tgbotapi.KeyboardBuilder) {
+			b.URL("Site", "https://example.com")
+		})
+
+	require.Equal(t, tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData("1", "n:1"),
+			tgbotapi.NewInlineKeyboardButtonData("2", "n:2"),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData("3", "n:3"),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData("Back", "back"),
+			tgbotapi.NewInlineKeyboardButtonURL("Site", "https://example.com"),
+		),
+	), builder.Build())
+
+	require.Equal(t, tgbotapi.NewReplyKeyboard(
+		tgbotapi.NewKeyboardButtonRow(tgbotapi.NewKeyboardButton("1"), tgbotapi.NewKeyboardButton("2")),
+		tgbotapi.NewKeyboardButtonRow(tgbotapi.NewKeyboardButton("3")),
+		tgbotapi.NewKeyboardButtonRow(tgbotapi.NewKeyboardButton("Back"), tgbotapi.NewKeyboardButton("Site")),
+	), builder.BuildReply())
+}
+
+func TestKeyboardBuilderReplyButtons(t *testing.T) {
+	contact := tgbotapi.NewKeyboardButtonContact("Share")
+	markup := tgbotapi.NewKeyboard().
+		ReplyButton(contact).
+		Row().
+		Button("Cancel", "").
+		BuildReply()
+
+	require.Equal(t, [][]tgbotapi.KeyboardButton{
+		{contact},
+		{tgbotapi.NewKeyboardButton("Cancel")},
+	}, markup.Keyboard)
+	require.True(t, markup.ResizeKeyboard)
+
+	require.Empty(t, tgbotapi.NewKeyboard().Build().InlineKeyboard)
+}
@@ -2,8 +2,10 @@ package tgbotapi
 
 import (
 	"errors"
+	"fmt"
 	stdlog "log"
 	"os"
+	"strings"
 )
 
 // BotLogger is an interface that represents the required methods to log data.
@@ -25,3 +27,66 @@ func SetLogger(logger BotLogger) error {
 	log = logger
 	return nil
 }
+
+// Logger is a structured logger used by BotAPI.
+//
+// keysAndValues are alternating keys and values describing the event,
+// such as "method", "getUpdates", "error", err.
+// A *slog.Logger from the standard library implements Logger as is.
+type Logger interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+}
+
+// stdLogger adapts a BotLogger to the Logger interface.
+type stdLogger struct {
+	logger BotLogger
+}
+
+// NewStdLogger creates a Logger writing lines like
+// "ERROR Failed to get updates error=..." to a BotLogger,
+// such as *log.Logger from the standard library.
+func NewStdLogger(logger BotLogger) Logger {
+	return stdLogger{logger: logger}
+}
+
+// Debug logs a message at debug level.
+func (l stdLogger) Debug(msg string, keysAndValues ...interface{}) {
+	l.print("DEBUG", msg, keysAndValues)
+}
+
+// Info logs a message at info level.
+func (l stdLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.print("INFO", msg, keysAndValues)
+}
+
+// Error logs a message at error level.
+func (l stdLogger) Error(msg string, keysAndValues ...interface{}) {
+	l.print("ERROR", msg, keysAndValues)
+}
+
+func (l stdLogger) print(level, msg string, keysAndValues []interface{}) {
+	var b strings.Builder
+	b.WriteString(level)
+	b.WriteByte(' ')
+	b.WriteString(msg)
+
+	for i := 0; i < len(keysAndValues); i += 2 {
+		if i+1 < len(keysAndValues) {
+			fmt.Fprintf(&b, " %v=%v", keysAndValues[i], keysAndValues[i+1])
+		} else {
+			fmt.Fprintf(&b, " %v", keysAndValues[i])
+		}
+	}
+
+	l.logger.Println(b.String())
+}
+
+// logger returns the Logger of the bot, falling back to the package logger.
+func (bot *BotAPI) logger() Logger {
+	if bot.Logger != nil {
+		return bot.Logger
+	}
+	return NewStdLogger(log)
+}
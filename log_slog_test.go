@@ -0,0 +1,11 @@
+//go:build go1.21
+
+package tgbotapi_test
+
+import (
+	"log/slog"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+)
+
+var _ tgbotapi.Logger = (*slog.Logger)(nil)
@@ -0,0 +1,38 @@
+package tgbotapi_test
+
+import (
+	"bytes"
+	stdlog "log"
+	"net/http"
+	"testing"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStdLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := tgbotapi.NewStdLogger(stdlog.New(&buf, "", 0))
+
+	logger.Error("Failed to get updates", "error", "timeout", "attempt", 2)
+
+	require.Equal(t, "ERROR Failed to get updates error=timeout attempt=2\n", buf.String())
+}
+
+func TestDebugRedactsToken(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ok":true,"result":{"message_id":1,"text":"` + TestToken + `"}}`))
+	})
+
+	var buf bytes.Buffer
+	bot.Logger = tgbotapi.NewStdLogger(stdlog.New(&buf, "", 0))
+	bot.Debug = true
+
+	_, err := bot.Send(tgbotapi.NewMessage(ChatID, TestToken))
+	require.NoError(t, err)
+
+	require.Contains(t, buf.String(), "DEBUG Request method=sendMessage")
+	require.Contains(t, buf.String(), "DEBUG Response method=sendMessage status=200")
+	require.Contains(t, buf.String(), "<token>")
+	require.NotContains(t, buf.String(), TestToken)
+}
@@ -0,0 +1,87 @@
+package tgbotapi
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LoginUser is a user authorized with the Telegram Login Widget.
+type LoginUser struct {
+	// ID is the identifier of the user
+	ID int64 `json:"id"`
+	// FirstName of the user
+	FirstName string `json:"first_name"`
+	// LastName of the user
+	//
+	// optional
+	LastName string `json:"last_name,omitempty"`
+	// UserName of the user
+	//
+	// optional
+	UserName string `json:"username,omitempty"`
+	// PhotoURL of the profile photo of the user
+	//
+	// optional
+	PhotoURL string `json:"photo_url,omitempty"`
+	// AuthDate is when the user was authorized, as a Unix timestamp
+	AuthDate int64 `json:"auth_date"`
+}
+
+// AuthTime converts the authorization timestamp into a Time.
+func (u *LoginUser) AuthTime() time.Time {
+	return time.Unix(u.AuthDate, 0)
+}
+
+// ValidateLoginWidgetData checks that data, the fields the Telegram Login
+// Widget passes to its callback, were signed for the bot of botToken, and
+// returns the user in them.
+//
+// Data authorized more than maxAge ago is rejected with ErrLoginDataExpired,
+// to limit replays of leaked data. A maxAge of 0 accepts any age.
+func ValidateLoginWidgetData(data url.Values, botToken string, maxAge time.Duration) (*LoginUser, error) {
+	hash, err := hex.DecodeString(data.Get("hash"))
+	if err != nil || len(hash) == 0 {
+		return nil, errors.New(ErrBadLoginData)
+	}
+
+	pairs := make([]string, 0, len(data))
+	for key := range data {
+		if key != "hash" {
+			pairs = append(pairs, key+"="+data.Get(key))
+		}
+	}
+	sort.Strings(pairs)
+
+	secret := sha256.Sum256([]byte(botToken))
+	mac := hmac.New(sha256.New, secret[:])
+	mac.Write([]byte(strings.Join(pairs, "\n")))
+	if !hmac.Equal(mac.Sum(nil), hash) {
+		return nil, errors.New(ErrBadLoginData)
+	}
+
+	user := &LoginUser{
+		FirstName: data.Get("first_name"),
+		LastName:  data.Get("last_name"),
+		UserName:  data.Get("username"),
+		PhotoURL:  data.Get("photo_url"),
+	}
+	if user.ID, err = strconv.ParseInt(data.Get("id"), 10, 64); err != nil {
+		return nil, err
+	}
+	if user.AuthDate, err = strconv.ParseInt(data.Get("auth_date"), 10, 64); err != nil {
+		return nil, err
+	}
+
+	if maxAge > 0 && time.Since(user.AuthTime()) > maxAge {
+		return nil, errors.New(ErrLoginDataExpired)
+	}
+
+	return user, nil
+}
@@ -0,0 +1,59 @@
+package tgbotapi_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func signLoginData(token string, authDate int64) url.Values {
+	data := url.Values{
+		"id":         {"279058397"},
+		"first_name": {"Ada"},
+		"username":   {"ada"},
+		"auth_date":  {strconv.FormatInt(authDate, 10)},
+	}
+
+	secret := sha256.Sum256([]byte(token))
+	mac := hmac.New(sha256.New, secret[:])
+	mac.Write([]byte("auth_date=" + data.Get("auth_date") + "\nfirst_name=Ada\nid=279058397\nusername=ada"))
+	data.Set("hash", hex.EncodeToString(mac.Sum(nil)))
+
+	return data
+}
+
+func TestValidateLoginWidgetData(t *testing.T) {
+	authDate := time.Now().Add(-time.Minute).Unix()
+	data := signLoginData(TestToken, authDate)
+
+	user, err := tgbotapi.ValidateLoginWidgetData(data, TestToken, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, &tgbotapi.LoginUser{ID: 279058397, FirstName: "Ada", UserName: "ada", AuthDate: authDate}, user)
+
+	_, err = tgbotapi.ValidateLoginWidgetData(data, "123456:other", time.Hour)
+	require.EqualError(t, err, tgbotapi.ErrBadLoginData)
+
+	_, err = tgbotapi.ValidateLoginWidgetData(data, TestToken, time.Second)
+	require.EqualError(t, err, tgbotapi.ErrLoginDataExpired)
+
+	data.Set("first_name", "Eve")
+	_, err = tgbotapi.ValidateLoginWidgetData(data, TestToken, 0)
+	require.EqualError(t, err, tgbotapi.ErrBadLoginData)
+
+	data.Del("hash")
+	_, err = tgbotapi.ValidateLoginWidgetData(data, TestToken, 0)
+	require.EqualError(t, err, tgbotapi.ErrBadLoginData)
+}
+
+func TestValidateLoginWidgetDataAnyAge(t *testing.T) {
+	user, err := tgbotapi.ValidateLoginWidgetData(signLoginData(TestToken, 1600000000), TestToken, 0)
+	require.NoError(t, err)
+	require.Equal(t, time.Unix(1600000000, 0), user.AuthTime())
+}
@@ -0,0 +1,76 @@
+package tgbotapi
+
+import (
+	"context"
+	"net/http"
+)
+
+// Requester sends HTTP requests to the Bot API.
+//
+// Any HttpClient is a Requester.
+type Requester interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// RequesterFunc is an adapter to allow the use of ordinary functions
+// as a Requester.
+type RequesterFunc func(req *http.Request) (*http.Response, error)
+
+// Do calls f(req).
+func (f RequesterFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps a Requester to inspect or modify every outgoing
+// API call and its response.
+type Middleware func(next Requester) Requester
+
+// Use adds middleware to the chain wrapping every outgoing API call.
+//
+// Middleware is applied in the order it was added, so the first one
+// sees the request first and the response last.
+func (bot *BotAPI) Use(middleware ...Middleware) {
+	bot.middleware = append(bot.middleware, middleware...)
+}
+
+// requester returns the Client wrapped with all middleware.
+func (bot *BotAPI) requester() Requester {
+	var requester Requester = bot.Client
+	for i := len(bot.middleware) - 1; i >= 0; i-- {
+		requester = bot.middleware[i](requester)
+	}
+	return requester
+}
+
+type apiMethodKey struct{}
+
+// withAPIMethod returns a copy of ctx carrying the called API method.
+func withAPIMethod(ctx context.Context, method string) context.Context {
+	return context.WithValue(ctx, apiMethodKey{}, method)
+}
+
+// APIMethodFromContext returns the API method called by the request
+// with this context, such as "sendMessage". It is meant to be used
+// by Middleware with req.Context().
+func APIMethodFromContext(ctx context.Context) string {
+	method, _ := ctx.Value(apiMethodKey{}).(string)
+	return method
+}
+
+type chatIDKey struct{}
+
+// withChatID returns a copy of ctx carrying the chat_id of the request.
+func withChatID(ctx context.Context, chatID string) context.Context {
+	if chatID == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, chatIDKey{}, chatID)
+}
+
+// ChatIDFromContext returns the chat_id parameter of the request with
+// this context, or an empty string if the request has none. Like
+// APIMethodFromContext, it is meant to be used by Middleware.
+func ChatIDFromContext(ctx context.Context) string {
+	chatID, _ := ctx.Value(chatIDKey{}).(string)
+	return chatID
+}
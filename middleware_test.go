@@ -0,0 +1,41 @@
+package tgbotapi_test
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddleware(t *testing.T) {
+	var header string
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get("X-Proxy-Auth")
+		_, _ = w.Write([]byte(`{"ok":true,"result":{"message_id":1}}`))
+	})
+
+	var order, methods, chatIDs []string
+	bot.Use(func(next tgbotapi.Requester) tgbotapi.Requester {
+		return tgbotapi.RequesterFunc(func(req *http.Request) (*http.Response, error) {
+			order = append(order, "first")
+			methods = append(methods, tgbotapi.APIMethodFromContext(req.Context()))
+			chatIDs = append(chatIDs, tgbotapi.ChatIDFromContext(req.Context()))
+			return next.Do(req)
+		})
+	}, func(next tgbotapi.Requester) tgbotapi.Requester {
+		return tgbotapi.RequesterFunc(func(req *http.Request) (*http.Response, error) {
+			order = append(order, "second")
+			req.Header.Set("X-Proxy-Auth", "secret")
+			return next.Do(req)
+		})
+	})
+
+	_, err := bot.Send(tgbotapi.NewMessage(ChatID, "test"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"first", "second"}, order)
+	require.Equal(t, []string{"sendMessage"}, methods)
+	require.Equal(t, []string{strconv.FormatInt(ChatID, 10)}, chatIDs)
+	require.Equal(t, "secret", header)
+}
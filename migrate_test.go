@@ -0,0 +1,90 @@
+package tgbotapi_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/stretchr/testify/require"
+)
+
+const migrationResponse = `{"ok":false,"error_code":400,` +
+	`"description":"Bad Request: group chat was upgraded to a supergroup chat",` +
+	`"parameters":{"migrate_to_chat_id":-1001}}`
+
+func TestFollowChatMigration(t *testing.T) {
+	var chatIDs []string
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		chatID := r.FormValue("chat_id")
+		chatIDs = append(chatIDs, chatID)
+		if chatID == "-1" {
+			_, _ = w.Write([]byte(migrationResponse))
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok":true,"result":{"message_id":1}}`))
+	})
+	bot.FollowChatMigration = true
+
+	var migrated [2]int64
+	bot.OnChatMigrated = func(oldChatID, newChatID int64) {
+		migrated = [2]int64{oldChatID, newChatID}
+	}
+
+	msg, err := bot.Send(tgbotapi.NewMessage(-1, "test"))
+	require.NoError(t, err)
+	require.Equal(t, 1, msg.MessageID)
+	require.Equal(t, []string{"-1", "-1001"}, chatIDs)
+	require.Equal(t, [2]int64{-1, -1001}, migrated)
+}
+
+func TestChatMigrationNotFollowed(t *testing.T) {
+	calls := 0
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		_, _ = w.Write([]byte(migrationResponse))
+	})
+
+	var newChatID int64
+	bot.OnChatMigrated = func(_, chatID int64) {
+		newChatID = chatID
+	}
+
+	_, err := bot.Send(tgbotapi.NewMessage(-1, "test"))
+	apiErr, ok := tgbotapi.AsError(err)
+	require.True(t, ok)
+	require.Equal(t, int64(-1001), apiErr.MigrateToChatID)
+	require.Equal(t, 1, calls)
+	require.Equal(t, int64(-1001), newChatID)
+}
+
+func TestChatMigrationKeepsParams(t *testing.T) {
+	var chatIDs []string
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/") {
+			require.NoError(t, r.ParseMultipartForm(1<<20))
+		}
+		chatID := r.FormValue("chat_id")
+		chatIDs = append(chatIDs, chatID)
+		if chatID == "-1" {
+			_, _ = w.Write([]byte(migrationResponse))
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok":true,"result":{"message_id":1}}`))
+	})
+	bot.FollowChatMigration = true
+
+	params := tgbotapi.Params{"chat_id": "-1", "text": "test"}
+	_, err := bot.MakeRequest("sendMessage", params, nil)
+	require.NoError(t, err)
+	require.Equal(t, "-1", params["chat_id"])
+
+	params = tgbotapi.Params{"chat_id": "-1"}
+	_, err = bot.UploadFiles("sendDocument", params, []tgbotapi.RequestFile{
+		{Name: "document", Data: tgbotapi.FileBytes{Name: "doc.txt", Bytes: []byte("doc")}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "-1", params["chat_id"])
+
+	require.Equal(t, []string{"-1", "-1001", "-1", "-1001"}, chatIDs)
+}
@@ -0,0 +1,87 @@
+package tgbotapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// RequestObserver is notified about every API call and every received update.
+// It may be used to collect metrics such as latency and error rates.
+//
+// Methods are called synchronously, so they should return quickly.
+type RequestObserver interface {
+	ObserveRequest(info RequestInfo)
+	ObserveUpdate(update Update)
+}
+
+// RequestInfo describes a finished API call.
+type RequestInfo struct {
+	// Method is the called API method, such as "sendMessage".
+	Method string
+	// StatusCode is the HTTP status code of the response,
+	// or 0 if no response was received.
+	StatusCode int
+	// Duration is how long the call took, including reading the response.
+	Duration time.Duration
+	// Err is the error the call failed with, if any.
+	Err error
+}
+
+// ErrorType returns a short low-cardinality description of Err,
+// suitable for a metric label. It is empty if the call succeeded.
+//
+// It is one of "", "canceled", "timeout", "network", "decode",
+// or "api_" followed by the API error code, such as "api_429".
+func (info RequestInfo) ErrorType() string {
+	var apiErr Error
+	var netErr net.Error
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+
+	switch err := info.Err; {
+	case err == nil:
+		return ""
+	case errors.As(err, &apiErr):
+		return "api_" + strconv.Itoa(apiErr.Code)
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return "timeout"
+	case errors.As(err, &syntaxErr), errors.As(err, &typeErr):
+		return "decode"
+	default:
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) || errors.As(err, &netErr) {
+			return "network"
+		}
+		return "other"
+	}
+}
+
+// observeRequest reports a finished API call to the RequestObserver.
+func (bot *BotAPI) observeRequest(method string, statusCode int, start time.Time, err error) {
+	if bot.Observer == nil {
+		return
+	}
+
+	bot.Observer.ObserveRequest(RequestInfo{
+		Method:     method,
+		StatusCode: statusCode,
+		Duration:   time.Since(start),
+		Err:        err,
+	})
+}
+
+// observeUpdate reports a received update to the RequestObserver.
+func (bot *BotAPI) observeUpdate(update Update) {
+	if bot.Observer != nil {
+		bot.Observer.ObserveUpdate(update)
+	}
+}
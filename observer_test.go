@@ -0,0 +1,72 @@
+package tgbotapi_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingObserver struct {
+	mu       sync.Mutex
+	requests []tgbotapi.RequestInfo
+	updates  []tgbotapi.Update
+}
+
+func (o *recordingObserver) ObserveRequest(info tgbotapi.RequestInfo) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.requests = append(o.requests, info)
+}
+
+func (o *recordingObserver) ObserveUpdate(update tgbotapi.Update) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.updates = append(o.updates, update)
+}
+
+func TestRequestObserver(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/getUpdates") {
+			_, _ = w.Write([]byte(`{"ok":true,"result":[{"update_id":7}]}`))
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"ok":false,"error_code":400,"description":"Bad Request: chat not found"}`))
+	})
+
+	observer := &recordingObserver{}
+	bot.Observer = observer
+
+	_, err := bot.Send(tgbotapi.NewMessage(ChatID, "test"))
+	require.Error(t, err)
+
+	require.Len(t, observer.requests, 1)
+	info := observer.requests[0]
+	require.Equal(t, "sendMessage", info.Method)
+	require.Equal(t, http.StatusBadRequest, info.StatusCode)
+	require.Equal(t, "api_400", info.ErrorType())
+	require.True(t, info.Duration > 0)
+
+	updates, err := bot.GetUpdatesChan(tgbotapi.NewUpdate(0))
+	require.NoError(t, err)
+	<-updates
+	bot.StopReceivingUpdates()
+
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+	require.NotEmpty(t, observer.updates)
+	require.Equal(t, 7, observer.updates[0].UpdateID)
+}
+
+func TestRequestInfoErrorType(t *testing.T) {
+	require.Equal(t, "", tgbotapi.RequestInfo{}.ErrorType())
+	require.Equal(t, "canceled", tgbotapi.RequestInfo{Err: context.Canceled}.ErrorType())
+	require.Equal(t, "timeout", tgbotapi.RequestInfo{Err: context.DeadlineExceeded}.ErrorType())
+	require.Equal(t, "other", tgbotapi.RequestInfo{Err: errors.New("boom")}.ErrorType())
+}
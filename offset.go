@@ -0,0 +1,179 @@
+package tgbotapi
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// OffsetStore keeps the ID of the last update received with
+// GetUpdatesChan, so a restarted bot resumes where it left off.
+//
+// Used as UpdateConfig.OffsetStore, the ID is stored as soon as the update
+// is passed to the channel, so updates are delivered at most once: those
+// still waiting in the channel or being handled when the bot crashes are
+// lost. Wrap the store in an OffsetCommitter to store the ID only after
+// the update is handled.
+type OffsetStore interface {
+	// Get returns the ID of the last received update,
+	// or 0 if there is none yet.
+	Get() (int, error)
+	// Set saves the ID of the last received update.
+	Set(updateID int) error
+}
+
+// MemoryOffsetStore is an OffsetStore keeping the update ID in memory.
+// It lets updates be received again with GetUpdatesChan after
+// StopReceivingUpdates without getting the same update twice.
+type MemoryOffsetStore struct {
+	mu       sync.Mutex
+	updateID int
+}
+
+// Get returns the ID of the last received update.
+func (s *MemoryOffsetStore) Get() (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.updateID, nil
+}
+
+// Set saves the ID of the last received update.
+func (s *MemoryOffsetStore) Set(updateID int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.updateID = updateID
+	return nil
+}
+
+// FileOffsetStore is an OffsetStore keeping the update ID in a file.
+type FileOffsetStore struct {
+	Path string
+
+	mu sync.Mutex
+}
+
+// NewFileOffsetStore creates a FileOffsetStore keeping the update ID in the
+// file at path. The file is created when the first update is received.
+func NewFileOffsetStore(path string) *FileOffsetStore {
+	return &FileOffsetStore{Path: path}
+}
+
+// Get returns the update ID from the file, or 0 if it doesn't exist.
+func (s *FileOffsetStore) Get() (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := ioutil.ReadFile(s.Path)
+	if os.IsNotExist(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+
+	return strconv.Atoi(strings.TrimSpace(string(data)))
+}
+
+// Set writes the update ID to the file. The file is replaced atomically,
+// so it is never left half-written.
+func (s *FileOffsetStore) Set(updateID int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tmp, err := ioutil.TempFile(filepath.Dir(s.Path), filepath.Base(s.Path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.WriteString(strconv.Itoa(updateID) + "\n"); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), s.Path)
+}
+
+// OffsetCommitter stores the ID of an update received with GetUpdatesChan
+// only after the update is committed as handled, so a restarted bot gets
+// the updates that weren't handled again. Updates are committed out of
+// order by concurrent handlers, so an ID is only stored once all the updates
+// received before it are committed too.
+//
+// Set it as UpdateConfig.Committer, and commit the updates with Commit,
+// or set it as Dispatcher.Committer to commit them after they are handled.
+// The zero value with a Store set is ready to use.
+type OffsetCommitter struct {
+	// Store keeps the ID of the last handled update.
+	Store OffsetStore
+
+	mu       sync.Mutex
+	received []int
+	handled  map[int]bool
+}
+
+// NewOffsetCommitter creates an OffsetCommitter keeping the ID
+// of the last handled update in store.
+func NewOffsetCommitter(store OffsetStore) *OffsetCommitter {
+	return &OffsetCommitter{Store: store}
+}
+
+// Commit marks the update as handled, and stores the ID of the last update
+// which was handled along with all the updates before it. Updates not
+// received with GetUpdatesChan are ignored.
+func (c *OffsetCommitter) Commit(updateID int) error {
+	if c.Store == nil {
+		return errors.New(ErrNoOffsetStore)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.isReceived(updateID) {
+		return nil
+	}
+	if c.handled == nil {
+		c.handled = make(map[int]bool)
+	}
+	c.handled[updateID] = true
+
+	last, done := 0, 0
+	for _, id := range c.received {
+		if !c.handled[id] {
+			break
+		}
+		delete(c.handled, id)
+		last = id
+		done++
+	}
+	if done == 0 {
+		return nil
+	}
+	c.received = c.received[done:]
+
+	return c.Store.Set(last)
+}
+
+// isReceived reports if the update was received and not stored yet.
+func (c *OffsetCommitter) isReceived(updateID int) bool {
+	for _, id := range c.received {
+		if id == updateID {
+			return true
+		}
+	}
+	return false
+}
+
+// receive records that the update was received, before it is handled.
+func (c *OffsetCommitter) receive(updateID int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.received = append(c.received, updateID)
+}
@@ -0,0 +1,219 @@
+package tgbotapi_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileOffsetStore(t *testing.T) {
+	store := tgbotapi.NewFileOffsetStore(filepath.Join(t.TempDir(), "offset"))
+
+	updateID, err := store.Get()
+	require.NoError(t, err)
+	require.Zero(t, updateID)
+
+	require.NoError(t, store.Set(42))
+	require.NoError(t, store.Set(43))
+
+	updateID, err = tgbotapi.NewFileOffsetStore(store.Path).Get()
+	require.NoError(t, err)
+	require.Equal(t, 43, updateID)
+}
+
+func TestGetUpdatesChanOffsetStore(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.FormValue("offset"))
+		if offset > 3 {
+			time.Sleep(10 * time.Millisecond)
+			_, _ = w.Write([]byte(`{"ok":true,"result":[]}`))
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"ok":true,"result":[{"update_id":%d}]}`, offset)
+	})
+
+	store := tgbotapi.NewFileOffsetStore(filepath.Join(t.TempDir(), "offset"))
+	require.NoError(t, store.Set(1))
+
+	config := tgbotapi.NewUpdate(0)
+	config.OffsetStore = store
+	updates, err := bot.GetUpdatesChan(config)
+	require.NoError(t, err)
+
+	require.Equal(t, 2, (<-updates).UpdateID)
+	require.Equal(t, 3, (<-updates).UpdateID)
+	require.NoError(t, bot.Shutdown(context.Background()))
+
+	updateID, err := store.Get()
+	require.NoError(t, err)
+	require.Equal(t, 3, updateID)
+}
+
+func TestGetUpdatesChanCommitter(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.FormValue("offset"))
+		if offset > 4 {
+			time.Sleep(10 * time.Millisecond)
+			_, _ = w.Write([]byte(`{"ok":true,"result":[]}`))
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"ok":true,"result":[{"update_id":%d}]}`, offset)
+	})
+
+	store := &tgbotapi.MemoryOffsetStore{}
+	require.NoError(t, store.Set(1))
+	committer := tgbotapi.NewOffsetCommitter(store)
+
+	config := tgbotapi.NewUpdate(0)
+	config.Committer = committer
+	updates, err := bot.GetUpdatesChan(config)
+	require.NoError(t, err)
+
+	require.Equal(t, 2, (<-updates).UpdateID)
+	require.Equal(t, 3, (<-updates).UpdateID)
+	require.Equal(t, 4, (<-updates).UpdateID)
+
+	updateID, err := store.Get()
+	require.NoError(t, err)
+	require.Equal(t, 1, updateID, "received updates are not stored before they are committed")
+
+	// Update 3 is handled first, but 2 is still being handled.
+	require.NoError(t, committer.Commit(3))
+	updateID, _ = store.Get()
+	require.Equal(t, 1, updateID)
+
+	require.NoError(t, committer.Commit(2))
+	updateID, _ = store.Get()
+	require.Equal(t, 3, updateID)
+
+	require.NoError(t, bot.Shutdown(context.Background()))
+	updateID, _ = store.Get()
+	require.Equal(t, 3, updateID, "update 4 was never committed")
+}
+
+func TestOffsetCommitterZeroValue(t *testing.T) {
+	require.EqualError(t, (&tgbotapi.OffsetCommitter{}).Commit(1), tgbotapi.ErrNoOffsetStore)
+
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.FormValue("offset"))
+		if offset > 2 {
+			time.Sleep(10 * time.Millisecond)
+			_, _ = w.Write([]byte(`{"ok":true,"result":[]}`))
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"ok":true,"result":[{"update_id":%d}]}`, offset)
+	})
+
+	config := tgbotapi.NewUpdate(1)
+	config.Committer = &tgbotapi.OffsetCommitter{}
+	_, err := bot.GetUpdatesChan(config)
+	require.EqualError(t, err, tgbotapi.ErrNoOffsetStore)
+
+	store := &tgbotapi.MemoryOffsetStore{}
+	committer := &tgbotapi.OffsetCommitter{Store: store}
+	config.Committer = committer
+	updates, err := bot.GetUpdatesChan(config)
+	require.NoError(t, err)
+
+	require.Equal(t, 1, (<-updates).UpdateID)
+	require.Equal(t, 2, (<-updates).UpdateID)
+	require.NoError(t, committer.Commit(1))
+	require.NoError(t, committer.Commit(2))
+
+	updateID, err := store.Get()
+	require.NoError(t, err)
+	require.Equal(t, 2, updateID)
+
+	require.NoError(t, bot.Shutdown(context.Background()))
+}
+
+func TestOffsetCommitterUnknownUpdates(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.FormValue("offset"))
+		if offset > 2 {
+			time.Sleep(10 * time.Millisecond)
+			_, _ = w.Write([]byte(`{"ok":true,"result":[]}`))
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"ok":true,"result":[{"update_id":%d}]}`, offset)
+	})
+
+	store := &tgbotapi.MemoryOffsetStore{}
+	committer := tgbotapi.NewOffsetCommitter(store)
+
+	// Updates which weren't received are not stored, and don't make
+	// the later ones count as handled.
+	require.NoError(t, committer.Commit(2))
+
+	config := tgbotapi.NewUpdate(1)
+	config.Committer = committer
+	updates, err := bot.GetUpdatesChan(config)
+	require.NoError(t, err)
+
+	require.Equal(t, 1, (<-updates).UpdateID)
+	require.Equal(t, 2, (<-updates).UpdateID)
+	require.NoError(t, committer.Commit(1))
+	require.NoError(t, committer.Commit(5))
+
+	updateID, err := store.Get()
+	require.NoError(t, err)
+	require.Equal(t, 1, updateID, "update 2 was committed before it was received")
+
+	require.NoError(t, committer.Commit(2))
+	updateID, _ = store.Get()
+	require.Equal(t, 2, updateID)
+
+	require.NoError(t, bot.Shutdown(context.Background()))
+}
+
+func TestDispatcherCommitter(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.FormValue("offset"))
+		if offset > 10 {
+			time.Sleep(10 * time.Millisecond)
+			_, _ = w.Write([]byte(`{"ok":true,"result":[]}`))
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"ok":true,"result":[{"update_id":%d}]}`, offset)
+	})
+
+	store := &tgbotapi.MemoryOffsetStore{}
+	committer := tgbotapi.NewOffsetCommitter(store)
+
+	config := tgbotapi.NewUpdate(1)
+	config.Committer = committer
+	updates, err := bot.GetUpdatesChan(config)
+	require.NoError(t, err)
+
+	handled := make(chan int, 10)
+	dispatcher := tgbotapi.NewDispatcher(3, func(update tgbotapi.Update) {
+		handled <- update.UpdateID
+		if update.UpdateID == 5 {
+			panic("boom")
+		}
+	})
+	dispatcher.OnPanic = func(update tgbotapi.Update, recovered interface{}) {}
+	dispatcher.Committer = committer
+
+	done := make(chan error)
+	go func() {
+		done <- dispatcher.Run(context.Background(), updates)
+	}()
+
+	for i := 0; i < 10; i++ {
+		<-handled
+	}
+	require.NoError(t, bot.Shutdown(context.Background()))
+	require.NoError(t, <-done)
+
+	updateID, err := store.Get()
+	require.NoError(t, err)
+	require.Equal(t, 10, updateID)
+}
@@ -0,0 +1,83 @@
+package tgbotapi
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Paginator renders navigation rows of inline keyboards browsing
+// numbered pages, « ‹ 3/12 › », and decodes the callback data
+// of their buttons back into page numbers.
+//
+// Pages are numbered from 1.
+type Paginator struct {
+	// Prefix is prepended to the page number in the callback data,
+	// to tell the navigation callbacks from the others.
+	Prefix string
+	// Pages is the number of pages.
+	Pages int
+}
+
+// NewPaginator creates a Paginator of pages pages with the callback
+// data prefix.
+func NewPaginator(prefix string, pages int) Paginator {
+	return Paginator{
+		Prefix: prefix,
+		Pages:  pages,
+	}
+}
+
+// Data returns the callback data opening the page.
+func (p Paginator) Data(page int) string {
+	return p.Prefix + strconv.Itoa(page)
+}
+
+// Page returns the page number encoded in the callback data,
+// and false if the data doesn't belong to the paginator
+// or the page is out of range.
+func (p Paginator) Page(data string) (int, bool) {
+	if !strings.HasPrefix(data, p.Prefix) {
+		return 0, false
+	}
+
+	page, err := strconv.Atoi(data[len(p.Prefix):])
+	if err != nil || page < 1 || page > p.Pages {
+		return 0, false
+	}
+
+	return page, true
+}
+
+// Row renders the navigation row of the page. The buttons to the
+// first and the previous pages are left out on the first page, and
+// those to the next and the last pages on the last one.
+// The row is empty if there is only one page.
+func (p Paginator) Row(page int) []InlineKeyboardButton {
+	if p.Pages <= 1 {
+		return nil
+	}
+	if page < 1 {
+		page = 1
+	}
+	if page > p.Pages {
+		page = p.Pages
+	}
+
+	var row []InlineKeyboardButton
+	if page > 1 {
+		row = append(row,
+			NewInlineKeyboardButtonData("«", p.Data(1)),
+			NewInlineKeyboardButtonData("‹", p.Data(page-1)),
+		)
+	}
+	row = append(row, NewInlineKeyboardButtonData(
+		strconv.Itoa(page)+"/"+strconv.Itoa(p.Pages), p.Data(page)))
+	if page < p.Pages {
+		row = append(row,
+			NewInlineKeyboardButtonData("›", p.Data(page+1)),
+			NewInlineKeyboardButtonData("»", p.Data(p.Pages)),
+		)
+	}
+
+	return row
+}
@@ -0,0 +1,47 @@
+package tgbotapi_test
+
+import (
+	"testing"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaginatorRow(t *testing.T) {
+	p := tgbotapi.NewPaginator("page:", 12)
+
+	require.Equal(t, []tgbotapi.InlineKeyboardButton{
+		tgbotapi.NewInlineKeyboardButtonData("«", "page:1"),
+		tgbotapi.NewInlineKeyboardButtonData("‹", "page:2"),
+		tgbotapi.NewInlineKeyboardButtonData("3/12", "page:3"),
+		tgbotapi.NewInlineKeyboardButtonData("›", "page:4"),
+		tgbotapi.NewInlineKeyboardButtonData("»", "page:12"),
+	}, p.Row(3))
+
+	require.Equal(t, []tgbotapi.InlineKeyboardButton{
+		tgbotapi.NewInlineKeyboardButtonData("1/12", "page:1"),
+		tgbotapi.NewInlineKeyboardButtonData("›", "page:2"),
+		tgbotapi.NewInlineKeyboardButtonData("»", "page:12"),
+	}, p.Row(0))
+
+	require.Equal(t, []tgbotapi.InlineKeyboardButton{
+		tgbotapi.NewInlineKeyboardButtonData("«", "page:1"),
+		tgbotapi.NewInlineKeyboardButtonData("‹", "page:11"),
+		tgbotapi.NewInlineKeyboardButtonData("12/12", "page:12"),
+	}, p.Row(12))
+
+	require.Empty(t, tgbotapi.NewPaginator("page:", 1).Row(1))
+}
+
+func TestPaginatorPage(t *testing.T) {
+	p := tgbotapi.NewPaginator("page:", 12)
+
+	page, ok := p.Page(p.Data(7))
+	require.True(t, ok)
+	require.Equal(t, 7, page)
+
+	for _, data := range []string{"menu:7", "page:", "page:x", "page:0", "page:13"} {
+		_, ok = p.Page(data)
+		require.False(t, ok, data)
+	}
+}
@@ -10,16 +10,7 @@ import (
 // Params represents a set of parameters that gets passed to a request.
 type Params map[string]string
 
-func newParams(values url.Values) Params {
-	params := Params{}
-	for k, v := range values {
-		if len(v) > 0 {
-			params[k] = v[0]
-		}
-	}
-	return params
-}
-
+// toValues converts Params to url.Values for encoding a request body.
 func (p Params) toValues() url.Values {
 	values := url.Values{}
 	for k, v := range p {
@@ -28,6 +19,17 @@ func (p Params) toValues() url.Values {
 	return values
 }
 
+// with returns a copy of Params with key set to value,
+// leaving the original Params unchanged.
+func (p Params) with(key, value string) Params {
+	params := make(Params, len(p)+1)
+	for k, v := range p {
+		params[k] = v
+	}
+	params[key] = value
+	return params
+}
+
 // AddNonEmpty adds a value if it not an empty string.
 func (p Params) AddNonEmpty(key, value string) {
 	if value != "" {
@@ -56,6 +58,14 @@ func (p Params) AddBool(key string, value bool) {
 	}
 }
 
+// AddBoolPtr adds a value of a bool if it is not nil, so that false
+// can be sent explicitly.
+func (p Params) AddBoolPtr(key string, value *bool) {
+	if value != nil {
+		p[key] = strconv.FormatBool(*value)
+	}
+}
+
 // AddNonZeroFloat adds a floating point value that is not zero.
 func (p Params) AddNonZeroFloat(key string, value float64) {
 	if value != 0 {
@@ -64,10 +74,17 @@ func (p Params) AddNonZeroFloat(key string, value float64) {
 }
 
 // AddInterface adds an interface if it is not nill and can be JSON marshalled.
+// Nil pointers, slices and maps are skipped as well.
 func (p Params) AddInterface(key string, value interface{}) error {
-	if value == nil || (reflect.ValueOf(value).Kind() == reflect.Ptr && reflect.ValueOf(value).IsNil()) {
+	if value == nil {
 		return nil
 	}
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+	}
 
 	b, err := json.Marshal(value)
 	if err != nil {
@@ -79,6 +96,15 @@ func (p Params) AddInterface(key string, value interface{}) error {
 	return nil
 }
 
+// AddNonEmptyStrings adds a JSON array of strings if it is not empty.
+func (p Params) AddNonEmptyStrings(key string, values []string) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	return p.AddInterface(key, values)
+}
+
 // AddFirstValid attempts to add the first item that is not a default value.
 //
 // For example, AddFirstValid(0, "", "test") would add "test".
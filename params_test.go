@@ -0,0 +1,64 @@
+package tgbotapi_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParams(t *testing.T) {
+	yes, no := true, false
+
+	params := make(tgbotapi.Params)
+	params.AddNonEmpty("empty", "")
+	params.AddNonEmpty("text", "hello")
+	params.AddNonZero("zero", 0)
+	params.AddNonZero("limit", 10)
+	params.AddNonZero64("chat_id", -100)
+	params.AddBool("false", false)
+	params.AddBool("is_big", true)
+	params.AddBoolPtr("nil", nil)
+	params.AddBoolPtr("can_send", &yes)
+	params.AddBoolPtr("can_pin", &no)
+	params.AddNonZeroFloat("latitude", 1.5)
+	require.NoError(t, params.AddInterface("markup", []string{"a"}))
+	require.NoError(t, params.AddInterface("nil_markup", (*tgbotapi.InlineKeyboardMarkup)(nil)))
+	require.NoError(t, params.AddInterface("nil_entities", []tgbotapi.MessageEntity(nil)))
+	require.NoError(t, params.AddFirstValid("from", "", 0, "@channel"))
+
+	require.Equal(t, tgbotapi.Params{
+		"text":     "hello",
+		"limit":    "10",
+		"chat_id":  "-100",
+		"is_big":   "true",
+		"can_send": "true",
+		"can_pin":  "false",
+		"latitude": "1.500000",
+		"markup":   `["a"]`,
+		"from":     "@channel",
+	}, params)
+}
+
+func TestSendConfigParams(t *testing.T) {
+	var form url.Values
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		form = r.PostForm
+		_, _ = w.Write([]byte(`{"ok":true,"result":{"message_id":1}}`))
+	})
+
+	photo := tgbotapi.NewPhotoShare(ChatID, "file-id")
+	photo.Caption = "caption"
+	photo.ChannelUsername = "@channel"
+
+	_, err := bot.Send(photo)
+	require.NoError(t, err)
+	require.Equal(t, url.Values{
+		"chat_id": {"@channel"},
+		"photo":   {"file-id"},
+		"caption": {"caption"},
+	}, form)
+}
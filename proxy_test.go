@@ -0,0 +1,63 @@
+package tgbotapi_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetProxy(t *testing.T) {
+	bot := newTestBot(t, nil)
+
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = append(proxied, r.URL.String())
+		_, _ = w.Write([]byte(`{"ok":true,"result":{"id":2,"is_bot":true,"first_name":"Proxied"}}`))
+	}))
+	defer proxy.Close()
+
+	proxyURL, err := url.Parse(proxy.URL)
+	require.NoError(t, err)
+	require.NoError(t, bot.SetProxy(proxyURL))
+
+	self, err := bot.GetMe()
+	require.NoError(t, err)
+	require.Equal(t, "Proxied", self.FirstName)
+	require.Len(t, proxied, 1)
+	require.Contains(t, proxied[0], "/bot"+TestToken+"/getMe")
+
+	require.NoError(t, bot.SetProxy(nil))
+	self, err = bot.GetMe()
+	require.NoError(t, err)
+	require.Equal(t, "Test", self.FirstName)
+	require.Len(t, proxied, 1)
+}
+
+func TestSetProxyCopiesClient(t *testing.T) {
+	bot := newTestBot(t, nil)
+	bot.Client = http.DefaultClient
+
+	proxyURL, err := url.Parse("http://127.0.0.1:3128")
+	require.NoError(t, err)
+	require.NoError(t, bot.SetProxy(proxyURL))
+
+	require.NotSame(t, http.DefaultClient, bot.Client)
+	require.Nil(t, http.DefaultClient.Transport, "the shared client is left unchanged")
+}
+
+func TestSetProxyBadURL(t *testing.T) {
+	bot := newTestBot(t, nil)
+
+	require.EqualError(t, bot.SetProxy(&url.URL{Scheme: "ftp", Host: "127.0.0.1:21"}), tgbotapi.ErrBadProxyURL)
+	require.EqualError(t, bot.SetProxy(&url.URL{Scheme: "socks5"}), tgbotapi.ErrBadProxyURL)
+
+	_, err := tgbotapi.NewBotAPIWithProxy(TestToken, "ftp://127.0.0.1:21")
+	require.EqualError(t, err, tgbotapi.ErrBadProxyURL)
+
+	bot.Client = tgbotapi.HttpClient(nil)
+	require.EqualError(t, bot.SetProxy(nil), tgbotapi.ErrProxyClient)
+}
@@ -0,0 +1,93 @@
+package tgbotapi
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Telegram flood limits for sending messages.
+const (
+	// DefaultGlobalRate is the number of messages per second
+	// a bot may send across all chats.
+	DefaultGlobalRate = 30
+	// DefaultChatInterval is the minimal delay between two messages
+	// sent to the same chat.
+	DefaultChatInterval = time.Second
+)
+
+// RateLimiter paces outgoing requests.
+type RateLimiter interface {
+	// Wait blocks until a request to the chat may be made or ctx is done.
+	// chatID is empty for requests that are not bound to a chat.
+	Wait(ctx context.Context, chatID string) error
+}
+
+// FloodLimiter is a RateLimiter that spaces out requests globally
+// and per chat, queueing them in the order they arrive.
+type FloodLimiter struct {
+	globalInterval time.Duration
+	chatInterval   time.Duration
+
+	mu         sync.Mutex
+	nextGlobal time.Time
+	nextChat   map[string]time.Time
+}
+
+// NewFloodLimiter creates a FloodLimiter allowing globalRate requests
+// per second and one request per chatInterval to every chat.
+func NewFloodLimiter(globalRate int, chatInterval time.Duration) *FloodLimiter {
+	var globalInterval time.Duration
+	if globalRate > 0 {
+		globalInterval = time.Second / time.Duration(globalRate)
+	}
+
+	return &FloodLimiter{
+		globalInterval: globalInterval,
+		chatInterval:   chatInterval,
+		nextChat:       make(map[string]time.Time),
+	}
+}
+
+// NewDefaultFloodLimiter creates a FloodLimiter respecting
+// the Telegram flood limits.
+func NewDefaultFloodLimiter() *FloodLimiter {
+	return NewFloodLimiter(DefaultGlobalRate, DefaultChatInterval)
+}
+
+// Wait reserves the next free slot for the chat and sleeps until it comes.
+func (l *FloodLimiter) Wait(ctx context.Context, chatID string) error {
+	l.mu.Lock()
+	now := time.Now()
+
+	at := now
+	if l.nextGlobal.After(at) {
+		at = l.nextGlobal
+	}
+	if next := l.nextChat[chatID]; chatID != "" && next.After(at) {
+		at = next
+	}
+
+	l.nextGlobal = at.Add(l.globalInterval)
+	if chatID != "" {
+		l.nextChat[chatID] = at.Add(l.chatInterval)
+		l.forgetIdleChats(now)
+	}
+	l.mu.Unlock()
+
+	return sleepContext(ctx, at.Sub(now))
+}
+
+// forgetIdleChats drops chats which may already be sent to again,
+// so the limiter doesn't grow with every chat the bot has ever seen.
+func (l *FloodLimiter) forgetIdleChats(now time.Time) {
+	if len(l.nextChat) < 1024 {
+		return
+	}
+
+	for chatID, next := range l.nextChat {
+		if !next.After(now) {
+			delete(l.nextChat, chatID)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package tgbotapi_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFloodLimiterPacesSameChat(t *testing.T) {
+	limiter := tgbotapi.NewFloodLimiter(1000, 50*time.Millisecond)
+	ctx := context.Background()
+
+	start := time.Now()
+	require.NoError(t, limiter.Wait(ctx, "1"))
+	require.NoError(t, limiter.Wait(ctx, "1"))
+	require.NoError(t, limiter.Wait(ctx, "1"))
+
+	require.True(t, time.Since(start) >= 100*time.Millisecond)
+}
+
+func TestFloodLimiterDoesNotPaceDifferentChats(t *testing.T) {
+	limiter := tgbotapi.NewFloodLimiter(1000, time.Hour)
+	ctx := context.Background()
+
+	start := time.Now()
+	require.NoError(t, limiter.Wait(ctx, "1"))
+	require.NoError(t, limiter.Wait(ctx, "2"))
+	require.NoError(t, limiter.Wait(ctx, "3"))
+
+	require.True(t, time.Since(start) < time.Second)
+}
+
+func TestFloodLimiterCanceled(t *testing.T) {
+	limiter := tgbotapi.NewFloodLimiter(1000, time.Hour)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	require.NoError(t, limiter.Wait(ctx, "1"))
+	require.Equal(t, context.DeadlineExceeded, limiter.Wait(ctx, "1"))
+}
@@ -0,0 +1,22 @@
+package tgbotapi
+
+import "context"
+
+// Request calls an API method with params and decodes its result into T.
+//
+// It allows calling new or custom methods before the library has
+// an explicit wrapper for them:
+//
+//	count, err := tgbotapi.Request[int](bot, "getChatMemberCount", tgbotapi.Params{
+//		"chat_id": "-1001234567890",
+//	})
+func Request[T any](bot *BotAPI, method string, params Params) (T, error) {
+	return RequestWithContext[T](context.Background(), bot, method, params)
+}
+
+// RequestWithContext is Request aborted as soon as ctx is done.
+func RequestWithContext[T any](ctx context.Context, bot *BotAPI, method string, params Params) (T, error) {
+	var result T
+	_, err := bot.MakeRequestWithContext(ctx, method, params, &result)
+	return result, err
+}
@@ -0,0 +1,32 @@
+package tgbotapi_test
+
+import (
+	"net/http"
+	"testing"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequest(t *testing.T) {
+	var chatID string
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		chatID = r.FormValue("chat_id")
+		_, _ = w.Write([]byte(`{"ok":true,"result":{"id":10,"type":"group","title":"test"}}`))
+	})
+
+	chat, err := tgbotapi.Request[tgbotapi.Chat](bot, "getChat", tgbotapi.Params{"chat_id": "10"})
+	require.NoError(t, err)
+	require.Equal(t, "10", chatID)
+	require.Equal(t, tgbotapi.Chat{ID: 10, Type: "group", Title: "test"}, chat)
+}
+
+func TestRequestError(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ok":false,"error_code":400,"description":"Bad Request: chat not found"}`))
+	})
+
+	count, err := tgbotapi.Request[int](bot, "getChatMemberCount", tgbotapi.Params{"chat_id": "10"})
+	require.True(t, tgbotapi.IsChatNotFound(err))
+	require.Zero(t, count)
+}
@@ -0,0 +1,209 @@
+package tgbotapi
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"time"
+)
+
+// Default delays used by RetryPolicy when none are set.
+const (
+	DefaultMinBackoff = 500 * time.Millisecond
+	DefaultMaxBackoff = 30 * time.Second
+)
+
+// DefaultMaxFloodWait is the longest retry_after that is waited out
+// when AutoRetryFloodWait is enabled and MaxFloodWait is not set.
+const DefaultMaxFloodWait = time.Minute
+
+// DefaultMaxFloodWaitRetries is how many times a request is repeated after
+// waiting out the flood control, when MaxFloodWaitRetries is not set.
+const DefaultMaxFloodWaitRetries = 3
+
+// RetryPolicy describes how requests failed with transient errors are retried.
+//
+// Network timeouts and connection errors, 5xx responses and 429 Too Many
+// Requests responses are considered transient, see IsTransientError.
+// When the API asks to wait with retry_after, the next attempt is delayed
+// for at least that long.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	MaxAttempts int
+	// MinBackoff is the delay before the first retry.
+	// It is doubled on every following attempt.
+	//
+	// optional, DefaultMinBackoff is used if zero
+	MinBackoff time.Duration
+	// MaxBackoff caps the delay between two attempts.
+	//
+	// optional, DefaultMaxBackoff is used if zero
+	MaxBackoff time.Duration
+	// Jitter is the fraction of the delay, between 0 and 1,
+	// that is randomized to avoid retrying in lockstep.
+	//
+	// optional
+	Jitter float64
+}
+
+// DefaultPollingBackoff is the delay before GetUpdatesChan tries again after
+// failing to get updates, when UpdateConfig.ErrorBackoff is not set.
+var DefaultPollingBackoff = RetryPolicy{
+	MinBackoff: 3 * time.Second,
+	MaxBackoff: DefaultMaxBackoff,
+	Jitter:     0.2,
+}
+
+// NewRetryPolicy creates a RetryPolicy making up to maxAttempts attempts
+// with the default backoff.
+func NewRetryPolicy(maxAttempts int) *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: maxAttempts,
+		MinBackoff:  DefaultMinBackoff,
+		MaxBackoff:  DefaultMaxBackoff,
+		Jitter:      0.2,
+	}
+}
+
+// Backoff returns the delay before the given retry, counting from 1.
+func (p *RetryPolicy) Backoff(retry int) time.Duration {
+	minBackoff, maxBackoff := p.MinBackoff, p.MaxBackoff
+	if minBackoff <= 0 {
+		minBackoff = DefaultMinBackoff
+	}
+	if maxBackoff <= 0 {
+		maxBackoff = DefaultMaxBackoff
+	}
+
+	delay := minBackoff
+	for i := 1; i < retry && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxBackoff {
+		delay = maxBackoff
+	}
+
+	if p.Jitter > 0 {
+		delay -= time.Duration(p.Jitter * rand.Float64() * float64(delay))
+	}
+
+	return delay
+}
+
+// IsTransientError returns true if a request failed with err may succeed
+// when it is made again: API errors with 429 Too Many Requests or 5xx codes,
+// network timeouts and failed or dropped connections. Wrapped errors are
+// checked too. Canceled requests and certificate errors are not transient.
+func IsTransientError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	if apiErr, ok := AsError(err); ok {
+		return apiErr.Code == http.StatusTooManyRequests || apiErr.Code >= http.StatusInternalServerError
+	}
+
+	var (
+		unknownAuthority x509.UnknownAuthorityError
+		invalidCert      x509.CertificateInvalidError
+		hostname         x509.HostnameError
+	)
+	if errors.As(err, &unknownAuthority) || errors.As(err, &invalidCert) || errors.As(err, &hostname) {
+		return false
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTimeout || dnsErr.IsTemporary
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	var opErr *net.OpError
+	return errors.As(err, &opErr) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF)
+}
+
+// withRetry calls do until it succeeds, fails with an error that is not
+// transient or the retry policy gives up.
+func (bot *BotAPI) withRetry(ctx context.Context, do func() (*APIResponse, error)) (*APIResponse, error) {
+	policy := bot.RetryPolicy
+
+	maxFloodWaits := bot.MaxFloodWaitRetries
+	if maxFloodWaits <= 0 {
+		maxFloodWaits = DefaultMaxFloodWaitRetries
+	}
+
+	for attempt, floodWaits := 1, 0; ; {
+		resp, err := do()
+		if err == nil || ctx.Err() != nil {
+			return resp, err
+		}
+
+		if delay, ok := bot.floodWait(err); ok && floodWaits < maxFloodWaits {
+			if err := sleepContext(ctx, delay); err != nil {
+				return resp, err
+			}
+			floodWaits++
+			continue
+		}
+
+		if policy == nil || attempt >= policy.MaxAttempts || !IsTransientError(err) {
+			return resp, err
+		}
+
+		delay := policy.Backoff(attempt)
+		if e, ok := AsError(err); ok {
+			if retryAfter := time.Duration(e.RetryAfter) * time.Second; retryAfter > delay {
+				delay = retryAfter
+			}
+		}
+
+		if err := sleepContext(ctx, delay); err != nil {
+			return resp, err
+		}
+		attempt++
+	}
+}
+
+// floodWait returns how long to wait before repeating a request
+// rejected by the flood control, if it should be repeated automatically.
+func (bot *BotAPI) floodWait(err error) (time.Duration, bool) {
+	if !bot.AutoRetryFloodWait {
+		return 0, false
+	}
+
+	e, ok := AsError(err)
+	if !ok || e.Code != http.StatusTooManyRequests || e.RetryAfter <= 0 {
+		return 0, false
+	}
+
+	maxWait := bot.MaxFloodWait
+	if maxWait <= 0 {
+		maxWait = DefaultMaxFloodWait
+	}
+
+	delay := time.Duration(e.RetryAfter) * time.Second
+	return delay, delay <= maxWait
+}
+
+// sleepContext pauses for d or until ctx is done, whichever happens first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,125 @@
+package tgbotapi_test
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := tgbotapi.RetryPolicy{
+		MinBackoff: time.Second,
+		MaxBackoff: 5 * time.Second,
+	}
+
+	require.Equal(t, time.Second, policy.Backoff(1))
+	require.Equal(t, 2*time.Second, policy.Backoff(2))
+	require.Equal(t, 4*time.Second, policy.Backoff(3))
+	require.Equal(t, 5*time.Second, policy.Backoff(4))
+}
+
+func TestIsTransientError(t *testing.T) {
+	require.True(t, tgbotapi.IsTransientError(tgbotapi.Error{Code: 502}))
+	require.True(t, tgbotapi.IsTransientError(tgbotapi.Error{Code: 429}))
+	require.False(t, tgbotapi.IsTransientError(tgbotapi.Error{Code: 400}))
+	require.False(t, tgbotapi.IsTransientError(errors.New("bad file type")))
+	require.False(t, tgbotapi.IsTransientError(nil))
+
+	require.True(t, tgbotapi.IsTransientError(fmt.Errorf("middleware: %w", tgbotapi.Error{Code: 503})))
+	require.False(t, tgbotapi.IsTransientError(fmt.Errorf("middleware: %w", tgbotapi.Error{Code: 403})))
+
+	refused := &url.Error{Op: "Post", URL: "https://api.telegram.org", Err: &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}}
+	require.True(t, tgbotapi.IsTransientError(refused))
+	require.True(t, tgbotapi.IsTransientError(&url.Error{Op: "Post", URL: "https://api.telegram.org", Err: io.ErrUnexpectedEOF}))
+	require.True(t, tgbotapi.IsTransientError(&url.Error{Op: "Post", URL: "https://api.telegram.org", Err: &net.DNSError{Err: "timeout", IsTimeout: true}}))
+
+	require.False(t, tgbotapi.IsTransientError(&url.Error{Op: "Post", URL: "https://api.telegram.org", Err: context.Canceled}))
+	require.False(t, tgbotapi.IsTransientError(&url.Error{Op: "Post", URL: "https://api.telegram.org", Err: x509.UnknownAuthorityError{}}))
+	require.False(t, tgbotapi.IsTransientError(&url.Error{Op: "Post", URL: "https://api.telegram.org", Err: &net.DNSError{Err: "no such host", IsNotFound: true}}))
+	require.False(t, tgbotapi.IsTransientError(&url.Error{Op: "parse", URL: ":bad", Err: errors.New("missing protocol scheme")}))
+}
+
+func TestMakeRequestRetriesTransientErrors(t *testing.T) {
+	calls := 0
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok":true,"result":{"message_id":1}}`))
+	})
+	bot.RetryPolicy = &tgbotapi.RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}
+
+	message, err := bot.Send(tgbotapi.NewMessage(ChatID, "test"))
+	require.NoError(t, err)
+	require.Equal(t, 1, message.MessageID)
+	require.Equal(t, 3, calls)
+}
+
+func TestMakeRequestDoesNotRetryBadRequest(t *testing.T) {
+	calls := 0
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		_, _ = w.Write([]byte(`{"ok":false,"error_code":400,"description":"Bad Request: chat not found"}`))
+	})
+	bot.RetryPolicy = &tgbotapi.RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}
+
+	_, err := bot.Send(tgbotapi.NewMessage(ChatID, "test"))
+	require.Error(t, err)
+	require.Equal(t, 1, calls)
+}
+
+func TestMakeRequestWaitsFloodControl(t *testing.T) {
+	calls := 0
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			_, _ = w.Write([]byte(`{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 1","parameters":{"retry_after":1}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok":true,"result":{"message_id":1}}`))
+	})
+	bot.AutoRetryFloodWait = true
+
+	start := time.Now()
+	_, err := bot.Send(tgbotapi.NewMessage(ChatID, "test"))
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+	require.True(t, time.Since(start) >= time.Second)
+}
+
+func TestMakeRequestFloodWaitTooLong(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 120","parameters":{"retry_after":120}}`))
+	})
+	bot.AutoRetryFloodWait = true
+	bot.MaxFloodWait = time.Second
+
+	_, err := bot.Send(tgbotapi.NewMessage(ChatID, "test"))
+	require.Error(t, err)
+}
+
+func TestMakeRequestFloodWaitRetriesLimit(t *testing.T) {
+	calls := 0
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		_, _ = w.Write([]byte(`{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 1","parameters":{"retry_after":1}}`))
+	})
+	bot.AutoRetryFloodWait = true
+	bot.MaxFloodWaitRetries = 1
+
+	_, err := bot.Send(tgbotapi.NewMessage(ChatID, "test"))
+	require.Error(t, err)
+	require.Equal(t, 2, calls)
+}
@@ -0,0 +1,89 @@
+package tgbotapitest_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/Feresey/telegram-bot-api/v5/tgbotapitest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder(t *testing.T) {
+	golden := filepath.Join(t.TempDir(), "golden.json")
+
+	server := tgbotapitest.NewServer()
+	recorder, err := tgbotapitest.NewRecorder(golden, tgbotapitest.ModeRecord)
+	require.NoError(t, err)
+	recorder.Client = server.Client()
+
+	bot, err := tgbotapi.NewBotAPIWithClient(tgbotapitest.Token, server.Endpoint(), recorder)
+	require.NoError(t, err)
+
+	server.RespondError("sendMessage", 403, "Forbidden: bot was blocked by the user")
+	_, err = bot.Send(tgbotapi.NewMessage(42, "first"))
+	require.True(t, tgbotapi.IsBotBlockedByUser(err))
+
+	recorded, err := bot.Send(tgbotapi.NewMessage(42, "second"))
+	require.NoError(t, err)
+
+	require.NoError(t, recorder.Save())
+	server.Close()
+
+	data, err := ioutil.ReadFile(golden)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), tgbotapitest.Token)
+
+	replayer, err := tgbotapitest.NewRecorder(golden, tgbotapitest.ModeReplay)
+	require.NoError(t, err)
+
+	bot, err = tgbotapi.NewBotAPIWithClient(tgbotapitest.Token, server.Endpoint(), replayer)
+	require.NoError(t, err)
+
+	// Interactions are matched by params, not by order.
+	replayed, err := bot.Send(tgbotapi.NewMessage(42, "second"))
+	require.NoError(t, err)
+	require.Equal(t, recorded, replayed)
+
+	_, err = bot.Send(tgbotapi.NewMessage(42, "first"))
+	require.True(t, tgbotapi.IsBotBlockedByUser(err))
+
+	_, err = bot.Send(tgbotapi.NewMessage(42, "first"))
+	require.Error(t, err)
+	require.True(t, strings.Contains(err.Error(), "no recorded interaction"), err)
+}
+
+func TestRecorderNormalize(t *testing.T) {
+	golden := filepath.Join(t.TempDir(), "golden.json")
+
+	server := tgbotapitest.NewServer()
+	defer server.Close()
+
+	normalize := func(method string, params tgbotapi.Params) {
+		if method == "sendMessage" {
+			delete(params, "text")
+		}
+	}
+
+	recorder, err := tgbotapitest.NewRecorder(golden, tgbotapitest.ModeRecord)
+	require.NoError(t, err)
+	recorder.Client = server.Client()
+	recorder.Normalize = normalize
+
+	bot, err := tgbotapi.NewBotAPIWithClient(tgbotapitest.Token, server.Endpoint(), recorder)
+	require.NoError(t, err)
+	_, err = bot.Send(tgbotapi.NewMessage(42, "sent at 12:00"))
+	require.NoError(t, err)
+	require.NoError(t, recorder.Save())
+
+	replayer, err := tgbotapitest.NewRecorder(golden, tgbotapitest.ModeReplay)
+	require.NoError(t, err)
+	replayer.Normalize = normalize
+
+	bot, err = tgbotapi.NewBotAPIWithClient(tgbotapitest.Token, server.Endpoint(), replayer)
+	require.NoError(t, err)
+	_, err = bot.Send(tgbotapi.NewMessage(42, "sent at 12:01"))
+	require.NoError(t, err)
+}
@@ -0,0 +1,354 @@
+// Package tgbotapitest provides a fake Telegram Bot API server
+// for unit testing bots without hitting the real API.
+//
+//	server := tgbotapitest.NewServer()
+//	defer server.Close()
+//
+//	bot, err := server.NewBot()
+//	...
+//	server.SendUpdate(tgbotapi.Update{Message: &tgbotapi.Message{Text: "/start"}})
+//	...
+//	requests := server.RequestsFor("sendMessage")
+//
+// It also provides Recorder, which records real API calls
+// and replays them in tests.
+package tgbotapitest
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+)
+
+// Token is the token of the bots created with Server.NewBot.
+const Token = "123456:TEST-TOKEN"
+
+// maxPollWait limits how long getUpdates waits for an update,
+// regardless of the timeout requested by the bot.
+const maxPollWait = time.Second
+
+// Request is an API call received by the Server.
+type Request struct {
+	// Method is the called API method, such as "sendMessage".
+	Method string
+	// Params holds the parameters of the request.
+	Params tgbotapi.Params
+	// Files holds the files uploaded with the request by their field names.
+	Files map[string]File
+}
+
+// File is a file uploaded to the Server.
+type File struct {
+	Name string
+	Data []byte
+}
+
+// Server is a fake Telegram Bot API server.
+//
+// It records every request, answers them with responses set by Respond
+// and its relatives, and serves updates added by SendUpdate to getUpdates.
+// Without a set response, send* and forwardMessage methods return
+// a message in the requested chat, sendMediaGroup returns a message
+// for each media, and other methods return true.
+type Server struct {
+	*httptest.Server
+
+	// Self is returned by getMe.
+	Self tgbotapi.User
+
+	mu           sync.Mutex
+	requests     []Request
+	responses    map[string][]tgbotapi.APIResponse
+	updates      []tgbotapi.Update
+	nextUpdateID int
+	nextMessage  int
+	newUpdate    chan struct{}
+	closed       chan struct{}
+	closeOnce    sync.Once
+}
+
+// NewServer starts a new Server. It should be closed with Close.
+func NewServer() *Server {
+	s := &Server{
+		Self: tgbotapi.User{
+			ID:        123456,
+			FirstName: "Test",
+			UserName:  "test_bot",
+			IsBot:     true,
+		},
+		responses:    make(map[string][]tgbotapi.APIResponse),
+		nextUpdateID: 1,
+		nextMessage:  1,
+		newUpdate:    make(chan struct{}),
+		closed:       make(chan struct{}),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// Close stops pending getUpdates calls and shuts down the server.
+func (s *Server) Close() {
+	s.closeOnce.Do(func() {
+		close(s.closed)
+	})
+	s.Server.Close()
+}
+
+// Endpoint returns the API endpoint of the server, in the format
+// expected by tgbotapi.NewBotAPIWithAPIEndpoint.
+func (s *Server) Endpoint() string {
+	return s.URL + "/bot%s/%s"
+}
+
+// NewBot creates a bot talking to the server.
+func (s *Server) NewBot() (*tgbotapi.BotAPI, error) {
+	return tgbotapi.NewBotAPIWithClient(Token, s.Endpoint(), s.Client())
+}
+
+// Respond sets the result of the next call to the method.
+// Responses to the same method are returned in the order they were set.
+func (s *Server) Respond(method string, result interface{}) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	s.RespondWith(method, tgbotapi.APIResponse{Ok: true, Result: data})
+	return nil
+}
+
+// RespondError makes the next call to the method fail with the error.
+func (s *Server) RespondError(method string, code int, description string) {
+	s.RespondWith(method, tgbotapi.APIResponse{
+		Ok:          false,
+		ErrorCode:   code,
+		Description: description,
+	})
+}
+
+// RespondWith sets the response to the next call to the method.
+func (s *Server) RespondWith(method string, resp tgbotapi.APIResponse) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.responses[method] = append(s.responses[method], resp)
+}
+
+// SendUpdate adds an update to be received with getUpdates.
+// UpdateID is assigned if it is zero.
+func (s *Server) SendUpdate(update tgbotapi.Update) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if update.UpdateID == 0 {
+		update.UpdateID = s.nextUpdateID
+	}
+	if update.UpdateID >= s.nextUpdateID {
+		s.nextUpdateID = update.UpdateID + 1
+	}
+	s.updates = append(s.updates, update)
+
+	// Wake up the pending getUpdates calls.
+	close(s.newUpdate)
+	s.newUpdate = make(chan struct{})
+}
+
+// Requests returns all requests received by the server, except getMe
+// and getUpdates.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Request(nil), s.requests...)
+}
+
+// RequestsFor returns the requests to the method received by the server.
+func (s *Server) RequestsFor(method string) []Request {
+	var requests []Request
+	for _, req := range s.Requests() {
+		if req.Method == method {
+			requests = append(requests, req)
+		}
+	}
+	return requests
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := parseRequest(r)
+	if err != nil {
+		writeResponse(w, tgbotapi.APIResponse{
+			ErrorCode:   http.StatusBadRequest,
+			Description: "Bad Request: " + err.Error(),
+		})
+		return
+	}
+
+	switch req.Method {
+	case "getMe":
+		writeResult(w, s.Self)
+		return
+	case "getUpdates":
+		writeResult(w, s.pollUpdates(r, req.Params))
+		return
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, req)
+	resp, ok := s.nextResponse(req.Method)
+	if !ok {
+		resp = s.defaultResponse(req)
+	}
+	s.mu.Unlock()
+
+	writeResponse(w, resp)
+}
+
+// nextResponse pops the response set for the method.
+func (s *Server) nextResponse(method string) (tgbotapi.APIResponse, bool) {
+	queue := s.responses[method]
+	if len(queue) == 0 {
+		return tgbotapi.APIResponse{}, false
+	}
+
+	s.responses[method] = queue[1:]
+	return queue[0], true
+}
+
+// defaultResponse returns a plausible successful response to req.
+func (s *Server) defaultResponse(req Request) tgbotapi.APIResponse {
+	var result interface{} = true
+
+	switch {
+	case req.Method == "sendMediaGroup":
+		var media []json.RawMessage
+		_ = json.Unmarshal([]byte(req.Params["media"]), &media)
+
+		messages := make([]tgbotapi.Message, len(media))
+		for i := range messages {
+			messages[i] = s.newMessage(req)
+		}
+		result = messages
+	case req.Method == "sendChatAction":
+	case strings.HasPrefix(req.Method, "send") || req.Method == "forwardMessage":
+		result = s.newMessage(req)
+	}
+
+	data, _ := json.Marshal(result)
+	return tgbotapi.APIResponse{Ok: true, Result: data}
+}
+
+// newMessage returns a new message sent by the bot in the chat of req.
+func (s *Server) newMessage(req Request) tgbotapi.Message {
+	chatID, _ := strconv.ParseInt(req.Params["chat_id"], 10, 64)
+	message := tgbotapi.Message{
+		MessageID: s.nextMessage,
+		From:      &s.Self,
+		Date:      int(time.Now().Unix()),
+		Chat:      &tgbotapi.Chat{ID: chatID},
+		Text:      req.Params["text"],
+	}
+	s.nextMessage++
+
+	return message
+}
+
+// pollUpdates returns the updates starting from the offset, waiting for
+// new ones if there are none.
+func (s *Server) pollUpdates(r *http.Request, params tgbotapi.Params) []tgbotapi.Update {
+	offset, _ := strconv.Atoi(params["offset"])
+	timeout, _ := strconv.Atoi(params["timeout"])
+
+	wait := time.Duration(timeout) * time.Second
+	if wait > maxPollWait {
+		wait = maxPollWait
+	}
+	deadline := time.NewTimer(wait)
+	defer deadline.Stop()
+
+	for {
+		s.mu.Lock()
+		// Updates before the offset are confirmed and forgotten.
+		pending := s.updates[:0]
+		for _, update := range s.updates {
+			if update.UpdateID >= offset {
+				pending = append(pending, update)
+			}
+		}
+		s.updates = pending
+		updates := append([]tgbotapi.Update{}, pending...)
+		newUpdate := s.newUpdate
+		s.mu.Unlock()
+
+		if len(updates) > 0 {
+			return updates
+		}
+
+		select {
+		case <-newUpdate:
+		case <-deadline.C:
+			return updates
+		case <-r.Context().Done():
+			return updates
+		case <-s.closed:
+			return updates
+		}
+	}
+}
+
+// parseRequest extracts the method and parameters of an API call.
+func parseRequest(r *http.Request) (Request, error) {
+	req := Request{
+		Method: r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:],
+		Params: make(tgbotapi.Params),
+		Files:  make(map[string]File),
+	}
+
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		if err := r.ParseMultipartForm(32 << 20); err != nil {
+			return req, err
+		}
+
+		for name, headers := range r.MultipartForm.File {
+			f, err := headers[0].Open()
+			if err != nil {
+				return req, err
+			}
+			data, err := ioutil.ReadAll(f)
+			f.Close()
+			if err != nil {
+				return req, err
+			}
+
+			req.Files[name] = File{Name: headers[0].Filename, Data: data}
+		}
+	} else if err := r.ParseForm(); err != nil {
+		return req, err
+	}
+
+	for key, values := range r.Form {
+		req.Params[key] = values[0]
+	}
+
+	return req, nil
+}
+
+func writeResult(w http.ResponseWriter, result interface{}) {
+	data, _ := json.Marshal(result)
+	writeResponse(w, tgbotapi.APIResponse{Ok: true, Result: data})
+}
+
+func writeResponse(w http.ResponseWriter, resp tgbotapi.APIResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Ok && resp.ErrorCode != 0 {
+		w.WriteHeader(resp.ErrorCode)
+	}
+	_ = json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,92 @@
+package tgbotapitest_test
+
+import (
+	"testing"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/Feresey/telegram-bot-api/v5/tgbotapitest"
+	"github.com/stretchr/testify/require"
+)
+
+// newServerBot creates a bot talking to a tgbotapitest.Server,
+// which is closed when the test ends.
+func newServerBot(t *testing.T) (*tgbotapi.BotAPI, *tgbotapitest.Server) {
+	server := tgbotapitest.NewServer()
+	t.Cleanup(server.Close)
+
+	bot, err := server.NewBot()
+	require.NoError(t, err)
+	return bot, server
+}
+
+func TestServer(t *testing.T) {
+	bot, server := newServerBot(t)
+	require.Equal(t, "test_bot", bot.Self.UserName)
+
+	server.SendUpdate(tgbotapi.Update{Message: &tgbotapi.Message{
+		Text: "/start",
+		Chat: &tgbotapi.Chat{ID: 42},
+	}})
+
+	updates, err := bot.GetUpdatesChan(tgbotapi.NewUpdate(0))
+	require.NoError(t, err)
+
+	update := <-updates
+	bot.StopReceivingUpdates()
+	require.Equal(t, 1, update.UpdateID)
+
+	msg, err := bot.Send(tgbotapi.NewMessage(update.Message.Chat.ID, "hello"))
+	require.NoError(t, err)
+	require.Equal(t, int64(42), msg.Chat.ID)
+	require.Equal(t, "hello", msg.Text)
+
+	requests := server.RequestsFor("sendMessage")
+	require.Len(t, requests, 1)
+	require.Equal(t, "hello", requests[0].Params["text"])
+}
+
+func TestServerResponses(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	require.NoError(t, server.Respond("getChat", tgbotapi.Chat{ID: 10, Title: "group"}))
+	server.RespondError("getChat", 400, "Bad Request: chat not found")
+
+	chat, err := bot.GetChat(tgbotapi.ChatConfig{ChatID: 10})
+	require.NoError(t, err)
+	require.Equal(t, "group", chat.Title)
+
+	_, err = bot.GetChat(tgbotapi.ChatConfig{ChatID: 10})
+	require.True(t, tgbotapi.IsChatNotFound(err))
+
+	_, err = bot.LeaveChat(tgbotapi.ChatConfig{ChatID: 10})
+	require.NoError(t, err)
+}
+
+func TestServerUploads(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	file := tgbotapi.FileBytes{Name: "note.txt", Bytes: []byte("content")}
+	_, err := bot.Send(tgbotapi.NewDocumentUpload(42, file))
+	require.NoError(t, err)
+
+	requests := server.RequestsFor("sendDocument")
+	require.Len(t, requests, 1)
+	require.Equal(t, "42", requests[0].Params["chat_id"])
+	require.Equal(t, tgbotapitest.File{Name: "note.txt", Data: []byte("content")}, requests[0].Files["document"])
+}
+
+func TestServerMediaGroup(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	messages, err := bot.SendMediaGroup(tgbotapi.NewMediaGroup(42, []interface{}{
+		tgbotapi.NewInputMediaDocument("first"),
+		tgbotapi.NewInputMediaDocumentUpload(tgbotapi.FileBytes{Name: "second.txt", Bytes: []byte("second")}),
+	}))
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+	require.Equal(t, int64(42), messages[1].Chat.ID)
+
+	requests := server.RequestsFor("sendMediaGroup")
+	require.Len(t, requests, 1)
+	require.Equal(t, tgbotapitest.File{Name: "second.txt", Data: []byte("second")}, requests[0].Files["file-1"])
+}
@@ -0,0 +1,194 @@
+// Package tgcallbackdata encodes small structs into the callback data
+// of inline keyboard buttons and decodes them back.
+//
+// The fields are encoded after a prefix telling the kinds of callbacks apart,
+// separated by colons, like "item:42:edit":
+//
+//	type Item struct {
+//		ID     int64
+//		Action string
+//	}
+//
+//	items := tgcallbackdata.New("item")
+//	button, err := items.Button("Edit", Item{ID: 42, Action: "edit"})
+//
+//	var item Item
+//	if items.Match(query.Data) {
+//		err = items.Unmarshal(query.Data, &item)
+//	}
+//
+// Exported fields of type string, bool, integer or float are encoded in their
+// order, except those tagged `callback:"-"`. Callback data can't be longer than
+// MaxLength bytes, which is checked when it is encoded.
+package tgcallbackdata
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+)
+
+// MaxLength is the maximum length of callback data in bytes.
+const MaxLength = 64
+
+const separator = ":"
+
+var (
+	// ErrTooLong happens when the encoded data is longer than MaxLength.
+	ErrTooLong = errors.New("tgcallbackdata: data is longer than 64 bytes")
+	// ErrPrefixMismatch happens when the data doesn't start with the prefix
+	// of the codec, so it encodes another kind of callback.
+	ErrPrefixMismatch = errors.New("tgcallbackdata: data has another prefix")
+	// ErrFieldCount happens when the data doesn't have as many fields
+	// as the struct.
+	ErrFieldCount = errors.New("tgcallbackdata: data doesn't match the struct fields")
+)
+
+var escaper = strings.NewReplacer("%", "%25", ":", "%3A")
+var unescaper = strings.NewReplacer("%3A", ":", "%25", "%")
+
+// Codec encodes structs into callback data with a prefix.
+type Codec struct {
+	// Prefix starts the callback data, to tell it from others.
+	// It must not contain colons.
+	Prefix string
+}
+
+// New creates a Codec with the prefix.
+func New(prefix string) Codec {
+	return Codec{Prefix: prefix}
+}
+
+// Match checks if data was encoded with the prefix of the codec.
+func (c Codec) Match(data string) bool {
+	return data == c.Prefix || strings.HasPrefix(data, c.Prefix+separator)
+}
+
+// Marshal encodes v, a struct or a pointer to one, into callback data.
+func (c Codec) Marshal(v interface{}) (string, error) {
+	value := reflect.Indirect(reflect.ValueOf(v))
+	if value.Kind() != reflect.Struct {
+		return "", fmt.Errorf("tgcallbackdata: can't marshal %T, a struct is required", v)
+	}
+
+	parts := []string{c.Prefix}
+	for _, i := range fields(value.Type()) {
+		field := value.Field(i)
+
+		var part string
+		switch field.Kind() {
+		case reflect.String:
+			part = escaper.Replace(field.String())
+		case reflect.Bool:
+			part = "0"
+			if field.Bool() {
+				part = "1"
+			}
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			part = strconv.FormatInt(field.Int(), 10)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			part = strconv.FormatUint(field.Uint(), 10)
+		case reflect.Float32, reflect.Float64:
+			part = strconv.FormatFloat(field.Float(), 'g', -1, field.Type().Bits())
+		default:
+			return "", unsupported(value.Type(), i)
+		}
+		parts = append(parts, part)
+	}
+
+	data := strings.Join(parts, separator)
+	if len(data) > MaxLength {
+		return "", ErrTooLong
+	}
+
+	return data, nil
+}
+
+// Unmarshal decodes callback data, usually CallbackQuery.Data,
+// into v, a pointer to a struct.
+func (c Codec) Unmarshal(data string, v interface{}) error {
+	ptr := reflect.ValueOf(v)
+	if ptr.Kind() != reflect.Ptr || ptr.IsNil() || ptr.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("tgcallbackdata: can't unmarshal into %T, a pointer to a struct is required", v)
+	}
+	if !c.Match(data) {
+		return ErrPrefixMismatch
+	}
+	value := ptr.Elem()
+
+	indexes := fields(value.Type())
+	var parts []string
+	if rest := strings.TrimPrefix(data, c.Prefix); rest != "" {
+		parts = strings.Split(rest[len(separator):], separator)
+	}
+	if len(parts) != len(indexes) {
+		return ErrFieldCount
+	}
+
+	for n, i := range indexes {
+		field := value.Field(i)
+		part := parts[n]
+
+		switch field.Kind() {
+		case reflect.String:
+			field.SetString(unescaper.Replace(part))
+		case reflect.Bool:
+			field.SetBool(part == "1")
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			parsed, err := strconv.ParseInt(part, 10, field.Type().Bits())
+			if err != nil {
+				return err
+			}
+			field.SetInt(parsed)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			parsed, err := strconv.ParseUint(part, 10, field.Type().Bits())
+			if err != nil {
+				return err
+			}
+			field.SetUint(parsed)
+		case reflect.Float32, reflect.Float64:
+			parsed, err := strconv.ParseFloat(part, field.Type().Bits())
+			if err != nil {
+				return err
+			}
+			field.SetFloat(parsed)
+		default:
+			return unsupported(value.Type(), i)
+		}
+	}
+
+	return nil
+}
+
+// Button creates an inline keyboard button with the text and v encoded
+// as its callback data.
+func (c Codec) Button(text string, v interface{}) (tgbotapi.InlineKeyboardButton, error) {
+	data, err := c.Marshal(v)
+	if err != nil {
+		return tgbotapi.InlineKeyboardButton{}, err
+	}
+
+	return tgbotapi.NewInlineKeyboardButtonData(text, data), nil
+}
+
+// fields returns the indexes of the encoded fields of t.
+func fields(t reflect.Type) []int {
+	var indexes []int
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" || field.Tag.Get("callback") == "-" {
+			continue
+		}
+		indexes = append(indexes, i)
+	}
+	return indexes
+}
+
+func unsupported(t reflect.Type, i int) error {
+	field := t.Field(i)
+	return fmt.Errorf("tgcallbackdata: field %s of %s has unsupported type %s", field.Name, t, field.Type)
+}
@@ -0,0 +1,73 @@
+package tgcallbackdata_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Feresey/telegram-bot-api/v5/tgcallbackdata"
+	"github.com/stretchr/testify/require"
+)
+
+type item struct {
+	ID      int64
+	Action  string
+	Confirm bool
+	Price   float64
+	Page    uint8
+	Cached  string `callback:"-"`
+	private int
+}
+
+func TestMarshalUnmarshal(t *testing.T) {
+	codec := tgcallbackdata.New("item")
+
+	data, err := codec.Marshal(&item{ID: -42, Action: "a:b%c", Confirm: true, Price: 1.5, Page: 3, Cached: "x", private: 1})
+	require.NoError(t, err)
+	require.Equal(t, "item:-42:a%3Ab%25c:1:1.5:3", data)
+	require.True(t, codec.Match(data))
+
+	var decoded item
+	require.NoError(t, codec.Unmarshal(data, &decoded))
+	require.Equal(t, item{ID: -42, Action: "a:b%c", Confirm: true, Price: 1.5, Page: 3}, decoded)
+
+	button, err := codec.Button("Edit", item{ID: 1})
+	require.NoError(t, err)
+	require.Equal(t, "item:1::0:0:0", *button.CallbackData)
+}
+
+func TestMarshalEmpty(t *testing.T) {
+	codec := tgcallbackdata.New("noop")
+
+	data, err := codec.Marshal(struct{}{})
+	require.NoError(t, err)
+	require.Equal(t, "noop", data)
+	require.NoError(t, codec.Unmarshal(data, &struct{}{}))
+}
+
+func TestMarshalErrors(t *testing.T) {
+	codec := tgcallbackdata.New("item")
+
+	_, err := codec.Marshal(item{Action: strings.Repeat("a", 64)})
+	require.ErrorIs(t, err, tgcallbackdata.ErrTooLong)
+
+	_, err = codec.Button("Edit", item{Action: strings.Repeat("a", 64)})
+	require.ErrorIs(t, err, tgcallbackdata.ErrTooLong)
+
+	_, err = codec.Marshal(42)
+	require.Error(t, err)
+
+	_, err = codec.Marshal(struct{ IDs []int }{})
+	require.Error(t, err)
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	codec := tgcallbackdata.New("item")
+	var decoded item
+
+	require.False(t, codec.Match("items:1"))
+	require.ErrorIs(t, codec.Unmarshal("items:1::0:0:0", &decoded), tgcallbackdata.ErrPrefixMismatch)
+	require.ErrorIs(t, codec.Unmarshal("item:1", &decoded), tgcallbackdata.ErrFieldCount)
+	require.Error(t, codec.Unmarshal("item:x::0:0:0", &decoded))
+	require.Error(t, codec.Unmarshal("item:1::0:0:256", &decoded))
+	require.Error(t, codec.Unmarshal("item:1::0:0:0", decoded))
+}
@@ -0,0 +1,54 @@
+// Package tglogrus adapts logrus loggers for use with tgbotapi.
+package tglogrus
+
+import (
+	"fmt"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/sirupsen/logrus"
+)
+
+// Logger is a tgbotapi.Logger writing to logrus.
+type Logger struct {
+	logger logrus.FieldLogger
+}
+
+// New creates a tgbotapi.Logger writing to a logrus logger or entry.
+func New(logger logrus.FieldLogger) *Logger {
+	return &Logger{logger: logger}
+}
+
+var _ tgbotapi.Logger = (*Logger)(nil)
+
+// Debug logs a message at debug level.
+func (l *Logger) Debug(msg string, keysAndValues ...interface{}) {
+	l.withFields(keysAndValues).Debug(msg)
+}
+
+// Info logs a message at info level.
+func (l *Logger) Info(msg string, keysAndValues ...interface{}) {
+	l.withFields(keysAndValues).Info(msg)
+}
+
+// Error logs a message at error level.
+func (l *Logger) Error(msg string, keysAndValues ...interface{}) {
+	l.withFields(keysAndValues).Error(msg)
+}
+
+func (l *Logger) withFields(keysAndValues []interface{}) logrus.FieldLogger {
+	if len(keysAndValues) == 0 {
+		return l.logger
+	}
+
+	fields := make(logrus.Fields, len(keysAndValues)/2+1)
+	for i := 0; i < len(keysAndValues); i += 2 {
+		key := fmt.Sprint(keysAndValues[i])
+		if i+1 < len(keysAndValues) {
+			fields[key] = keysAndValues[i+1]
+		} else {
+			fields["!BADKEY"] = keysAndValues[i]
+		}
+	}
+
+	return l.logger.WithFields(fields)
+}
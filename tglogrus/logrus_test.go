@@ -0,0 +1,22 @@
+package tglogrus_test
+
+import (
+	"testing"
+
+	"github.com/Feresey/telegram-bot-api/v5/tglogrus"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogger(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+
+	tglogrus.New(logger).Error("Failed to get updates", "error", "timeout")
+
+	entry := hook.LastEntry()
+	require.NotNil(t, entry)
+	require.Equal(t, logrus.ErrorLevel, entry.Level)
+	require.Equal(t, "Failed to get updates", entry.Message)
+	require.Equal(t, logrus.Fields{"error": "timeout"}, entry.Data)
+}
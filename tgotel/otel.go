@@ -0,0 +1,109 @@
+// Package tgotel instruments tgbotapi with OpenTelemetry tracing.
+//
+// Spans of API calls are children of the span in the context passed to
+// the context-aware BotAPI methods, such as SendWithContext:
+//
+//	tracing := tgotel.New(nil)
+//	bot.Use(tracing.Middleware())
+//
+//	for update := range updates {
+//		ctx, span := tracing.StartUpdate(context.Background(), update)
+//		_, err := bot.SendWithContext(ctx, reply)
+//		tgotel.End(span, err)
+//	}
+package tgotel
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentationName is the name of the tracer used for the spans.
+const InstrumentationName = "github.com/Feresey/telegram-bot-api/v5/tgotel"
+
+// Attribute keys set on the spans.
+const (
+	ChatIDKey     = attribute.Key("tg.chat_id")
+	UpdateIDKey   = attribute.Key("tg.update_id")
+	StatusCodeKey = attribute.Key("http.status_code")
+)
+
+// Tracing creates spans for API calls and processed updates.
+type Tracing struct {
+	tracer trace.Tracer
+}
+
+// New creates Tracing with spans from the provider.
+//
+// The global TracerProvider is used if provider is nil.
+func New(provider trace.TracerProvider) *Tracing {
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+
+	return &Tracing{tracer: provider.Tracer(InstrumentationName)}
+}
+
+// Middleware returns a tgbotapi.Middleware creating a span named
+// after the API method, such as "tg.sendMessage", for every request.
+func (t *Tracing) Middleware() tgbotapi.Middleware {
+	return func(next tgbotapi.Requester) tgbotapi.Requester {
+		return tgbotapi.RequesterFunc(func(req *http.Request) (*http.Response, error) {
+			ctx := req.Context()
+
+			ctx, span := t.tracer.Start(ctx, "tg."+tgbotapi.APIMethodFromContext(ctx),
+				trace.WithSpanKind(trace.SpanKindClient))
+			defer span.End()
+
+			if chatID := tgbotapi.ChatIDFromContext(ctx); chatID != "" {
+				span.SetAttributes(ChatIDKey.String(chatID))
+			}
+
+			resp, err := next.Do(req.WithContext(ctx))
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+				return resp, err
+			}
+
+			span.SetAttributes(StatusCodeKey.Int(resp.StatusCode))
+			// The API answers errors with a matching HTTP status code.
+			if resp.StatusCode >= http.StatusBadRequest {
+				span.SetStatus(codes.Error, strconv.Itoa(resp.StatusCode)+" "+http.StatusText(resp.StatusCode))
+			}
+
+			return resp, nil
+		})
+	}
+}
+
+// StartUpdate starts a span named "tg.update" for processing the update.
+// The returned context should be passed to the API calls made while
+// processing it, and the span must be ended by the caller.
+func (t *Tracing) StartUpdate(ctx context.Context, update tgbotapi.Update) (context.Context, trace.Span) {
+	ctx, span := t.tracer.Start(ctx, "tg.update",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(UpdateIDKey.Int(update.UpdateID)))
+
+	if chat := update.FromChat(); chat != nil {
+		span.SetAttributes(ChatIDKey.Int64(chat.ID))
+	}
+
+	return ctx, span
+}
+
+// End ends the span, recording err on it if it is not nil.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
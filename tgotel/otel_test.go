@@ -0,0 +1,71 @@
+package tgotel_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/Feresey/telegram-bot-api/v5/tgotel"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/getMe") {
+			_, _ = w.Write([]byte(`{"ok":true,"result":{"id":1,"first_name":"bot","is_bot":true}}`))
+			return
+		}
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"ok":false,"error_code":403,"description":"Forbidden: bot was blocked by the user"}`))
+	}))
+	defer server.Close()
+
+	bot, err := tgbotapi.NewBotAPIWithAPIEndpoint("TOKEN", server.URL+"/bot%s/%s")
+	require.NoError(t, err)
+
+	recorder := tracetest.NewSpanRecorder()
+	tracing := tgotel.New(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	bot.Use(tracing.Middleware())
+
+	update := tgbotapi.Update{
+		UpdateID: 5,
+		Message:  &tgbotapi.Message{Chat: &tgbotapi.Chat{ID: 42}},
+	}
+	ctx, span := tracing.StartUpdate(context.Background(), update)
+	_, err = bot.SendWithContext(ctx, tgbotapi.NewMessage(42, "test"))
+	require.Error(t, err)
+	tgotel.End(span, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+
+	call, processing := spans[0], spans[1]
+	require.Equal(t, "tg.sendMessage", call.Name())
+	require.Equal(t, codes.Error, call.Status().Code)
+	require.Contains(t, call.Attributes(), tgotel.ChatIDKey.String("42"))
+	require.Contains(t, call.Attributes(), tgotel.StatusCodeKey.Int(http.StatusForbidden))
+	require.Equal(t, processing.SpanContext().SpanID(), call.Parent().SpanID())
+
+	require.Equal(t, "tg.update", processing.Name())
+	require.Equal(t, codes.Error, processing.Status().Code)
+	require.Contains(t, processing.Attributes(), tgotel.UpdateIDKey.Int(5))
+	require.Contains(t, processing.Attributes(), tgotel.ChatIDKey.Int64(42))
+}
+
+func TestEnd(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracing := tgotel.New(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	_, span := tracing.StartUpdate(context.Background(), tgbotapi.Update{})
+	tgotel.End(span, errors.New("boom"))
+
+	require.Len(t, recorder.Ended(), 1)
+	require.Equal(t, "boom", recorder.Ended()[0].Status().Description)
+}
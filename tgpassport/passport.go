@@ -0,0 +1,167 @@
+// Package tgpassport decrypts the Telegram Passport data shared with a bot.
+//
+// The credentials are decrypted with the private key of the bot, whose public
+// key is set with @BotFather, and give the secrets of the elements and files:
+//
+//	key, err := tgpassport.ParsePrivateKey(pemBytes)
+//	credentials, err := tgpassport.DecryptCredentials(key, message.PassportData.Credentials)
+//
+//	var details tgbotapi.PersonalDetails
+//	err = tgpassport.DecryptElement(credentials.Data["personal_details"].Data, element.Data, &details)
+//
+// Files of the elements are downloaded like any other file, and decrypted
+// with DecryptFile.
+package tgpassport
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+)
+
+var (
+	// ErrBadPrivateKey happens when the private key is not an RSA key
+	// in PEM format.
+	ErrBadPrivateKey = errors.New("tgpassport: private key must be an RSA key in PEM format")
+	// ErrBadData happens when encrypted data is malformed.
+	ErrBadData = errors.New("tgpassport: malformed encrypted data")
+	// ErrHashMismatch happens when decrypted data doesn't match its hash,
+	// so it was tampered with or decrypted with the wrong secret.
+	ErrHashMismatch = errors.New("tgpassport: decrypted data doesn't match its hash")
+)
+
+// ParsePrivateKey parses a PEM-encoded RSA private key,
+// in PKCS #1 or PKCS #8 form.
+func ParsePrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, ErrBadPrivateKey
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, ErrBadPrivateKey
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, ErrBadPrivateKey
+	}
+	return rsaKey, nil
+}
+
+// DecryptCredentials decrypts the credentials of passport data with the
+// private key of the bot. The Nonce of the credentials must be checked
+// against the one of the request.
+func DecryptCredentials(key *rsa.PrivateKey, credentials *tgbotapi.EncryptedCredentials) (*tgbotapi.Credentials, error) {
+	encryptedSecret, err := base64.StdEncoding.DecodeString(credentials.Secret)
+	if err != nil {
+		return nil, ErrBadData
+	}
+
+	secret, err := rsa.DecryptOAEP(sha1.New(), nil, key, encryptedSecret, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := base64.StdEncoding.DecodeString(credentials.Hash)
+	if err != nil {
+		return nil, ErrBadData
+	}
+
+	data, err := base64.StdEncoding.DecodeString(credentials.Data)
+	if err != nil {
+		return nil, ErrBadData
+	}
+
+	plain, err := decrypt(secret, hash, data)
+	if err != nil {
+		return nil, err
+	}
+
+	var decrypted tgbotapi.Credentials
+	if err := json.Unmarshal(plain, &decrypted); err != nil {
+		return nil, err
+	}
+	return &decrypted, nil
+}
+
+// DecryptElement decrypts the base64-encoded data of an
+// EncryptedPassportElement into v, such as PersonalDetails or IDDocumentData.
+func DecryptElement(credentials *tgbotapi.DataCredentials, data string, v interface{}) error {
+	encrypted, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return ErrBadData
+	}
+
+	plain, err := decryptWith(credentials.Secret, credentials.DataHash, encrypted)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(plain, v)
+}
+
+// DecryptFile decrypts the downloaded contents of a PassportFile.
+func DecryptFile(credentials *tgbotapi.FileCredentials, data []byte) ([]byte, error) {
+	return decryptWith(credentials.Secret, credentials.FileHash, data)
+}
+
+// decryptWith decrypts data with a base64-encoded secret and hash.
+func decryptWith(secret, hash string, data []byte) ([]byte, error) {
+	rawSecret, err := base64.StdEncoding.DecodeString(secret)
+	if err != nil {
+		return nil, ErrBadData
+	}
+
+	rawHash, err := base64.StdEncoding.DecodeString(hash)
+	if err != nil {
+		return nil, ErrBadData
+	}
+
+	return decrypt(rawSecret, rawHash, data)
+}
+
+// decrypt decrypts data with AES-256-CBC, using the key and IV derived
+// from the secret and the hash, checks the hash of the decrypted data,
+// and strips its random padding.
+func decrypt(secret, hash, data []byte) ([]byte, error) {
+	if len(data) == 0 || len(data)%aes.BlockSize != 0 {
+		return nil, ErrBadData
+	}
+
+	secretHash := sha512.Sum512(append(append([]byte{}, secret...), hash...))
+	block, err := aes.NewCipher(secretHash[:32])
+	if err != nil {
+		return nil, err
+	}
+
+	plain := make([]byte, len(data))
+	cipher.NewCBCDecrypter(block, secretHash[32:48]).CryptBlocks(plain, data)
+
+	sum := sha256.Sum256(plain)
+	if !bytes.Equal(sum[:], hash) {
+		return nil, ErrHashMismatch
+	}
+
+	padding := int(plain[0])
+	if padding < 32 || padding > len(plain) {
+		return nil, ErrBadData
+	}
+	return plain[padding:], nil
+}
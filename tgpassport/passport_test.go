@@ -0,0 +1,92 @@
+package tgpassport_test
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/Feresey/telegram-bot-api/v5/tgpassport"
+	"github.com/stretchr/testify/require"
+)
+
+// encrypt encrypts data the way Telegram does, and returns
+// the encrypted data with its secret and hash.
+func encrypt(t *testing.T, data []byte) (encrypted, secret, hash []byte) {
+	padding := 32 + (aes.BlockSize-len(data)%aes.BlockSize)%aes.BlockSize
+	padded := make([]byte, padding+len(data))
+	_, err := rand.Read(padded[:padding])
+	require.NoError(t, err)
+	padded[0] = byte(padding)
+	copy(padded[padding:], data)
+
+	sum := sha256.Sum256(padded)
+	hash = sum[:]
+
+	secret = make([]byte, 32)
+	_, err = rand.Read(secret)
+	require.NoError(t, err)
+
+	secretHash := sha512.Sum512(append(append([]byte{}, secret...), hash...))
+	block, err := aes.NewCipher(secretHash[:32])
+	require.NoError(t, err)
+
+	encrypted = make([]byte, len(padded))
+	cipher.NewCBCEncrypter(block, secretHash[32:48]).CryptBlocks(encrypted, padded)
+	return encrypted, secret, hash
+}
+
+func TestDecrypt(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	pemKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	parsed, err := tgpassport.ParsePrivateKey(pemKey)
+	require.NoError(t, err)
+
+	details, detailsSecret, detailsHash := encrypt(t, []byte(`{"first_name":"Ada","last_name":"Lovelace"}`))
+	scan, scanSecret, scanHash := encrypt(t, []byte("scan"))
+
+	b64 := base64.StdEncoding.EncodeToString
+	credentials, secret, hash := encrypt(t, []byte(`{"secure_data":{`+
+		`"personal_details":{"data":{"data_hash":"`+b64(detailsHash)+`","secret":"`+b64(detailsSecret)+`"}},`+
+		`"utility_bill":{"files":[{"file_hash":"`+b64(scanHash)+`","secret":"`+b64(scanSecret)+`"}]}`+
+		`},"nonce":"nonce"}`))
+	encryptedSecret, err := rsa.EncryptOAEP(sha1.New(), rand.Reader, &key.PublicKey, secret, nil)
+	require.NoError(t, err)
+
+	decrypted, err := tgpassport.DecryptCredentials(parsed, &tgbotapi.EncryptedCredentials{
+		Data:   b64(credentials),
+		Hash:   b64(hash),
+		Secret: b64(encryptedSecret),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "nonce", decrypted.Nonce)
+
+	var personal tgbotapi.PersonalDetails
+	err = tgpassport.DecryptElement(decrypted.Data["personal_details"].Data, b64(details), &personal)
+	require.NoError(t, err)
+	require.Equal(t, "Ada", personal.FirstName)
+	require.Equal(t, "Lovelace", personal.LastName)
+
+	file, err := tgpassport.DecryptFile(decrypted.Data["utility_bill"].Files[0], scan)
+	require.NoError(t, err)
+	require.Equal(t, []byte("scan"), file)
+
+	scan[len(scan)-1] ^= 1
+	_, err = tgpassport.DecryptFile(decrypted.Data["utility_bill"].Files[0], scan)
+	require.ErrorIs(t, err, tgpassport.ErrHashMismatch)
+}
+
+func TestParsePrivateKeyInvalid(t *testing.T) {
+	_, err := tgpassport.ParsePrivateKey([]byte("not a key"))
+	require.ErrorIs(t, err, tgpassport.ErrBadPrivateKey)
+}
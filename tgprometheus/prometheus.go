@@ -0,0 +1,82 @@
+// Package tgprometheus exports tgbotapi request metrics to Prometheus.
+package tgprometheus
+
+import (
+	"strconv"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Collector is a tgbotapi.RequestObserver that records metrics about
+// API calls and received updates. It must be registered with
+// a prometheus.Registerer to be exported.
+//
+// It exposes the following metrics:
+//
+//	<namespace>_requests_total{method, code, error}
+//	<namespace>_request_duration_seconds{method}
+//	<namespace>_updates_received_total
+type Collector struct {
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	updates  prometheus.Counter
+}
+
+// NewCollector creates a Collector with metrics under the namespace,
+// such as "telegram_bot".
+func NewCollector(namespace string) *Collector {
+	return &Collector{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "requests_total",
+			Help:      "Number of Telegram Bot API calls.",
+		}, []string{"method", "code", "error"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "request_duration_seconds",
+			Help:      "Latency of Telegram Bot API calls.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method"}),
+		updates: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "updates_received_total",
+			Help:      "Number of received updates.",
+		}),
+	}
+}
+
+var (
+	_ tgbotapi.RequestObserver = (*Collector)(nil)
+	_ prometheus.Collector     = (*Collector)(nil)
+)
+
+// ObserveRequest records a finished API call.
+func (c *Collector) ObserveRequest(info tgbotapi.RequestInfo) {
+	code := "none"
+	if info.StatusCode != 0 {
+		code = strconv.Itoa(info.StatusCode)
+	}
+
+	c.requests.WithLabelValues(info.Method, code, info.ErrorType()).Inc()
+	c.duration.WithLabelValues(info.Method).Observe(info.Duration.Seconds())
+}
+
+// ObserveUpdate records a received update.
+func (c *Collector) ObserveUpdate(tgbotapi.Update) {
+	c.updates.Inc()
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.requests.Describe(ch)
+	c.duration.Describe(ch)
+	c.updates.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.requests.Collect(ch)
+	c.duration.Collect(ch)
+	c.updates.Collect(ch)
+}
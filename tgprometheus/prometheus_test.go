@@ -0,0 +1,53 @@
+package tgprometheus_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/Feresey/telegram-bot-api/v5/tgprometheus"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollector(t *testing.T) {
+	collector := tgprometheus.NewCollector("bot")
+
+	registry := prometheus.NewPedanticRegistry()
+	require.NoError(t, registry.Register(collector))
+
+	collector.ObserveRequest(tgbotapi.RequestInfo{
+		Method:     "sendMessage",
+		StatusCode: 200,
+		Duration:   time.Millisecond,
+	})
+	collector.ObserveRequest(tgbotapi.RequestInfo{
+		Method:     "sendMessage",
+		StatusCode: 429,
+		Duration:   time.Millisecond,
+		Err:        tgbotapi.Error{Code: 429, Message: "Too Many Requests"},
+	})
+	collector.ObserveRequest(tgbotapi.RequestInfo{
+		Method: "getMe",
+		Err:    errors.New("boom"),
+	})
+	collector.ObserveUpdate(tgbotapi.Update{UpdateID: 1})
+
+	expected := `
+# HELP bot_requests_total Number of Telegram Bot API calls.
+# TYPE bot_requests_total counter
+bot_requests_total{code="200",error="",method="sendMessage"} 1
+bot_requests_total{code="429",error="api_429",method="sendMessage"} 1
+bot_requests_total{code="none",error="other",method="getMe"} 1
+# HELP bot_updates_received_total Number of received updates.
+# TYPE bot_updates_received_total counter
+bot_updates_received_total 1
+`
+	require.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(expected),
+		"bot_requests_total", "bot_updates_received_total"))
+
+	require.Equal(t, 2, testutil.CollectAndCount(collector, "bot_request_duration_seconds"))
+}
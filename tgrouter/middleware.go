@@ -0,0 +1,184 @@
+package tgrouter
+
+import (
+	"errors"
+	"fmt"
+	stdlog "log"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+)
+
+// ErrAdminCheck happens when AdminOnly can't find out if the sender
+// is an administrator of the chat. The error returned by AdminOnly
+// also wraps the error of getChatMember.
+var ErrAdminCheck = errors.New("tgrouter: can't check if the sender is an administrator")
+
+// adminCheckError is an ErrAdminCheck wrapping its cause.
+type adminCheckError struct {
+	err error
+}
+
+func (e *adminCheckError) Error() string {
+	return fmt.Sprintf("%v: %v", ErrAdminCheck, e.err)
+}
+
+func (e *adminCheckError) Is(target error) bool {
+	return target == ErrAdminCheck
+}
+
+func (e *adminCheckError) Unwrap() error {
+	return e.err
+}
+
+// PanicError is returned by the handlers wrapped with Recover
+// when they panic.
+type PanicError struct {
+	// Value is the value the handler panicked with.
+	Value interface{}
+	// Stack is the stack trace of the panic.
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("tgrouter: handler panicked: %v", e.Value)
+}
+
+// Chain wraps handler with middlewares, the first of them running first.
+func Chain(handler Handler, middlewares ...Middleware) Handler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+	return handler
+}
+
+// Recover turns the panics of the handler into a *PanicError,
+// so they are handled like any other error.
+func Recover() Middleware {
+	return func(next Handler) Handler {
+		return func(c *Context) (err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					err = &PanicError{Value: r, Stack: debug.Stack()}
+				}
+			}()
+
+			return next(c)
+		}
+	}
+}
+
+// RateLimit lets every user through at most limit times per interval,
+// and drops the rest of their updates. Updates without a sender are
+// always let through.
+func RateLimit(limit int, interval time.Duration) Middleware {
+	type window struct {
+		start time.Time
+		count int
+	}
+
+	var mu sync.Mutex
+	windows := make(map[int]*window)
+
+	allow := func(userID int) bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		now := time.Now()
+		if len(windows) >= 1024 {
+			// Forget the users whose windows are over, so the map
+			// doesn't grow with every user the bot has ever seen.
+			for id, w := range windows {
+				if now.Sub(w.start) >= interval {
+					delete(windows, id)
+				}
+			}
+		}
+
+		w, ok := windows[userID]
+		if !ok || now.Sub(w.start) >= interval {
+			windows[userID] = &window{start: now, count: 1}
+			return true
+		}
+		if w.count >= limit {
+			return false
+		}
+		w.count++
+		return true
+	}
+
+	return func(next Handler) Handler {
+		return func(c *Context) error {
+			if sender := c.Sender(); sender != nil && !allow(sender.ID) {
+				return nil
+			}
+			return next(c)
+		}
+	}
+}
+
+// AdminOnly lets through only the updates from the creator and
+// the administrators of their chat, asking for the chat member with
+// getChatMember. The other updates, including those without a chat
+// or a sender, are dropped.
+func AdminOnly() Middleware {
+	return func(next Handler) Handler {
+		return func(c *Context) error {
+			chat := c.Update.FromChat()
+			sender := c.Sender()
+			if chat == nil || sender == nil {
+				return nil
+			}
+
+			member, err := c.Bot.GetChatMember(tgbotapi.ChatConfigWithUser{
+				ChatID: chat.ID,
+				UserID: sender.ID,
+			})
+			if err != nil {
+				return &adminCheckError{err: err}
+			}
+			if !member.IsCreator() && !member.IsAdministrator() {
+				return nil
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// Logging logs every update with the time it took to handle it,
+// and the error if the handler failed. If logger is nil,
+// the standard logger is used.
+func Logging(logger tgbotapi.Logger) Middleware {
+	if logger == nil {
+		logger = tgbotapi.NewStdLogger(stdlog.Default())
+	}
+
+	return func(next Handler) Handler {
+		return func(c *Context) error {
+			start := time.Now()
+			err := next(c)
+
+			keysAndValues := []interface{}{
+				"update_id", c.Update.UpdateID,
+				"duration", time.Since(start),
+			}
+			if sender := c.Sender(); sender != nil {
+				keysAndValues = append(keysAndValues, "user_id", sender.ID)
+			}
+			if c.Command != "" {
+				keysAndValues = append(keysAndValues, "command", c.Command)
+			}
+
+			if err != nil {
+				logger.Error("Update handling failed", append(keysAndValues, "error", err)...)
+			} else {
+				logger.Info("Update handled", keysAndValues...)
+			}
+
+			return err
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package tgrouter_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/Feresey/telegram-bot-api/v5/tgrouter"
+	"github.com/stretchr/testify/require"
+)
+
+func fromUser(update tgbotapi.Update, id int) tgbotapi.Update {
+	update.Message.From = &tgbotapi.User{ID: id}
+	return update
+}
+
+func TestRecover(t *testing.T) {
+	handler := tgrouter.Chain(func(c *tgrouter.Context) error {
+		panic("boom")
+	}, tgrouter.Recover())
+
+	err := handler(&tgrouter.Context{Context: context.Background()})
+	var panicErr *tgrouter.PanicError
+	require.ErrorAs(t, err, &panicErr)
+	require.Equal(t, "boom", panicErr.Value)
+	require.NotEmpty(t, panicErr.Stack)
+}
+
+func TestRateLimit(t *testing.T) {
+	router := tgrouter.New(newBot())
+	router.Use(tgrouter.RateLimit(2, time.Hour))
+
+	handled := make(map[int]int)
+	router.Default(func(c *tgrouter.Context) error {
+		handled[c.Sender().ID]++
+		return nil
+	})
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		router.HandleUpdate(ctx, fromUser(commandUpdate("/start"), 1))
+	}
+	router.HandleUpdate(ctx, fromUser(commandUpdate("/start"), 2))
+
+	require.Equal(t, map[int]int{1: 2, 2: 1}, handled)
+}
+
+func TestAdminOnly(t *testing.T) {
+	bot, server := newServerBot(t)
+
+	router := tgrouter.New(bot)
+	var handled int
+	router.Command("ban", tgrouter.Chain(func(c *tgrouter.Context) error {
+		handled++
+		return nil
+	}, tgrouter.AdminOnly()))
+	var errs []error
+	router.OnError = func(c *tgrouter.Context, err error) {
+		errs = append(errs, err)
+	}
+
+	ctx := context.Background()
+	require.NoError(t, server.Respond("getChatMember", tgbotapi.ChatMember{Status: "administrator"}))
+	router.HandleUpdate(ctx, fromUser(commandUpdate("/ban"), 5))
+	require.NoError(t, server.Respond("getChatMember", tgbotapi.ChatMember{Status: "member"}))
+	router.HandleUpdate(ctx, fromUser(commandUpdate("/ban"), 6))
+	server.RespondError("getChatMember", 400, "Bad Request: chat not found")
+	router.HandleUpdate(ctx, fromUser(commandUpdate("/ban"), 7))
+
+	require.Equal(t, 1, handled)
+	require.Len(t, errs, 1)
+	require.ErrorIs(t, errs[0], tgrouter.ErrAdminCheck)
+	apiErr, ok := tgbotapi.AsError(errs[0])
+	require.True(t, ok)
+	require.Equal(t, 400, apiErr.Code)
+
+	requests := server.RequestsFor("getChatMember")
+	require.Len(t, requests, 3)
+	require.Equal(t, "10", requests[0].Params["chat_id"])
+	require.Equal(t, "5", requests[0].Params["user_id"])
+}
+
+type testLogger struct {
+	mu      sync.Mutex
+	entries []string
+}
+
+func (l *testLogger) Debug(msg string, keysAndValues ...interface{}) { l.log(msg, keysAndValues) }
+func (l *testLogger) Info(msg string, keysAndValues ...interface{})  { l.log(msg, keysAndValues) }
+func (l *testLogger) Error(msg string, keysAndValues ...interface{}) { l.log(msg, keysAndValues) }
+
+func (l *testLogger) log(msg string, keysAndValues []interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, fmt.Sprint(msg, keysAndValues[len(keysAndValues)-2:]))
+}
+
+func TestLogging(t *testing.T) {
+	logger := &testLogger{}
+	router := tgrouter.New(newBot())
+	router.Use(tgrouter.Logging(logger))
+	router.OnError = func(c *tgrouter.Context, err error) {}
+
+	router.Command("ok", func(c *tgrouter.Context) error { return nil })
+	router.Command("fail", func(c *tgrouter.Context) error { return errors.New("failed") })
+
+	router.HandleUpdate(context.Background(), fromUser(commandUpdate("/ok"), 1))
+	router.HandleUpdate(context.Background(), fromUser(commandUpdate("/fail"), 1))
+
+	require.Equal(t, []string{
+		"Update handled[command ok]",
+		"Update handling failed[error failed]",
+	}, logger.entries)
+}
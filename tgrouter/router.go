@@ -0,0 +1,259 @@
+// Package tgrouter routes updates to handlers by their kind: commands,
+// callback queries with a data prefix, inline queries, or any other
+// condition.
+//
+//	router := tgrouter.New(bot)
+//	router.Command("start", func(c *tgrouter.Context) error {
+//		_, err := c.Bot.Send(tgbotapi.NewMessage(c.Update.Message.Chat.ID, "Hello!"))
+//		return err
+//	})
+//	router.CallbackPrefix("menu:", showMenu)
+//	router.InlineQuery(search)
+//	router.Default(help)
+//	router.Use(tgrouter.Recover(), tgrouter.Logging(nil))
+//
+//	updates, err := bot.GetUpdatesChan(tgbotapi.NewUpdate(0))
+//	if err != nil {
+//		return err
+//	}
+//	err = router.Run(ctx, updates)
+//
+// Middleware added with Use wraps the handling of every update, and can
+// wrap single handlers with Chain:
+//
+//	router.Command("ban", tgrouter.Chain(ban, tgrouter.AdminOnly()))
+//
+// Routes are tried in the order they were added, and the first matching one
+// handles the update. Routes and middleware must be added before the router
+// starts handling updates.
+package tgrouter
+
+import (
+	"context"
+	stdlog "log"
+	"strings"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+)
+
+// Handler handles an update.
+type Handler func(c *Context) error
+
+// Middleware wraps a Handler to run code before or after it,
+// or to not call it at all.
+type Middleware func(next Handler) Handler
+
+// Context is the update being handled, with what the router
+// parsed from it.
+type Context struct {
+	context.Context
+
+	// Bot is the bot of the router.
+	Bot *tgbotapi.BotAPI
+	// Update is the update being handled.
+	Update tgbotapi.Update
+	// Command is the command of the message, without the slash
+	// and the name of the bot.
+	//
+	// empty if the message isn't a command, or is a command to another bot
+	Command string
+	// Args are the arguments of the command, split by whitespace.
+	Args []string
+	// Data is the data of the callback query after the prefix
+	// of the route.
+	Data string
+}
+
+// Sender returns the user who caused the update, or nil if the update
+// has no user, like channel posts.
+func (c *Context) Sender() *tgbotapi.User {
+	update := c.Update
+	switch {
+	case update.Message != nil:
+		return update.Message.From
+	case update.EditedMessage != nil:
+		return update.EditedMessage.From
+	case update.BusinessMessage != nil:
+		return update.BusinessMessage.From
+	case update.EditedBusinessMessage != nil:
+		return update.EditedBusinessMessage.From
+	case update.CallbackQuery != nil:
+		return update.CallbackQuery.From
+	case update.InlineQuery != nil:
+		return update.InlineQuery.From
+	case update.ChosenInlineResult != nil:
+		return update.ChosenInlineResult.From
+	case update.ShippingQuery != nil:
+		return update.ShippingQuery.From
+	case update.PreCheckoutQuery != nil:
+		return update.PreCheckoutQuery.From
+	case update.MyChatMember != nil:
+		return &update.MyChatMember.From
+	case update.ChatMember != nil:
+		return &update.ChatMember.From
+	case update.ChatJoinRequest != nil:
+		return &update.ChatJoinRequest.From
+	default:
+		return nil
+	}
+}
+
+type route struct {
+	match   func(c *Context) bool
+	handler Handler
+}
+
+// Router routes updates to handlers.
+type Router struct {
+	// Bot is passed to the handlers, and its name tells the commands
+	// to the bot from those to other bots.
+	Bot *tgbotapi.BotAPI
+	// Workers is the number of updates handled at the same time by Run.
+	//
+	// optional, tgbotapi.DefaultDispatcherWorkers is used if zero
+	Workers int
+	// OnError is called with the errors returned by the handlers.
+	//
+	// optional, the errors are logged with Logger if nil
+	OnError func(c *Context, err error)
+	// Logger logs the errors of the handlers when OnError is nil.
+	//
+	// optional, the standard logger is used if nil
+	Logger tgbotapi.Logger
+
+	routes      []route
+	fallback    Handler
+	middlewares []Middleware
+}
+
+// New creates a Router for the bot.
+func New(bot *tgbotapi.BotAPI) *Router {
+	return &Router{Bot: bot}
+}
+
+// Use adds middleware wrapping the handling of every update,
+// including the updates no route matches. Middleware added first
+// runs first.
+func (r *Router) Use(middlewares ...Middleware) {
+	r.middlewares = append(r.middlewares, middlewares...)
+}
+
+// Handle routes the updates matching match to handler.
+func (r *Router) Handle(match func(c *Context) bool, handler Handler) {
+	r.routes = append(r.routes, route{match: match, handler: handler})
+}
+
+// Command routes messages with the command, with or without
+// the leading slash, to handler.
+func (r *Router) Command(command string, handler Handler) {
+	command = strings.TrimPrefix(command, "/")
+	r.Handle(func(c *Context) bool {
+		return c.Command == command
+	}, handler)
+}
+
+// CallbackPrefix routes callback queries with data starting with prefix
+// to handler. The rest of the data is passed in Context.Data.
+func (r *Router) CallbackPrefix(prefix string, handler Handler) {
+	r.Handle(func(c *Context) bool {
+		query := c.Update.CallbackQuery
+		if query == nil || !strings.HasPrefix(query.Data, prefix) {
+			return false
+		}
+		c.Data = query.Data[len(prefix):]
+		return true
+	}, handler)
+}
+
+// InlineQuery routes inline queries to handler.
+func (r *Router) InlineQuery(handler Handler) {
+	r.Handle(func(c *Context) bool {
+		return c.Update.InlineQuery != nil
+	}, handler)
+}
+
+// Default sets the handler of the updates no route matches.
+func (r *Router) Default(handler Handler) {
+	r.fallback = handler
+}
+
+// HandleUpdate routes the update. It can be used as the handler of
+// a tgbotapi.Dispatcher, or with updates from a webhook.
+func (r *Router) HandleUpdate(ctx context.Context, update tgbotapi.Update) {
+	c := r.newContext(ctx, update)
+
+	if err := Chain(r.route, r.middlewares...)(c); err != nil {
+		r.handleError(c, err)
+	}
+}
+
+// Run routes the updates with a tgbotapi.Dispatcher of Workers workers,
+// until the channel is closed or ctx is done.
+func (r *Router) Run(ctx context.Context, updates tgbotapi.UpdatesChannel) error {
+	dispatcher := tgbotapi.NewDispatcher(r.Workers, func(update tgbotapi.Update) {
+		r.HandleUpdate(ctx, update)
+	})
+
+	return dispatcher.Run(ctx, updates)
+}
+
+// route calls the handler of the first matching route.
+func (r *Router) route(c *Context) error {
+	for _, route := range r.routes {
+		if route.match(c) {
+			return route.handler(c)
+		}
+	}
+
+	if r.fallback != nil {
+		return r.fallback(c)
+	}
+
+	return nil
+}
+
+func (r *Router) newContext(ctx context.Context, update tgbotapi.Update) *Context {
+	c := &Context{
+		Context: ctx,
+		Bot:     r.Bot,
+		Update:  update,
+	}
+
+	message := update.Message
+	if message == nil || !message.IsCommand() {
+		return c
+	}
+
+	command := message.CommandWithAt()
+	if i := strings.Index(command, "@"); i != -1 {
+		if name := r.botName(); name != "" && !strings.EqualFold(command[i+1:], name) {
+			return c
+		}
+		command = command[:i]
+	}
+
+	c.Command = command
+	c.Args = strings.Fields(message.CommandArguments())
+
+	return c
+}
+
+func (r *Router) botName() string {
+	if r.Bot == nil || r.Bot.Self == nil {
+		return ""
+	}
+	return r.Bot.Self.UserName
+}
+
+func (r *Router) handleError(c *Context, err error) {
+	if r.OnError != nil {
+		r.OnError(c, err)
+		return
+	}
+
+	logger := r.Logger
+	if logger == nil {
+		logger = tgbotapi.NewStdLogger(stdlog.Default())
+	}
+	logger.Error("Update handler failed", "update_id", c.Update.UpdateID, "error", err)
+}
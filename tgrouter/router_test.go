@@ -0,0 +1,155 @@
+package tgrouter_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/Feresey/telegram-bot-api/v5/tgbotapitest"
+	"github.com/Feresey/telegram-bot-api/v5/tgrouter"
+	"github.com/stretchr/testify/require"
+)
+
+func newBot() *tgbotapi.BotAPI {
+	return &tgbotapi.BotAPI{Self: &tgbotapi.User{ID: 1, UserName: "test_bot", IsBot: true}}
+}
+
+// newServerBot creates a bot talking to a tgbotapitest.Server,
+// which is closed when the test ends.
+func newServerBot(t *testing.T) (*tgbotapi.BotAPI, *tgbotapitest.Server) {
+	server := tgbotapitest.NewServer()
+	t.Cleanup(server.Close)
+
+	bot, err := server.NewBot()
+	require.NoError(t, err)
+	return bot, server
+}
+
+func commandUpdate(text string) tgbotapi.Update {
+	length := len(text)
+	if i := strings.Index(text, " "); i != -1 {
+		length = i
+	}
+
+	return tgbotapi.Update{
+		UpdateID: 1,
+		Message: &tgbotapi.Message{
+			Chat:     &tgbotapi.Chat{ID: 10},
+			Text:     text,
+			Entities: &[]tgbotapi.MessageEntity{{Type: "bot_command", Offset: 0, Length: length}},
+		},
+	}
+}
+
+func TestRouterCommand(t *testing.T) {
+	router := tgrouter.New(newBot())
+
+	var got *tgrouter.Context
+	router.Command("/start", func(c *tgrouter.Context) error {
+		got = c
+		return nil
+	})
+	var fallback int
+	router.Default(func(c *tgrouter.Context) error {
+		fallback++
+		return nil
+	})
+
+	router.HandleUpdate(context.Background(), commandUpdate("/start@Test_Bot ref  42"))
+	require.NotNil(t, got)
+	require.Equal(t, "start", got.Command)
+	require.Equal(t, []string{"ref", "42"}, got.Args)
+
+	got = nil
+	router.HandleUpdate(context.Background(), commandUpdate("/start"))
+	require.NotNil(t, got)
+	require.Empty(t, got.Args)
+
+	got = nil
+	router.HandleUpdate(context.Background(), commandUpdate("/start@other_bot"))
+	router.HandleUpdate(context.Background(), commandUpdate("/help"))
+	router.HandleUpdate(context.Background(), tgbotapi.Update{Message: &tgbotapi.Message{Text: "start"}})
+	require.Nil(t, got)
+	require.Equal(t, 3, fallback)
+}
+
+func TestRouterCallbackAndInline(t *testing.T) {
+	router := tgrouter.New(newBot())
+
+	var routes []string
+	router.CallbackPrefix("menu:", func(c *tgrouter.Context) error {
+		routes = append(routes, "menu "+c.Data)
+		return nil
+	})
+	router.CallbackPrefix("", func(c *tgrouter.Context) error {
+		routes = append(routes, "other "+c.Data)
+		return nil
+	})
+	router.InlineQuery(func(c *tgrouter.Context) error {
+		routes = append(routes, "inline "+c.Update.InlineQuery.Query)
+		return nil
+	})
+
+	ctx := context.Background()
+	router.HandleUpdate(ctx, tgbotapi.Update{CallbackQuery: &tgbotapi.CallbackQuery{Data: "menu:settings"}})
+	router.HandleUpdate(ctx, tgbotapi.Update{CallbackQuery: &tgbotapi.CallbackQuery{Data: "page:2"}})
+	router.HandleUpdate(ctx, tgbotapi.Update{InlineQuery: &tgbotapi.InlineQuery{Query: "cats"}})
+	router.HandleUpdate(ctx, tgbotapi.Update{})
+
+	require.Equal(t, []string{"menu settings", "other page:2", "inline cats"}, routes)
+}
+
+func TestRouterMiddlewareAndErrors(t *testing.T) {
+	router := tgrouter.New(newBot())
+
+	var calls []string
+	trace := func(name string) tgrouter.Middleware {
+		return func(next tgrouter.Handler) tgrouter.Handler {
+			return func(c *tgrouter.Context) error {
+				calls = append(calls, name)
+				return next(c)
+			}
+		}
+	}
+	router.Use(trace("first"), trace("second"))
+
+	errFailed := errors.New("failed")
+	router.Command("fail", func(c *tgrouter.Context) error {
+		calls = append(calls, "handler")
+		return errFailed
+	})
+
+	var handled error
+	router.OnError = func(c *tgrouter.Context, err error) {
+		handled = err
+	}
+
+	router.HandleUpdate(context.Background(), commandUpdate("/fail"))
+	require.Equal(t, []string{"first", "second", "handler"}, calls)
+	require.ErrorIs(t, handled, errFailed)
+
+	calls = nil
+	router.HandleUpdate(context.Background(), tgbotapi.Update{})
+	require.Equal(t, []string{"first", "second"}, calls)
+}
+
+func TestRouterRun(t *testing.T) {
+	router := tgrouter.New(newBot())
+	router.Workers = 2
+
+	handled := make(chan string, 2)
+	router.Command("start", func(c *tgrouter.Context) error {
+		handled <- c.Command
+		return nil
+	})
+
+	updates := make(chan tgbotapi.Update, 2)
+	updates <- commandUpdate("/start")
+	updates <- commandUpdate("/start")
+	close(updates)
+
+	require.NoError(t, router.Run(context.Background(), updates))
+	require.Len(t, handled, 2)
+}
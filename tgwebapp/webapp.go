@@ -0,0 +1,236 @@
+// Package tgwebapp validates the init data Telegram passes to Web Apps.
+//
+// A Web App sends window.Telegram.WebApp.initData to its backend, which
+// must validate it before trusting the user in it:
+//
+//	data, err := tgwebapp.ValidateInitData(initData, botToken)
+//
+// Backends that don't know the bot token, such as those of third parties,
+// validate the Ed25519 signature of the data with the public key of Telegram
+// instead:
+//
+//	data, err := tgwebapp.ValidateInitDataThirdParty(initData, botID, tgwebapp.PublicKey)
+package tgwebapp
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrMissingHash happens when the init data has no hash, or no
+	// signature for third-party validation.
+	ErrMissingHash = errors.New("tgwebapp: init data is not signed")
+	// ErrInvalidHash happens when the hash or the signature of the init data
+	// doesn't match it, so it wasn't sent by Telegram or was tampered with.
+	ErrInvalidHash = errors.New("tgwebapp: init data doesn't match its signature")
+)
+
+var (
+	// PublicKey is the Ed25519 public key Telegram signs init data with.
+	PublicKey = mustPublicKey("e7bf03a2fa4602af4580703d88dda5bb59f32ed8b02a56c187fe7d34caed242d")
+	// TestPublicKey is the Ed25519 public key Telegram signs init data with
+	// in the test environment.
+	TestPublicKey = mustPublicKey("40055058a4ee38156a06562e52eece92a771bcd8346a8c4615cb7376eddf72ec")
+)
+
+// WebAppUser is a user of a Web App.
+type WebAppUser struct {
+	ID                    int64  `json:"id"`
+	IsBot                 bool   `json:"is_bot,omitempty"`
+	FirstName             string `json:"first_name"`
+	LastName              string `json:"last_name,omitempty"`
+	UserName              string `json:"username,omitempty"`
+	LanguageCode          string `json:"language_code,omitempty"`
+	IsPremium             bool   `json:"is_premium,omitempty"`
+	AddedToAttachmentMenu bool   `json:"added_to_attachment_menu,omitempty"`
+	AllowsWriteToPM       bool   `json:"allows_write_to_pm,omitempty"`
+	PhotoURL              string `json:"photo_url,omitempty"`
+}
+
+// WebAppChat is a chat a Web App was opened from, through the attachment menu.
+type WebAppChat struct {
+	ID       int64  `json:"id"`
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	UserName string `json:"username,omitempty"`
+	PhotoURL string `json:"photo_url,omitempty"`
+}
+
+// InitData is the data passed to a Web App when it is opened.
+type InitData struct {
+	// QueryID is the identifier of the Web App session, used to answer it
+	// with AnswerWebAppQuery.
+	QueryID string
+	// User is the current user.
+	User *WebAppUser
+	// Receiver is the chat partner of the current user in a private chat
+	// the Web App was opened from, through the attachment menu.
+	Receiver *WebAppUser
+	// Chat is the group or channel the Web App was opened from,
+	// through the attachment menu.
+	Chat *WebAppChat
+	// ChatType is the type of the chat the Web App was opened from,
+	// “sender”, “private”, “group”, “supergroup” or “channel”.
+	ChatType string
+	// ChatInstance is the global identifier of the chat
+	// the Web App was opened from.
+	ChatInstance string
+	// StartParam is the start parameter of the link the Web App was opened
+	// with.
+	StartParam string
+	// CanSendAfter is the time after which a message can be sent
+	// with AnswerWebAppQuery.
+	CanSendAfter time.Duration
+	// AuthDate is when the Web App was opened.
+	AuthDate time.Time
+	// Hash is the HMAC signature of the data.
+	Hash string
+	// Signature is the Ed25519 signature of the data.
+	Signature string
+}
+
+// ValidateInitData checks that initData, the query string of
+// window.Telegram.WebApp.initData, was signed for the bot of botToken,
+// and parses it.
+func ValidateInitData(initData, botToken string) (*InitData, error) {
+	values, err := url.ParseQuery(initData)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := values.Get("hash")
+	if hash == "" {
+		return nil, ErrMissingHash
+	}
+	expected, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, ErrInvalidHash
+	}
+
+	secret := hmac.New(sha256.New, []byte("WebAppData"))
+	secret.Write([]byte(botToken))
+	mac := hmac.New(sha256.New, secret.Sum(nil))
+	mac.Write([]byte(dataCheckString(values, "hash")))
+	if !hmac.Equal(mac.Sum(nil), expected) {
+		return nil, ErrInvalidHash
+	}
+
+	return parse(values)
+}
+
+// ValidateInitDataThirdParty checks that initData, the query string of
+// window.Telegram.WebApp.initData, was signed by Telegram for the bot botID
+// with the key matching publicKey, usually PublicKey, and parses it.
+func ValidateInitDataThirdParty(initData string, botID int64, publicKey ed25519.PublicKey) (*InitData, error) {
+	values, err := url.ParseQuery(initData)
+	if err != nil {
+		return nil, err
+	}
+
+	signature := values.Get("signature")
+	if signature == "" {
+		return nil, ErrMissingHash
+	}
+	rawSignature, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(signature, "="))
+	if err != nil {
+		return nil, ErrInvalidHash
+	}
+
+	data := strconv.FormatInt(botID, 10) + ":WebAppData\n" + dataCheckString(values, "hash", "signature")
+	if !ed25519.Verify(publicKey, []byte(data), rawSignature) {
+		return nil, ErrInvalidHash
+	}
+
+	return parse(values)
+}
+
+// Age returns how long ago the Web App was opened, to reject
+// outdated data.
+func (data *InitData) Age() time.Duration {
+	return time.Since(data.AuthDate)
+}
+
+// dataCheckString returns the string that is signed for values:
+// the sorted key=value pairs except the excluded ones, one per line.
+func dataCheckString(values url.Values, exclude ...string) string {
+	pairs := make([]string, 0, len(values))
+	for key := range values {
+		if contains(exclude, key) {
+			continue
+		}
+		pairs = append(pairs, key+"="+values.Get(key))
+	}
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, "\n")
+}
+
+// parse parses validated init data.
+func parse(values url.Values) (*InitData, error) {
+	data := &InitData{
+		QueryID:      values.Get("query_id"),
+		ChatType:     values.Get("chat_type"),
+		ChatInstance: values.Get("chat_instance"),
+		StartParam:   values.Get("start_param"),
+		Hash:         values.Get("hash"),
+		Signature:    values.Get("signature"),
+	}
+
+	for key, v := range map[string]interface{}{
+		"user":     &data.User,
+		"receiver": &data.Receiver,
+		"chat":     &data.Chat,
+	} {
+		if raw := values.Get(key); raw != "" {
+			if err := json.Unmarshal([]byte(raw), v); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if raw := values.Get("auth_date"); raw != "" {
+		authDate, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		data.AuthDate = time.Unix(authDate, 0)
+	}
+
+	if raw := values.Get("can_send_after"); raw != "" {
+		seconds, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, err
+		}
+		data.CanSendAfter = time.Duration(seconds) * time.Second
+	}
+
+	return data, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func mustPublicKey(s string) ed25519.PublicKey {
+	key, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return ed25519.PublicKey(key)
+}
@@ -0,0 +1,87 @@
+package tgwebapp_test
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/Feresey/telegram-bot-api/v5/tgwebapp"
+	"github.com/stretchr/testify/require"
+)
+
+const token = "123456:token"
+
+func initData() url.Values {
+	return url.Values{
+		"query_id":       {"AAHdF6IQAAAAAN0XohDhrOrc"},
+		"user":           {`{"id":279058397,"first_name":"Vladislav","username":"vdkfrost","language_code":"ru","is_premium":true}`},
+		"auth_date":      {"1662771648"},
+		"can_send_after": {"10"},
+	}
+}
+
+func TestValidateInitData(t *testing.T) {
+	values := initData()
+	secret := hmac.New(sha256.New, []byte("WebAppData"))
+	secret.Write([]byte(token))
+	mac := hmac.New(sha256.New, secret.Sum(nil))
+	mac.Write([]byte("auth_date=1662771648\ncan_send_after=10\nquery_id=AAHdF6IQAAAAAN0XohDhrOrc\n" +
+		`user={"id":279058397,"first_name":"Vladislav","username":"vdkfrost","language_code":"ru","is_premium":true}`))
+	values.Set("hash", hex.EncodeToString(mac.Sum(nil)))
+
+	data, err := tgwebapp.ValidateInitData(values.Encode(), token)
+	require.NoError(t, err)
+	require.Equal(t, "AAHdF6IQAAAAAN0XohDhrOrc", data.QueryID)
+	require.Equal(t, &tgwebapp.WebAppUser{
+		ID:           279058397,
+		FirstName:    "Vladislav",
+		UserName:     "vdkfrost",
+		LanguageCode: "ru",
+		IsPremium:    true,
+	}, data.User)
+	require.Equal(t, time.Unix(1662771648, 0), data.AuthDate)
+	require.Equal(t, 10*time.Second, data.CanSendAfter)
+
+	_, err = tgwebapp.ValidateInitData(values.Encode(), "654321:other")
+	require.ErrorIs(t, err, tgwebapp.ErrInvalidHash)
+
+	values.Set("auth_date", "1662771649")
+	_, err = tgwebapp.ValidateInitData(values.Encode(), token)
+	require.ErrorIs(t, err, tgwebapp.ErrInvalidHash)
+
+	values.Del("hash")
+	_, err = tgwebapp.ValidateInitData(values.Encode(), token)
+	require.ErrorIs(t, err, tgwebapp.ErrMissingHash)
+}
+
+func TestValidateInitDataThirdParty(t *testing.T) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	values := initData()
+	values.Set("hash", "ignored")
+	signature := ed25519.Sign(private, []byte("123456:WebAppData\n"+
+		"auth_date=1662771648\ncan_send_after=10\nquery_id=AAHdF6IQAAAAAN0XohDhrOrc\n"+
+		`user={"id":279058397,"first_name":"Vladislav","username":"vdkfrost","language_code":"ru","is_premium":true}`))
+	values.Set("signature", base64.RawURLEncoding.EncodeToString(signature))
+
+	data, err := tgwebapp.ValidateInitDataThirdParty(values.Encode(), 123456, public)
+	require.NoError(t, err)
+	require.Equal(t, int64(279058397), data.User.ID)
+
+	_, err = tgwebapp.ValidateInitDataThirdParty(values.Encode(), 654321, public)
+	require.ErrorIs(t, err, tgwebapp.ErrInvalidHash)
+
+	_, err = tgwebapp.ValidateInitDataThirdParty(values.Encode(), 123456, tgwebapp.PublicKey)
+	require.ErrorIs(t, err, tgwebapp.ErrInvalidHash)
+
+	values.Del("signature")
+	_, err = tgwebapp.ValidateInitDataThirdParty(values.Encode(), 123456, public)
+	require.ErrorIs(t, err, tgwebapp.ErrMissingHash)
+}
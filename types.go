@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"path"
 	"strings"
 	"time"
 )
@@ -74,6 +75,122 @@ type Update struct {
 	//
 	// optional
 	PreCheckoutQuery *PreCheckoutQuery `json:"pre_checkout_query"`
+	// Poll new poll state. Bots receive only updates about stopped polls
+	// and polls, which are sent by the bot
+	//
+	// optional
+	Poll *Poll `json:"poll"`
+	// PollAnswer is a user changed their answer in a non-anonymous poll.
+	// Bots receive new votes only in polls that were sent by the bot itself.
+	//
+	// optional
+	PollAnswer *PollAnswer `json:"poll_answer"`
+	// MyChatMember is the bot's chat member status was updated in a chat.
+	// For private chats, this update is received only when the bot is
+	// blocked or unblocked by the user.
+	//
+	// optional
+	MyChatMember *ChatMemberUpdated `json:"my_chat_member"`
+	// ChatMember is a chat member's status was updated in a chat.
+	// The bot must be an administrator in the chat and must explicitly
+	// specify UpdateTypeChatMember in the list of allowed updates
+	// to receive these updates.
+	//
+	// optional
+	ChatMember *ChatMemberUpdated `json:"chat_member"`
+
+	// ChatJoinRequest is a request to join the chat. The bot must have
+	// the can_invite_users administrator right in the chat to receive these
+	// updates.
+	//
+	// optional
+	ChatJoinRequest *ChatJoinRequest `json:"chat_join_request"`
+
+	// MessageReaction is a reaction to a message changed by a user.
+	// The bot must be an administrator in the chat and must explicitly
+	// specify "message_reaction" in the list of allowed_updates to receive
+	// these updates. The update isn't received for reactions set by bots.
+	//
+	// optional
+	MessageReaction *MessageReactionUpdated `json:"message_reaction"`
+	// MessageReactionCount is a change of the anonymous reactions to a
+	// message. The bot must be an administrator in the chat and must
+	// explicitly specify "message_reaction_count" in the list of
+	// allowed_updates to receive these updates. The updates are grouped
+	// and can be sent with a delay of up to a few minutes.
+	//
+	// optional
+	MessageReactionCount *MessageReactionCountUpdated `json:"message_reaction_count"`
+
+	// ChatBoost is a boost added to or changed in a chat. The bot must be
+	// an administrator in the chat to receive these updates.
+	//
+	// optional
+	ChatBoost *ChatBoostUpdated `json:"chat_boost"`
+	// RemovedChatBoost is a boost removed from a chat. The bot must be
+	// an administrator in the chat to receive these updates.
+	//
+	// optional
+	RemovedChatBoost *ChatBoostRemoved `json:"removed_chat_boost"`
+
+	// BusinessConnection is the bot connected to or disconnected from
+	// a business account, or a user edited an existing connection with the bot.
+	//
+	// optional
+	BusinessConnection *BusinessConnection `json:"business_connection"`
+	// BusinessMessage is a new message from a connected business account.
+	//
+	// optional
+	BusinessMessage *Message `json:"business_message"`
+	// EditedBusinessMessage is a new version of a message from
+	// a connected business account.
+	//
+	// optional
+	EditedBusinessMessage *Message `json:"edited_business_message"`
+	// DeletedBusinessMessages are messages deleted from
+	// a connected business account.
+	//
+	// optional
+	DeletedBusinessMessages *BusinessMessagesDeleted `json:"deleted_business_messages"`
+}
+
+// FromChat returns the chat where the update occurred,
+// or nil if the update is not related to a chat.
+func (u *Update) FromChat() *Chat {
+	switch {
+	case u.Message != nil:
+		return u.Message.Chat
+	case u.EditedMessage != nil:
+		return u.EditedMessage.Chat
+	case u.ChannelPost != nil:
+		return u.ChannelPost.Chat
+	case u.EditedChannelPost != nil:
+		return u.EditedChannelPost.Chat
+	case u.CallbackQuery != nil && u.CallbackQuery.Message != nil:
+		return u.CallbackQuery.Message.Chat
+	case u.MyChatMember != nil:
+		return &u.MyChatMember.Chat
+	case u.ChatMember != nil:
+		return &u.ChatMember.Chat
+	case u.ChatJoinRequest != nil:
+		return &u.ChatJoinRequest.Chat
+	case u.MessageReaction != nil:
+		return &u.MessageReaction.Chat
+	case u.MessageReactionCount != nil:
+		return &u.MessageReactionCount.Chat
+	case u.ChatBoost != nil:
+		return &u.ChatBoost.Chat
+	case u.RemovedChatBoost != nil:
+		return &u.RemovedChatBoost.Chat
+	case u.BusinessMessage != nil:
+		return u.BusinessMessage.Chat
+	case u.EditedBusinessMessage != nil:
+		return u.EditedBusinessMessage.Chat
+	case u.DeletedBusinessMessages != nil:
+		return &u.DeletedBusinessMessages.Chat
+	default:
+		return nil
+	}
 }
 
 // UpdatesChannel is the channel for getting updates.
@@ -171,6 +288,10 @@ type Chat struct {
 	//
 	// optional
 	LastName string `json:"last_name"`
+	// IsForum is true, if the supergroup chat is a forum (has topics enabled)
+	//
+	// optional
+	IsForum bool `json:"is_forum,omitempty"`
 	// AllMembersAreAdmins
 	//
 	// optional
@@ -191,6 +312,16 @@ type Chat struct {
 	//
 	// optional
 	PinnedMessage *Message `json:"pinned_message"`
+	// Permissions are default chat member permissions, for groups and supergroups
+	//
+	// optional
+	Permissions *ChatPermissions `json:"permissions,omitempty"`
+	// CustomEmojiStickerSetName is the name of the group's custom emoji
+	// sticker set, which custom emoji can be used by all users and bots in
+	// the group, for supergroups only
+	//
+	// optional
+	CustomEmojiStickerSetName string `json:"custom_emoji_sticker_set_name,omitempty"`
 }
 
 // IsPrivate returns if the Chat is a private conversation.
@@ -218,43 +349,308 @@ func (c Chat) ChatConfig() ChatConfig {
 	return ChatConfig{ChatID: c.ID}
 }
 
+// ChatFullInfo contains full information about a chat, as returned
+// by GetChat.
+type ChatFullInfo struct {
+	Chat
+	// AccentColorID is the identifier of the accent color for the chat name
+	// and backgrounds of the chat photo, reply header, and link preview
+	AccentColorID int `json:"accent_color_id"`
+	// MaxReactionCount is the maximum number of reactions that can be set
+	// on a message in the chat
+	MaxReactionCount int `json:"max_reaction_count"`
+	// ActiveUsernames is the list of all active chat usernames,
+	// for private chats, supergroups and channels
+	//
+	// optional
+	ActiveUsernames []string `json:"active_usernames,omitempty"`
+	// Birthdate of the other party in a private chat
+	//
+	// optional
+	Birthdate *Birthdate `json:"birthdate,omitempty"`
+	// BusinessIntro is the intro of the business, for private chats
+	// with business accounts
+	//
+	// optional
+	BusinessIntro *BusinessIntro `json:"business_intro,omitempty"`
+	// BusinessLocation is the location of the business, for private chats
+	// with business accounts
+	//
+	// optional
+	BusinessLocation *BusinessLocation `json:"business_location,omitempty"`
+	// BusinessOpeningHours are the opening hours of the business,
+	// for private chats with business accounts
+	//
+	// optional
+	BusinessOpeningHours *BusinessOpeningHours `json:"business_opening_hours,omitempty"`
+	// PersonalChat is the personal channel of the user, for private chats
+	//
+	// optional
+	PersonalChat *Chat `json:"personal_chat,omitempty"`
+	// AvailableReactions is the list of available reactions allowed in the
+	// chat. All emoji reactions are allowed if it is omitted
+	//
+	// optional
+	AvailableReactions []ReactionType `json:"available_reactions,omitempty"`
+	// BackgroundCustomEmojiID is the custom emoji chosen by the chat
+	// for the reply header and link preview background
+	//
+	// optional
+	BackgroundCustomEmojiID string `json:"background_custom_emoji_id,omitempty"`
+	// ProfileAccentColorID is the identifier of the accent color
+	// for the chat's profile background
+	//
+	// optional
+	ProfileAccentColorID *int `json:"profile_accent_color_id,omitempty"`
+	// ProfileBackgroundCustomEmojiID is the custom emoji chosen by the chat
+	// for its profile background
+	//
+	// optional
+	ProfileBackgroundCustomEmojiID string `json:"profile_background_custom_emoji_id,omitempty"`
+	// EmojiStatusCustomEmojiID is the custom emoji status of the chat
+	// or the other party in a private chat
+	//
+	// optional
+	EmojiStatusCustomEmojiID string `json:"emoji_status_custom_emoji_id,omitempty"`
+	// EmojiStatusExpirationDate is the expiration date of the emoji status
+	// of the chat or the other party in a private chat, in Unix time
+	//
+	// optional
+	EmojiStatusExpirationDate int64 `json:"emoji_status_expiration_date,omitempty"`
+	// Bio of the other party in a private chat
+	//
+	// optional
+	Bio string `json:"bio,omitempty"`
+	// HasPrivateForwards is true, if privacy settings of the other party in
+	// the private chat allows to use tg://user?id=<user_id> links only in
+	// chats with the user
+	//
+	// optional
+	HasPrivateForwards bool `json:"has_private_forwards,omitempty"`
+	// HasRestrictedVoiceAndVideoMessages is true, if the privacy settings of
+	// the other party restrict sending voice and video note messages in the
+	// private chat
+	//
+	// optional
+	HasRestrictedVoiceAndVideoMessages bool `json:"has_restricted_voice_and_video_messages,omitempty"`
+	// JoinToSendMessages is true, if users need to join the supergroup
+	// before they can send messages
+	//
+	// optional
+	JoinToSendMessages bool `json:"join_to_send_messages,omitempty"`
+	// JoinByRequest is true, if all users directly joining the supergroup
+	// without using an invite link need to be approved by administrators
+	//
+	// optional
+	JoinByRequest bool `json:"join_by_request,omitempty"`
+	// AcceptedGiftTypes are the types of gifts accepted by the chat
+	// or by the corresponding user for private chats
+	AcceptedGiftTypes AcceptedGiftTypes `json:"accepted_gift_types"`
+	// CanSendPaidMedia is true, if paid media messages can be sent
+	// or forwarded to the channel chat
+	//
+	// optional
+	CanSendPaidMedia bool `json:"can_send_paid_media,omitempty"`
+	// SlowModeDelay is the minimum allowed delay between consecutive
+	// messages sent by each unprivileged user, in seconds
+	//
+	// optional
+	SlowModeDelay int `json:"slow_mode_delay,omitempty"`
+	// UnrestrictBoostCount is the minimum number of boosts that a
+	// non-administrator user needs to add in order to ignore slow mode and
+	// chat permissions
+	//
+	// optional
+	UnrestrictBoostCount int `json:"unrestrict_boost_count,omitempty"`
+	// MessageAutoDeleteTime is the time after which all messages sent to the
+	// chat will be automatically deleted, in seconds
+	//
+	// optional
+	MessageAutoDeleteTime int `json:"message_auto_delete_time,omitempty"`
+	// HasAggressiveAntiSpamEnabled is true, if aggressive anti-spam checks
+	// are enabled in the supergroup
+	//
+	// optional
+	HasAggressiveAntiSpamEnabled bool `json:"has_aggressive_anti_spam_enabled,omitempty"`
+	// HasHiddenMembers is true, if non-administrators can only get the list
+	// of bots and administrators in the chat
+	//
+	// optional
+	HasHiddenMembers bool `json:"has_hidden_members,omitempty"`
+	// HasProtectedContent is true, if messages from the chat can't be
+	// forwarded to other chats
+	//
+	// optional
+	HasProtectedContent bool `json:"has_protected_content,omitempty"`
+	// HasVisibleHistory is true, if new chat members will have access
+	// to old messages
+	//
+	// optional
+	HasVisibleHistory bool `json:"has_visible_history,omitempty"`
+	// StickerSetName is the name of the group sticker set
+	//
+	// optional
+	StickerSetName string `json:"sticker_set_name,omitempty"`
+	// CanSetStickerSet is true, if the bot can change the group sticker set
+	//
+	// optional
+	CanSetStickerSet bool `json:"can_set_sticker_set,omitempty"`
+	// LinkedChatID is the identifier of the linked chat: the discussion group
+	// of a channel, or the channel of a discussion group
+	//
+	// optional
+	LinkedChatID int64 `json:"linked_chat_id,omitempty"`
+	// Location is the location to which the supergroup is connected
+	//
+	// optional
+	Location *ChatLocation `json:"location,omitempty"`
+}
+
+// Birthdate describes the birthdate of a user.
+type Birthdate struct {
+	// Day of the user's birth; 1-31
+	Day int `json:"day"`
+	// Month of the user's birth; 1-12
+	Month int `json:"month"`
+	// Year of the user's birth
+	//
+	// optional
+	Year int `json:"year,omitempty"`
+}
+
+// BusinessIntro contains information about the start page settings
+// of a business account.
+type BusinessIntro struct {
+	// Title text of the business intro
+	//
+	// optional
+	Title string `json:"title,omitempty"`
+	// Message text of the business intro
+	//
+	// optional
+	Message string `json:"message,omitempty"`
+	// Sticker of the business intro
+	//
+	// optional
+	Sticker *Sticker `json:"sticker,omitempty"`
+}
+
+// BusinessLocation contains information about the location
+// of a business account.
+type BusinessLocation struct {
+	// Address of the business
+	Address string `json:"address"`
+	// Location of the business
+	//
+	// optional
+	Location *Location `json:"location,omitempty"`
+}
+
+// BusinessOpeningHoursInterval describes an interval of time during which
+// a business is open.
+type BusinessOpeningHoursInterval struct {
+	// OpeningMinute is the minute's sequence number in a week, starting on
+	// Monday, marking the start of the time interval; 0-7*24*60
+	OpeningMinute int `json:"opening_minute"`
+	// ClosingMinute is the minute's sequence number in a week, starting on
+	// Monday, marking the end of the time interval; 0-8*24*60
+	ClosingMinute int `json:"closing_minute"`
+}
+
+// BusinessOpeningHours describes the opening hours of a business.
+type BusinessOpeningHours struct {
+	// TimeZoneName is the unique name of the time zone
+	// for which the opening hours are defined
+	TimeZoneName string `json:"time_zone_name"`
+	// OpeningHours is the list of time intervals describing
+	// the business opening hours
+	OpeningHours []BusinessOpeningHoursInterval `json:"opening_hours"`
+}
+
+// ChatLocation represents a location to which a chat is connected.
+type ChatLocation struct {
+	// Location to which the supergroup is connected. Can't be a live location
+	Location Location `json:"location"`
+	// Address is the location address; 1-64 characters, as defined
+	// by the chat owner
+	Address string `json:"address"`
+}
+
 // Message is returned by almost every request, and contains data about
 // almost anything.
 type Message struct {
 	// MessageID is a unique message identifier inside this chat
 	MessageID int `json:"message_id"`
+	// MessageThreadID is a unique identifier of a message thread
+	// to which the message belongs; for supergroups only;
+	//
+	// optional
+	MessageThreadID int `json:"message_thread_id"`
 	// From is a sender, empty for messages sent to channels;
 	//
 	// optional
 	From *User `json:"from"`
+	// SenderChat is the sender of the message, sent on behalf of a chat.
+	// For example, the channel itself for channel posts, the supergroup itself
+	// for messages from anonymous group administrators, the linked channel
+	// for messages automatically forwarded to the discussion group;
+	//
+	// optional
+	SenderChat *Chat `json:"sender_chat"`
+	// SenderBusinessBot is the bot that actually sent the message on behalf
+	// of the business account. Available only for outgoing messages sent
+	// on behalf of the connected business account;
+	//
+	// optional
+	SenderBusinessBot *User `json:"sender_business_bot"`
+	// BusinessConnectionID is the unique identifier of the business connection
+	// from which the message was received. If non-empty, the message belongs
+	// to a chat of the corresponding business account;
+	//
+	// optional
+	BusinessConnectionID string `json:"business_connection_id"`
 	// Date of the message was sent in Unix time
 	Date int `json:"date"`
 	// Chat is the conversation the message belongs to
 	Chat *Chat `json:"chat"`
-	// ForwardFrom for forwarded messages, sender of the original message;
+	// SenderBoostCount is the number of boosts added by the user,
+	// if the sender of the message boosted the chat;
 	//
 	// optional
-	ForwardFrom *User `json:"forward_from"`
-	// ForwardFromChat for messages forwarded from channels,
-	// information about the original channel;
+	SenderBoostCount int `json:"sender_boost_count,omitempty"`
+	// ForwardOrigin for forwarded messages, information about the original message;
 	//
 	// optional
-	ForwardFromChat *Chat `json:"forward_from_chat"`
-	// ForwardFromMessageID for messages forwarded from channels,
-	// identifier of the original message in the channel;
+	ForwardOrigin *MessageOrigin `json:"forward_origin,omitempty"`
+	// IsTopicMessage is true, if the message is sent to a forum topic;
 	//
 	// optional
-	ForwardFromMessageID int `json:"forward_from_message_id"`
-	// ForwardDate for forwarded messages, date the original message was sent in Unix time;
+	IsTopicMessage bool `json:"is_topic_message"`
+	// IsAutomaticForward is true, if the message is a channel post that was
+	// automatically forwarded to the connected discussion group;
 	//
 	// optional
-	ForwardDate int `json:"forward_date"`
+	IsAutomaticForward bool `json:"is_automatic_forward,omitempty"`
 	// ReplyToMessage for replies, the original message.
 	// Note that the Message object in this field will not contain further ReplyToMessage fields
 	// even if it itself is a reply;
 	//
 	// optional
 	ReplyToMessage *Message `json:"reply_to_message"`
+	// ExternalReply is information about the message that is being replied to,
+	// which may come from another chat or forum topic;
+	//
+	// optional
+	ExternalReply *ExternalReplyInfo `json:"external_reply,omitempty"`
+	// Quote for replies that quote part of the original message,
+	// the quoted part of the message;
+	//
+	// optional
+	Quote *TextQuote `json:"quote,omitempty"`
+	// ReplyToStory for replies to a story, the original story;
+	//
+	// optional
+	ReplyToStory *Story `json:"reply_to_story,omitempty"`
 	// ViaBot through which the message was sent;
 	//
 	// optional
@@ -263,6 +659,16 @@ type Message struct {
 	//
 	// optional
 	EditDate int `json:"edit_date"`
+	// HasProtectedContent is true, if the message can't be forwarded;
+	//
+	// optional
+	HasProtectedContent bool `json:"has_protected_content,omitempty"`
+	// IsFromOffline is true, if the message was sent by an implicit action,
+	// for example, as an away or a greeting business message,
+	// or as a scheduled message;
+	//
+	// optional
+	IsFromOffline bool `json:"is_from_offline,omitempty"`
 	// MediaGroupID is the unique identifier of a media message group this message belongs to;
 	//
 	// optional
@@ -280,10 +686,32 @@ type Message struct {
 	//
 	// optional
 	Entities *[]MessageEntity `json:"entities"`
+	// LinkPreviewOptions are the options used for link preview generation
+	// for the message, if it is a text message and link preview
+	// options were changed;
+	//
+	// optional
+	LinkPreviewOptions *LinkPreviewOptions `json:"link_preview_options,omitempty"`
+	// EffectID is the unique identifier of the message effect added to the message;
+	//
+	// optional
+	EffectID string `json:"effect_id,omitempty"`
 	// CaptionEntities;
 	//
 	// optional
 	CaptionEntities *[]MessageEntity `json:"caption_entities"`
+	// ShowCaptionAboveMedia is true, if the caption must be shown above the media;
+	//
+	// optional
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media"`
+	// HasMediaSpoiler is true, if the message media is covered by a spoiler animation;
+	//
+	// optional
+	HasMediaSpoiler bool `json:"has_media_spoiler,omitempty"`
+	// Story message is a forwarded story;
+	//
+	// optional
+	Story *Story `json:"story,omitempty"`
 	// Audio message is an audio file, information about the file;
 	//
 	// optional
@@ -305,6 +733,10 @@ type Message struct {
 	//
 	// optional
 	Photo *[]PhotoSize `json:"photo"`
+	// PaidMedia message contains paid media, information about the paid media;
+	//
+	// optional
+	PaidMedia *PaidMediaInfo `json:"paid_media"`
 	// Sticker message is a sticker, information about the sticker;
 	//
 	// optional
@@ -338,6 +770,14 @@ type Message struct {
 	//
 	// optional
 	Venue *Venue `json:"venue"`
+	// Poll is a native poll, information about the poll;
+	//
+	// optional
+	Poll *Poll `json:"poll"`
+	// Dice is a dice with random value;
+	//
+	// optional
+	Dice *Dice `json:"dice"`
 	// NewChatMembers that were added to the group or supergroup
 	// and information about them (the bot itself may be one of these members);
 	//
@@ -411,10 +851,133 @@ type Message struct {
 	//
 	// optional
 	SuccessfulPayment *SuccessfulPayment `json:"successful_payment"`
+	// Checklist message is a checklist;
+	//
+	// optional
+	Checklist *Checklist `json:"checklist"`
+	// ChecklistTasksDone is a service message: some tasks in a checklist
+	// were marked as done or not done;
+	//
+	// optional
+	ChecklistTasksDone *ChecklistTasksDone `json:"checklist_tasks_done"`
+	// ChecklistTasksAdded is a service message: tasks were added
+	// to a checklist;
+	//
+	// optional
+	ChecklistTasksAdded *ChecklistTasksAdded `json:"checklist_tasks_added"`
+	// Gift is a service message about a regular gift that was sent or received;
+	//
+	// optional
+	Gift *GiftInfo `json:"gift"`
+	// ForumTopicCreated is a service message: forum topic created;
+	//
+	// optional
+	ForumTopicCreated *ForumTopicCreated `json:"forum_topic_created"`
+	// ForumTopicEdited is a service message: forum topic edited;
+	//
+	// optional
+	ForumTopicEdited *ForumTopicEdited `json:"forum_topic_edited"`
+	// ForumTopicClosed is a service message: forum topic closed;
+	//
+	// optional
+	ForumTopicClosed *ForumTopicClosed `json:"forum_topic_closed"`
+	// ForumTopicReopened is a service message: forum topic reopened;
+	//
+	// optional
+	ForumTopicReopened *ForumTopicReopened `json:"forum_topic_reopened"`
+	// GeneralForumTopicHidden is a service message: the 'General' forum topic hidden;
+	//
+	// optional
+	GeneralForumTopicHidden *GeneralForumTopicHidden `json:"general_forum_topic_hidden"`
+	// GeneralForumTopicUnhidden is a service message: the 'General' forum topic unhidden;
+	//
+	// optional
+	GeneralForumTopicUnhidden *GeneralForumTopicUnhidden `json:"general_forum_topic_unhidden"`
+	// ProximityAlertTriggered is a service message. A user in the chat
+	// triggered another user's proximity alert while sharing Live Location;
+	//
+	// optional
+	ProximityAlertTriggered *ProximityAlertTriggered `json:"proximity_alert_triggered"`
 	// PassportData is a Telegram Passport data;
 	//
 	// optional
 	PassportData *PassportData `json:"passport_data,omitempty"`
+	// WebAppData is service message: data sent by a Web App
+	//
+	// optional
+	WebAppData *WebAppData `json:"web_app_data,omitempty"`
+	// MessageAutoDeleteTimerChanged is a service message: auto-delete timer
+	// settings changed in the chat;
+	//
+	// optional
+	MessageAutoDeleteTimerChanged *MessageAutoDeleteTimerChanged `json:"message_auto_delete_timer_changed,omitempty"`
+	// RefundedPayment is a service message about a refunded payment;
+	//
+	// optional
+	RefundedPayment *RefundedPayment `json:"refunded_payment,omitempty"`
+	// UsersShared is a service message: users were shared with the bot;
+	//
+	// optional
+	UsersShared *UsersShared `json:"users_shared,omitempty"`
+	// ChatShared is a service message: a chat was shared with the bot;
+	//
+	// optional
+	ChatShared *ChatShared `json:"chat_shared,omitempty"`
+	// ConnectedWebsite is the domain name of the website on which the user
+	// has logged in;
+	//
+	// optional
+	ConnectedWebsite string `json:"connected_website,omitempty"`
+	// WriteAccessAllowed is a service message: the user allowed the bot to
+	// write messages after adding it to the attachment or side menu,
+	// launching a Web App from a link, or accepting an explicit request
+	// from a Web App;
+	//
+	// optional
+	WriteAccessAllowed *WriteAccessAllowed `json:"write_access_allowed,omitempty"`
+	// BoostAdded is a service message: the user boosted the chat;
+	//
+	// optional
+	BoostAdded *ChatBoostAdded `json:"boost_added,omitempty"`
+	// GiveawayCreated is a service message: a scheduled giveaway was created;
+	//
+	// optional
+	GiveawayCreated *GiveawayCreated `json:"giveaway_created,omitempty"`
+	// Giveaway is a scheduled giveaway;
+	//
+	// optional
+	Giveaway *Giveaway `json:"giveaway,omitempty"`
+	// GiveawayWinners is a giveaway with public winners was completed;
+	//
+	// optional
+	GiveawayWinners *GiveawayWinners `json:"giveaway_winners,omitempty"`
+	// GiveawayCompleted is a service message: a giveaway without public
+	// winners was completed;
+	//
+	// optional
+	GiveawayCompleted *GiveawayCompleted `json:"giveaway_completed,omitempty"`
+	// VideoChatScheduled is a service message: video chat scheduled;
+	//
+	// optional
+	VideoChatScheduled *VideoChatScheduled `json:"video_chat_scheduled,omitempty"`
+	// VideoChatStarted is a service message: video chat started;
+	//
+	// optional
+	VideoChatStarted *VideoChatStarted `json:"video_chat_started,omitempty"`
+	// VideoChatEnded is a service message: video chat ended;
+	//
+	// optional
+	VideoChatEnded *VideoChatEnded `json:"video_chat_ended,omitempty"`
+	// VideoChatParticipantsInvited is a service message: new participants
+	// invited to a video chat;
+	//
+	// optional
+	VideoChatParticipantsInvited *VideoChatParticipantsInvited `json:"video_chat_participants_invited,omitempty"`
+	// ReplyMarkup is the inline keyboard attached to the message.
+	// LoginURL buttons are represented as ordinary url buttons;
+	//
+	// optional
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
 // Time converts the message timestamp into a Time.
@@ -485,6 +1048,39 @@ func (m *Message) CommandArguments() string {
 	return m.Text[entity.Length+1:]
 }
 
+// MessageID is the unique identifier of a message, returned by
+// the methods copying messages.
+type MessageID struct {
+	// MessageID is a unique message identifier inside this chat
+	MessageID int `json:"message_id"`
+}
+
+// EditResult is the result of a method editing a message, such as
+// editMessageText or setGameScore. The edited message is returned for
+// messages of a chat, and only true for inline messages.
+type EditResult struct {
+	// Message is the edited message, nil for inline messages
+	Message *Message
+	// OK is true if the message was edited
+	OK bool
+}
+
+// UnmarshalJSON decodes either an edited message or true.
+func (r *EditResult) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &r.OK); err == nil {
+		r.Message = nil
+		return nil
+	}
+
+	var message Message
+	if err := json.Unmarshal(data, &message); err != nil {
+		return err
+	}
+
+	r.Message, r.OK = &message, true
+	return nil
+}
+
 // MessageEntity contains information about data in a Message.
 type MessageEntity struct {
 	// Type of the entity.
@@ -503,7 +1099,8 @@ type MessageEntity struct {
 	//  “code” (monowidth string),
 	//  “pre” (monowidth block),
 	//  “text_link” (for clickable text URLs),
-	//  “text_mention” (for users without usernames)
+	//  “text_mention” (for users without usernames),
+	//  “custom_emoji” (for inline custom emoji stickers)
 	Type string `json:"type"`
 	// Offset in UTF-16 code units to the start of the entity
 	Offset int `json:"offset"`
@@ -517,6 +1114,12 @@ type MessageEntity struct {
 	//
 	// optional
 	User *User `json:"user"`
+	// CustomEmojiID for “custom_emoji” only, unique identifier of the
+	// custom emoji. Use GetCustomEmojiStickers to get full information
+	// about the sticker
+	//
+	// optional
+	CustomEmojiID string `json:"custom_emoji_id,omitempty"`
 }
 
 // ParseURL attempts to parse a URL contained within a MessageEntity.
@@ -578,6 +1181,11 @@ func (e MessageEntity) IsTextLink() bool {
 	return e.Type == "text_link"
 }
 
+// IsCustomEmoji returns true if the type of the message entity is "custom_emoji".
+func (e MessageEntity) IsCustomEmoji() bool {
+	return e.Type == "custom_emoji"
+}
+
 // PhotoSize contains information about photos.
 type PhotoSize struct {
 	// FileID identifier for this file, which can be used to download or reuse the file
@@ -670,6 +1278,11 @@ type Sticker struct {
 	//
 	// optional
 	IsAnimated bool `json:"is_animated"`
+	// MaskPosition is the position where the mask should be placed,
+	// for mask stickers
+	//
+	// optional
+	MaskPosition *MaskPosition `json:"mask_position,omitempty"`
 }
 
 // StickerSet contains information about an sticker set.
@@ -686,6 +1299,57 @@ type StickerSet struct {
 	Stickers []Sticker `json:"stickers"`
 }
 
+// MaskPosition describes the position on faces where a mask
+// should be placed by default.
+type MaskPosition struct {
+	// Point is the part of the face relative to which the mask should be
+	// placed, one of “forehead”, “eyes”, “mouth” or “chin”
+	Point string `json:"point"`
+	// XShift is the shift by X-axis measured in widths of the mask scaled to
+	// the face size, from left to right. For example, choosing -1.0 will
+	// place the mask just to the left of the default mask position
+	XShift float64 `json:"x_shift"`
+	// YShift is the shift by Y-axis measured in heights of the mask scaled to
+	// the face size, from top to bottom. For example, 1.0 will place the mask
+	// just below the default mask position
+	YShift float64 `json:"y_shift"`
+	// Scale is the mask scaling coefficient. For example, 2.0 means double size
+	Scale float64 `json:"scale"`
+}
+
+// InputSticker describes a sticker to add to a sticker set.
+type InputSticker struct {
+	// Sticker is the file_id of an existing file or an HTTP URL,
+	// or is set from File
+	Sticker string `json:"sticker"`
+	// File to upload in place of Sticker, as a file path, FileBytes,
+	// FileReader or RequestFileData. Animated and video stickers
+	// can't be given by URL, so they are uploaded or reused by file_id
+	//
+	// optional
+	File interface{} `json:"-"`
+	// Format of the sticker, “static”, “animated” or “video”
+	Format string `json:"format"`
+	// EmojiList are the 1-20 emoji associated with the sticker
+	EmojiList []string `json:"emoji_list"`
+	// MaskPosition is the position where the mask should be placed on faces,
+	// for mask stickers only
+	//
+	// optional
+	MaskPosition *MaskPosition `json:"mask_position,omitempty"`
+	// Keywords are 0-20 search keywords for the sticker, with a total length
+	// of up to 64 characters, for regular and custom emoji stickers only
+	//
+	// optional
+	Keywords []string `json:"keywords,omitempty"`
+}
+
+// prepare replaces File with an attach:// reference in Sticker.
+func (sticker InputSticker) prepare(prefix string) (interface{}, []RequestFile, error) {
+	files, err := attachFile(&sticker.Sticker, prefix, sticker.File)
+	return sticker, files, err
+}
+
 // ChatAnimation contains information about an animation.
 type ChatAnimation struct {
 	// FileID odentifier for this file, which can be used to download or reuse the file
@@ -788,6 +1452,10 @@ type Contact struct {
 	//
 	// optional
 	UserID int `json:"user_id"`
+	// VCard is additional data about the contact in the form of a vCard
+	//
+	// optional
+	VCard string `json:"vcard"`
 }
 
 // Location contains information about a place.
@@ -796,6 +1464,553 @@ type Location struct {
 	Longitude float64 `json:"longitude"`
 	// Latitude as defined by sender
 	Latitude float64 `json:"latitude"`
+	// HorizontalAccuracy the radius of uncertainty for the location,
+	// measured in meters; 0-1500
+	//
+	// optional
+	HorizontalAccuracy float64 `json:"horizontal_accuracy,omitempty"`
+	// LivePeriod time relative to the message sending date,
+	// during which the location can be updated, in seconds.
+	// For active live locations only.
+	//
+	// optional
+	LivePeriod int `json:"live_period,omitempty"`
+	// Heading the direction in which user is moving, in degrees; 1-360.
+	// For active live locations only.
+	//
+	// optional
+	Heading int `json:"heading,omitempty"`
+	// ProximityAlertRadius maximum distance for proximity alerts about
+	// approaching another chat member, in meters.
+	// For sent live locations only.
+	//
+	// optional
+	ProximityAlertRadius int `json:"proximity_alert_radius,omitempty"`
+}
+
+// ProximityAlertTriggered represents the content of a service message,
+// sent whenever a user in the chat triggers a proximity alert
+// set by another user.
+type ProximityAlertTriggered struct {
+	// Traveler is the user that triggered the alert
+	Traveler User `json:"traveler"`
+	// Watcher is the user that set the alert
+	Watcher User `json:"watcher"`
+	// Distance between the users
+	Distance int `json:"distance"`
+}
+
+// Constant values for the types of MessageOrigin.
+const (
+	MessageOriginUser       = "user"
+	MessageOriginHiddenUser = "hidden_user"
+	MessageOriginChat       = "chat"
+	MessageOriginChannel    = "channel"
+)
+
+// MessageOrigin describes the origin of a message.
+//
+// The type of the origin can be:
+//
+//	“user”, a message originally sent by a known user,
+//	“hidden_user”, a message originally sent by an unknown user,
+//	“chat”, a message originally sent on behalf of a chat to a group chat,
+//	“channel”, a message originally sent to a channel chat.
+type MessageOrigin struct {
+	// Type of the message origin
+	Type string `json:"type"`
+	// Date the message was sent originally in Unix time
+	Date int `json:"date"`
+	// SenderUser is the user that sent the message originally,
+	// for the “user” type
+	//
+	// optional
+	SenderUser *User `json:"sender_user,omitempty"`
+	// SenderUserName is the name of the user that sent the message
+	// originally, for the “hidden_user” type
+	//
+	// optional
+	SenderUserName string `json:"sender_user_name,omitempty"`
+	// SenderChat is the chat that sent the message originally,
+	// for the “chat” type
+	//
+	// optional
+	SenderChat *Chat `json:"sender_chat,omitempty"`
+	// Chat is the channel the message was originally sent to,
+	// for the “channel” type
+	//
+	// optional
+	Chat *Chat `json:"chat,omitempty"`
+	// MessageID is the unique message identifier inside the channel,
+	// for the “channel” type
+	//
+	// optional
+	MessageID int `json:"message_id,omitempty"`
+	// AuthorSignature is the signature of the original post author,
+	// for the “chat” and “channel” types
+	//
+	// optional
+	AuthorSignature string `json:"author_signature,omitempty"`
+}
+
+// TextQuote contains information about the quoted part of a message
+// that is replied to by the given message.
+type TextQuote struct {
+	// Text of the quoted part of a message that is replied to
+	// by the given message
+	Text string `json:"text"`
+	// Entities that appear in the quote. Only bold, italic, underline,
+	// strikethrough, spoiler, and custom_emoji entities are kept in quotes
+	//
+	// optional
+	Entities []MessageEntity `json:"entities,omitempty"`
+	// Position of the quote in the original message in UTF-16 code units
+	Position int `json:"position"`
+	// IsManual is true, if the quote was chosen manually by the message
+	// sender. Otherwise, the quote was added automatically by the server
+	//
+	// optional
+	IsManual bool `json:"is_manual,omitempty"`
+}
+
+// ExternalReplyInfo contains information about a message that is being
+// replied to, which may come from another chat or forum topic.
+type ExternalReplyInfo struct {
+	// Origin of the message replied to by the given message
+	Origin MessageOrigin `json:"origin"`
+	// Chat the original message belongs to. Available only if the chat
+	// is a supergroup or a channel
+	//
+	// optional
+	Chat *Chat `json:"chat,omitempty"`
+	// MessageID is the unique message identifier inside the original chat.
+	// Available only if the original chat is a supergroup or a channel
+	//
+	// optional
+	MessageID int `json:"message_id,omitempty"`
+	// LinkPreviewOptions used for link preview generation for the original
+	// message, if it is a text message
+	//
+	// optional
+	LinkPreviewOptions *LinkPreviewOptions `json:"link_preview_options,omitempty"`
+	// Animation message is an animation, information about the animation
+	//
+	// optional
+	Animation *ChatAnimation `json:"animation,omitempty"`
+	// Audio message is an audio file, information about the file
+	//
+	// optional
+	Audio *Audio `json:"audio,omitempty"`
+	// Document message is a general file, information about the file
+	//
+	// optional
+	Document *Document `json:"document,omitempty"`
+	// PaidMedia message contains paid media, information about the paid media
+	//
+	// optional
+	PaidMedia *PaidMediaInfo `json:"paid_media,omitempty"`
+	// Photo message is a photo, available sizes of the photo
+	//
+	// optional
+	Photo []PhotoSize `json:"photo,omitempty"`
+	// Sticker message is a sticker, information about the sticker
+	//
+	// optional
+	Sticker *Sticker `json:"sticker,omitempty"`
+	// Story message is a forwarded story
+	//
+	// optional
+	Story *Story `json:"story,omitempty"`
+	// Video message is a video, information about the video
+	//
+	// optional
+	Video *Video `json:"video,omitempty"`
+	// VideoNote message is a video note, information about the video message
+	//
+	// optional
+	VideoNote *VideoNote `json:"video_note,omitempty"`
+	// Voice message is a voice message, information about the file
+	//
+	// optional
+	Voice *Voice `json:"voice,omitempty"`
+	// HasMediaSpoiler is true, if the message media is covered
+	// by a spoiler animation
+	//
+	// optional
+	HasMediaSpoiler bool `json:"has_media_spoiler,omitempty"`
+	// Checklist message is a checklist
+	//
+	// optional
+	Checklist *Checklist `json:"checklist,omitempty"`
+	// Contact message is a shared contact, information about the contact
+	//
+	// optional
+	Contact *Contact `json:"contact,omitempty"`
+	// Dice message is a dice with random value
+	//
+	// optional
+	Dice *Dice `json:"dice,omitempty"`
+	// Game message is a game, information about the game
+	//
+	// optional
+	Game *Game `json:"game,omitempty"`
+	// Giveaway message is a scheduled giveaway, information about the giveaway
+	//
+	// optional
+	Giveaway *Giveaway `json:"giveaway,omitempty"`
+	// GiveawayWinners is a giveaway with public winners was completed
+	//
+	// optional
+	GiveawayWinners *GiveawayWinners `json:"giveaway_winners,omitempty"`
+	// Invoice message is an invoice for a payment, information about the invoice
+	//
+	// optional
+	Invoice *Invoice `json:"invoice,omitempty"`
+	// Location message is a shared location, information about the location
+	//
+	// optional
+	Location *Location `json:"location,omitempty"`
+	// Poll message is a native poll, information about the poll
+	//
+	// optional
+	Poll *Poll `json:"poll,omitempty"`
+	// Venue message is a venue, information about the venue
+	//
+	// optional
+	Venue *Venue `json:"venue,omitempty"`
+}
+
+// ReplyParameters describes reply parameters for the message that is being sent.
+type ReplyParameters struct {
+	// MessageID is the identifier of the message that will be replied to in
+	// the current chat, or in the chat ChatID if it is specified
+	MessageID int `json:"message_id"`
+	// ChatID is the identifier of the chat of the original message, if it is
+	// different from the chat the message is sent to
+	//
+	// optional
+	ChatID int64 `json:"chat_id,omitempty"`
+	// AllowSendingWithoutReply is true, if the message should be sent even
+	// if the message to be replied to is not found
+	//
+	// optional
+	AllowSendingWithoutReply bool `json:"allow_sending_without_reply,omitempty"`
+	// Quote is the quoted part of the message to be replied to; 0-1024
+	// characters after entities parsing. The quote must be an exact substring
+	// of the message to be replied to, including bold, italic, underline,
+	// strikethrough, spoiler, and custom_emoji entities
+	//
+	// optional
+	Quote string `json:"quote,omitempty"`
+	// QuoteParseMode is the mode for parsing entities in the quote
+	//
+	// optional
+	QuoteParseMode string `json:"quote_parse_mode,omitempty"`
+	// QuoteEntities are the special entities that appear in the quote,
+	// which can be specified instead of QuoteParseMode
+	//
+	// optional
+	QuoteEntities []MessageEntity `json:"quote_entities,omitempty"`
+	// QuotePosition is the position of the quote in the original message
+	// in UTF-16 code units
+	//
+	// optional
+	QuotePosition int `json:"quote_position,omitempty"`
+}
+
+// LinkPreviewOptions describes the options used for link preview generation.
+type LinkPreviewOptions struct {
+	// IsDisabled is true, if the link preview is disabled
+	//
+	// optional
+	IsDisabled bool `json:"is_disabled,omitempty"`
+	// URL to use for the link preview. If empty, then the first URL
+	// found in the message text will be used
+	//
+	// optional
+	URL string `json:"url,omitempty"`
+	// PreferSmallMedia is true, if the media in the link preview is supposed
+	// to be shrunk; ignored if the URL isn't explicitly specified or media
+	// size change isn't supported for the preview
+	//
+	// optional
+	PreferSmallMedia bool `json:"prefer_small_media,omitempty"`
+	// PreferLargeMedia is true, if the media in the link preview is supposed
+	// to be enlarged; ignored if the URL isn't explicitly specified or media
+	// size change isn't supported for the preview
+	//
+	// optional
+	PreferLargeMedia bool `json:"prefer_large_media,omitempty"`
+	// ShowAboveText is true, if the link preview must be shown above the
+	// message text; otherwise, the link preview will be shown below the
+	// message text
+	//
+	// optional
+	ShowAboveText bool `json:"show_above_text,omitempty"`
+}
+
+// Story represents a story.
+type Story struct {
+	// Chat that posted the story
+	Chat Chat `json:"chat"`
+	// ID is the unique identifier for the story in the chat
+	ID int `json:"id"`
+}
+
+// MessageAutoDeleteTimerChanged represents a service message about
+// a change in auto-delete timer settings.
+type MessageAutoDeleteTimerChanged struct {
+	// MessageAutoDeleteTime is the new auto-delete time for messages
+	// in the chat, in seconds
+	MessageAutoDeleteTime int `json:"message_auto_delete_time"`
+}
+
+// RefundedPayment contains basic information about a refunded payment.
+type RefundedPayment struct {
+	// Currency is the three-letter ISO 4217 currency code,
+	// or “XTR” for payments in Telegram Stars
+	Currency string `json:"currency"`
+	// TotalAmount is the total refunded price in the smallest units
+	// of the currency
+	TotalAmount int `json:"total_amount"`
+	// InvoicePayload is the bot-specified invoice payload
+	InvoicePayload string `json:"invoice_payload"`
+	// TelegramPaymentChargeID is the Telegram payment identifier
+	TelegramPaymentChargeID string `json:"telegram_payment_charge_id"`
+	// ProviderPaymentChargeID is the provider payment identifier
+	//
+	// optional
+	ProviderPaymentChargeID string `json:"provider_payment_charge_id,omitempty"`
+}
+
+// SharedUser contains information about a user that was shared with the bot
+// using a KeyboardButtonRequestUsers button.
+type SharedUser struct {
+	// UserID is the identifier of the shared user
+	UserID int64 `json:"user_id"`
+	// FirstName of the user, if the name was requested by the bot
+	//
+	// optional
+	FirstName string `json:"first_name,omitempty"`
+	// LastName of the user, if the name was requested by the bot
+	//
+	// optional
+	LastName string `json:"last_name,omitempty"`
+	// UserName of the user, if the username was requested by the bot
+	//
+	// optional
+	UserName string `json:"username,omitempty"`
+	// Photo is the available sizes of the chat photo,
+	// if the photo was requested by the bot
+	//
+	// optional
+	Photo []PhotoSize `json:"photo,omitempty"`
+}
+
+// UsersShared contains information about the users whose identifiers
+// were shared with the bot using a KeyboardButtonRequestUsers button.
+type UsersShared struct {
+	// RequestID is the identifier of the request
+	RequestID int `json:"request_id"`
+	// Users are the users shared with the bot
+	Users []SharedUser `json:"users"`
+}
+
+// ChatShared contains information about a chat that was shared with the bot
+// using a KeyboardButtonRequestChat button.
+type ChatShared struct {
+	// RequestID is the identifier of the request
+	RequestID int `json:"request_id"`
+	// ChatID is the identifier of the shared chat
+	ChatID int64 `json:"chat_id"`
+	// Title of the chat, if the title was requested by the bot
+	//
+	// optional
+	Title string `json:"title,omitempty"`
+	// UserName of the chat, if the username was requested by the bot
+	//
+	// optional
+	UserName string `json:"username,omitempty"`
+	// Photo is the available sizes of the chat photo,
+	// if the photo was requested by the bot
+	//
+	// optional
+	Photo []PhotoSize `json:"photo,omitempty"`
+}
+
+// WriteAccessAllowed represents a service message about a user allowing
+// a bot to write messages.
+type WriteAccessAllowed struct {
+	// FromRequest is true, if the access was granted after the user accepted
+	// an explicit request from a Web App
+	//
+	// optional
+	FromRequest bool `json:"from_request,omitempty"`
+	// WebAppName is the name of the Web App, if the access was granted
+	// when the Web App was launched from a link
+	//
+	// optional
+	WebAppName string `json:"web_app_name,omitempty"`
+	// FromAttachmentMenu is true, if the access was granted when the bot
+	// was added to the attachment or side menu
+	//
+	// optional
+	FromAttachmentMenu bool `json:"from_attachment_menu,omitempty"`
+}
+
+// ChatBoostAdded represents a service message about a user boosting a chat.
+type ChatBoostAdded struct {
+	// BoostCount is the number of boosts added by the user
+	BoostCount int `json:"boost_count"`
+}
+
+// GiveawayCreated represents a service message about the creation
+// of a scheduled giveaway.
+type GiveawayCreated struct {
+	// PrizeStarCount is the number of stars to be split between giveaway
+	// winners, for giveaways of stars only
+	//
+	// optional
+	PrizeStarCount int `json:"prize_star_count,omitempty"`
+}
+
+// Giveaway represents a message about a scheduled giveaway.
+type Giveaway struct {
+	// Chats the user must join to participate in the giveaway
+	Chats []Chat `json:"chats"`
+	// WinnersSelectionDate is the point in time (Unix timestamp)
+	// when the winners of the giveaway will be selected
+	WinnersSelectionDate int `json:"winners_selection_date"`
+	// WinnerCount is the number of users which are supposed to be selected
+	// as winners of the giveaway
+	WinnerCount int `json:"winner_count"`
+	// OnlyNewMembers is true, if only users who join the chats after
+	// the giveaway started should be eligible to win
+	//
+	// optional
+	OnlyNewMembers bool `json:"only_new_members,omitempty"`
+	// HasPublicWinners is true, if the list of giveaway winners
+	// will be visible to everyone
+	//
+	// optional
+	HasPublicWinners bool `json:"has_public_winners,omitempty"`
+	// PrizeDescription is the description of additional giveaway prize
+	//
+	// optional
+	PrizeDescription string `json:"prize_description,omitempty"`
+	// CountryCodes is the list of two-letter ISO 3166-1 alpha-2 country codes
+	// of the countries from which eligible users for the giveaway must come
+	//
+	// optional
+	CountryCodes []string `json:"country_codes,omitempty"`
+	// PrizeStarCount is the number of stars to be split between giveaway
+	// winners, for giveaways of stars only
+	//
+	// optional
+	PrizeStarCount int `json:"prize_star_count,omitempty"`
+	// PremiumSubscriptionMonthCount is the number of months the Telegram
+	// Premium subscription won from the giveaway will be active for,
+	// for Telegram Premium giveaways only
+	//
+	// optional
+	PremiumSubscriptionMonthCount int `json:"premium_subscription_month_count,omitempty"`
+}
+
+// GiveawayWinners represents a message about the completion of a giveaway
+// with public winners.
+type GiveawayWinners struct {
+	// Chat that created the giveaway
+	Chat Chat `json:"chat"`
+	// GiveawayMessageID is the identifier of the message with the giveaway
+	// in the chat
+	GiveawayMessageID int `json:"giveaway_message_id"`
+	// WinnersSelectionDate is the point in time (Unix timestamp)
+	// when the winners of the giveaway were selected
+	WinnersSelectionDate int `json:"winners_selection_date"`
+	// WinnerCount is the total number of winners in the giveaway
+	WinnerCount int `json:"winner_count"`
+	// Winners is the list of up to 100 winners of the giveaway
+	Winners []User `json:"winners"`
+	// AdditionalChatCount is the number of other chats the user had to join
+	// in order to be eligible for the giveaway
+	//
+	// optional
+	AdditionalChatCount int `json:"additional_chat_count,omitempty"`
+	// PrizeStarCount is the number of stars that were split between
+	// giveaway winners, for giveaways of stars only
+	//
+	// optional
+	PrizeStarCount int `json:"prize_star_count,omitempty"`
+	// PremiumSubscriptionMonthCount is the number of months the Telegram
+	// Premium subscription won from the giveaway will be active for,
+	// for Telegram Premium giveaways only
+	//
+	// optional
+	PremiumSubscriptionMonthCount int `json:"premium_subscription_month_count,omitempty"`
+	// UnclaimedPrizeCount is the number of undistributed prizes
+	//
+	// optional
+	UnclaimedPrizeCount int `json:"unclaimed_prize_count,omitempty"`
+	// OnlyNewMembers is true, if only users who had joined the chats after
+	// the giveaway started were eligible to win
+	//
+	// optional
+	OnlyNewMembers bool `json:"only_new_members,omitempty"`
+	// WasRefunded is true, if the giveaway was canceled because
+	// the payment for it was refunded
+	//
+	// optional
+	WasRefunded bool `json:"was_refunded,omitempty"`
+	// PrizeDescription is the description of additional giveaway prize
+	//
+	// optional
+	PrizeDescription string `json:"prize_description,omitempty"`
+}
+
+// GiveawayCompleted represents a service message about the completion
+// of a giveaway without public winners.
+type GiveawayCompleted struct {
+	// WinnerCount is the number of winners in the giveaway
+	WinnerCount int `json:"winner_count"`
+	// UnclaimedPrizeCount is the number of undistributed prizes
+	//
+	// optional
+	UnclaimedPrizeCount int `json:"unclaimed_prize_count,omitempty"`
+	// GiveawayMessage is the message with the giveaway that was completed,
+	// if it wasn't deleted
+	//
+	// optional
+	GiveawayMessage *Message `json:"giveaway_message,omitempty"`
+	// IsStarGiveaway is true, if the giveaway was a giveaway of stars
+	//
+	// optional
+	IsStarGiveaway bool `json:"is_star_giveaway,omitempty"`
+}
+
+// VideoChatScheduled represents a service message about a video chat
+// scheduled in the chat.
+type VideoChatScheduled struct {
+	// StartDate is the point in time (Unix timestamp) when the video chat
+	// is supposed to be started by a chat administrator
+	StartDate int `json:"start_date"`
+}
+
+// VideoChatStarted represents a service message about a video chat started
+// in the chat. Currently holds no information.
+type VideoChatStarted struct{}
+
+// VideoChatEnded represents a service message about a video chat ended
+// in the chat.
+type VideoChatEnded struct {
+	// Duration of the video chat in seconds
+	Duration int `json:"duration"`
+}
+
+// VideoChatParticipantsInvited represents a service message about new
+// members invited to a video chat.
+type VideoChatParticipantsInvited struct {
+	// Users that were invited to the video chat
+	Users []User `json:"users"`
 }
 
 // Venue contains information about a venue, including its Location.
@@ -810,6 +2025,125 @@ type Venue struct {
 	//
 	// optional
 	FoursquareID string `json:"foursquare_id"`
+	// FoursquareType foursquare type of the venue
+	//
+	// optional
+	FoursquareType string `json:"foursquare_type"`
+	// GooglePlaceID Google Places identifier of the venue
+	//
+	// optional
+	GooglePlaceID string `json:"google_place_id"`
+	// GooglePlaceType Google Places type of the venue
+	//
+	// optional
+	GooglePlaceType string `json:"google_place_type"`
+}
+
+// Dice represents an animated emoji that displays a random value.
+type Dice struct {
+	// Emoji on which the dice throw animation is based
+	Emoji string `json:"emoji"`
+	// Value of the dice, 1-6 for “🎲”, “🎯” and “🎳”,
+	// 1-5 for “🏀” and “⚽”, and 1-64 for “🎰”
+	Value int `json:"value"`
+}
+
+// PollOption contains information about one answer option in a poll.
+type PollOption struct {
+	// Text is the option text, 1-100 characters
+	Text string `json:"text"`
+	// TextEntities are the special entities that appear in the option text.
+	// Currently, only custom emoji entities are allowed in poll option texts;
+	//
+	// optional
+	TextEntities []MessageEntity `json:"text_entities,omitempty"`
+	// VoterCount is the number of users that voted for this option
+	VoterCount int `json:"voter_count"`
+}
+
+// InputPollOption contains information about one answer option
+// in a poll to send.
+type InputPollOption struct {
+	// Text is the option text, 1-100 characters
+	Text string `json:"text"`
+	// TextParseMode is the mode for parsing entities in the text;
+	//
+	// optional
+	TextParseMode string `json:"text_parse_mode,omitempty"`
+	// TextEntities are the special entities that appear in the option text,
+	// which can be specified instead of TextParseMode;
+	//
+	// optional
+	TextEntities []MessageEntity `json:"text_entities,omitempty"`
+}
+
+// PollAnswer represents an answer of a user in a non-anonymous poll.
+type PollAnswer struct {
+	// PollID is the unique poll identifier
+	PollID string `json:"poll_id"`
+	// VoterChat is the chat that changed the answer to the poll,
+	// if the voter is anonymous;
+	//
+	// optional
+	VoterChat *Chat `json:"voter_chat,omitempty"`
+	// User that changed the answer to the poll, if the voter isn't anonymous;
+	//
+	// optional
+	User *User `json:"user,omitempty"`
+	// OptionIDs are the 0-based identifiers of the chosen answer options.
+	// May be empty if the vote was retracted
+	OptionIDs []int `json:"option_ids"`
+}
+
+// Poll contains information about a poll.
+type Poll struct {
+	// ID is the unique poll identifier
+	ID string `json:"id"`
+	// Question is the poll question, 1-300 characters
+	Question string `json:"question"`
+	// QuestionEntities are the special entities that appear in the question.
+	// Currently, only custom emoji entities are allowed in poll questions;
+	//
+	// optional
+	QuestionEntities []MessageEntity `json:"question_entities,omitempty"`
+	// Options is the list of poll options
+	Options []PollOption `json:"options"`
+	// TotalVoterCount is the total number of users that voted in the poll
+	TotalVoterCount int `json:"total_voter_count"`
+	// IsClosed is if the poll is closed
+	IsClosed bool `json:"is_closed"`
+	// IsAnonymous is if the poll is anonymous
+	IsAnonymous bool `json:"is_anonymous"`
+	// Type is the poll type, PollTypeRegular or PollTypeQuiz
+	Type string `json:"type"`
+	// AllowsMultipleAnswers is true, if the poll allows multiple answers
+	AllowsMultipleAnswers bool `json:"allows_multiple_answers"`
+	// CorrectOptionID is the 0-based identifier of the correct answer option.
+	// Available only for polls in quiz mode, which are closed,
+	// or was sent (not forwarded) by the bot or to the private chat with the bot;
+	//
+	// optional
+	CorrectOptionID *int `json:"correct_option_id,omitempty"`
+	// Explanation is the text that is shown when a user chooses an incorrect
+	// answer or taps on the lamp icon in a quiz-style poll, 0-200 characters;
+	//
+	// optional
+	Explanation string `json:"explanation,omitempty"`
+	// ExplanationEntities are the special entities like usernames, URLs,
+	// bot commands, etc. that appear in the explanation;
+	//
+	// optional
+	ExplanationEntities []MessageEntity `json:"explanation_entities,omitempty"`
+	// OpenPeriod is the amount of time in seconds the poll will be active
+	// after creation;
+	//
+	// optional
+	OpenPeriod int `json:"open_period,omitempty"`
+	// CloseDate is the point in time (Unix timestamp) when the poll
+	// will be automatically closed;
+	//
+	// optional
+	CloseDate int `json:"close_date,omitempty"`
 }
 
 // UserProfilePhotos contains a set of user profile photos.
@@ -837,7 +2171,13 @@ type File struct {
 // Link returns a full path to the download URL for a File.
 //
 // It requires the Bot Token to create the link.
+// A local Bot API server returns absolute paths of files on its disk,
+// which are turned into file:// URLs.
 func (f *File) Link(token string) string {
+	if path.IsAbs(f.FilePath) {
+		return (&url.URL{Scheme: "file", Path: f.FilePath}).String()
+	}
+
 	return fmt.Sprintf(FileEndpoint, token, f.FilePath)
 }
 
@@ -871,6 +2211,17 @@ type ReplyKeyboardMarkup struct {
 	//
 	// optional
 	Selective bool `json:"selective"`
+	// IsPersistent requests clients to always show the keyboard when the
+	// regular keyboard is hidden. Defaults to false, in which case the custom
+	// keyboard can be hidden and opened with a keyboard icon.
+	//
+	// optional
+	IsPersistent bool `json:"is_persistent,omitempty"`
+	// InputFieldPlaceholder is the placeholder to be shown in the input field
+	// when the keyboard is active; 1-64 characters.
+	//
+	// optional
+	InputFieldPlaceholder string `json:"input_field_placeholder,omitempty"`
 }
 
 // KeyboardButton is a button within a custom keyboard.
@@ -889,9 +2240,117 @@ type KeyboardButton struct {
 	//
 	// optional
 	RequestLocation bool `json:"request_location"`
+	// WebApp if specified, the described Web App will be launched when the
+	// button is pressed. The Web App will be able to send a “web_app_data”
+	// service message. Available in private chats only.
+	//
+	// optional
+	WebApp *WebAppInfo `json:"web_app,omitempty"`
+	// RequestUsers if specified, pressing the button will open a list of
+	// suitable users. Identifiers of selected users will be sent to the bot
+	// in a “users_shared” service message. Available in private chats only.
+	//
+	// optional
+	RequestUsers *KeyboardButtonRequestUsers `json:"request_users,omitempty"`
+	// RequestChat if specified, pressing the button will open a list of
+	// suitable chats. Tapping on a chat will send its identifier to the bot
+	// in a “chat_shared” service message. Available in private chats only.
+	//
+	// optional
+	RequestChat *KeyboardButtonRequestChat `json:"request_chat,omitempty"`
 }
 
-// ReplyKeyboardHide allows the Bot to hide a custom keyboard.
+// KeyboardButtonRequestUsers defines the criteria used to request suitable
+// users. Information about the selected users will be shared with the bot
+// when the corresponding button is pressed.
+type KeyboardButtonRequestUsers struct {
+	// RequestID is the signed 32-bit identifier of the request that will be
+	// received back in the UsersShared object. Must be unique within the message
+	RequestID int `json:"request_id"`
+	// UserIsBot requests bots if true, or regular users if false.
+	// No additional restrictions are applied if it is nil
+	//
+	// optional
+	UserIsBot *bool `json:"user_is_bot,omitempty"`
+	// UserIsPremium requests premium users if true, or non-premium users
+	// if false. No additional restrictions are applied if it is nil
+	//
+	// optional
+	UserIsPremium *bool `json:"user_is_premium,omitempty"`
+	// MaxQuantity is the maximum number of users to be selected; 1-10.
+	// Defaults to 1
+	//
+	// optional
+	MaxQuantity int `json:"max_quantity,omitempty"`
+	// RequestName requests the users' first and last names
+	//
+	// optional
+	RequestName bool `json:"request_name,omitempty"`
+	// RequestUsername requests the users' usernames
+	//
+	// optional
+	RequestUsername bool `json:"request_username,omitempty"`
+	// RequestPhoto requests the users' photos
+	//
+	// optional
+	RequestPhoto bool `json:"request_photo,omitempty"`
+}
+
+// KeyboardButtonRequestChat defines the criteria used to request a suitable
+// chat. Information about the selected chat will be shared with the bot
+// when the corresponding button is pressed. The bot will be granted
+// the requested rights in the chat if appropriate.
+type KeyboardButtonRequestChat struct {
+	// RequestID is the signed 32-bit identifier of the request, which will be
+	// received back in the ChatShared object. Must be unique within the message
+	RequestID int `json:"request_id"`
+	// ChatIsChannel requests a channel chat if true,
+	// or a group or a supergroup chat if false
+	ChatIsChannel bool `json:"chat_is_channel"`
+	// ChatIsForum requests a forum supergroup if true, or a non-forum chat
+	// if false. No additional restrictions are applied if it is nil
+	//
+	// optional
+	ChatIsForum *bool `json:"chat_is_forum,omitempty"`
+	// ChatHasUsername requests a supergroup or a channel with a username
+	// if true, or without one if false. No additional restrictions are
+	// applied if it is nil
+	//
+	// optional
+	ChatHasUsername *bool `json:"chat_has_username,omitempty"`
+	// ChatIsCreated requests a chat owned by the user
+	//
+	// optional
+	ChatIsCreated bool `json:"chat_is_created,omitempty"`
+	// UserAdministratorRights lists the required administrator rights
+	// of the user in the chat
+	//
+	// optional
+	UserAdministratorRights *ChatAdministratorRights `json:"user_administrator_rights,omitempty"`
+	// BotAdministratorRights lists the required administrator rights
+	// of the bot in the chat
+	//
+	// optional
+	BotAdministratorRights *ChatAdministratorRights `json:"bot_administrator_rights,omitempty"`
+	// BotIsMember requests a chat with the bot as a member
+	//
+	// optional
+	BotIsMember bool `json:"bot_is_member,omitempty"`
+	// RequestTitle requests the chat's title
+	//
+	// optional
+	RequestTitle bool `json:"request_title,omitempty"`
+	// RequestUsername requests the chat's username
+	//
+	// optional
+	RequestUsername bool `json:"request_username,omitempty"`
+	// RequestPhoto requests the chat's photo
+	//
+	// optional
+	RequestPhoto bool `json:"request_photo,omitempty"`
+}
+
+// ReplyKeyboardHide allows the Bot to hide a custom keyboard.
 type ReplyKeyboardHide struct {
 	HideKeyboard bool `json:"hide_keyboard"`
 	Selective    bool `json:"selective"` // optional
@@ -972,6 +2431,126 @@ type InlineKeyboardButton struct {
 	//
 	// optional
 	Pay bool `json:"pay,omitempty"`
+	// WebApp description of the Web App that will be launched when the user
+	// presses the button. The Web App will be able to send an arbitrary
+	// message on behalf of the user using the method AnswerWebAppQuery.
+	// Available only in private chats between a user and the bot.
+	//
+	// optional
+	WebApp *WebAppInfo `json:"web_app,omitempty"`
+	// LoginURL is an HTTPS URL used to automatically authorize the user.
+	// Can be used as a replacement for the Telegram Login Widget.
+	//
+	// optional
+	LoginURL *LoginURL `json:"login_url,omitempty"`
+	// SwitchInlineQueryChosenChat if set, pressing the button will prompt
+	// the user to select one of their chats of the specified type, open that
+	// chat and insert the bot's username and the specified inline query
+	// in the input field.
+	//
+	// optional
+	SwitchInlineQueryChosenChat *SwitchInlineQueryChosenChat `json:"switch_inline_query_chosen_chat,omitempty"`
+	// CopyText description of the button that copies the specified text
+	// to the clipboard.
+	//
+	// optional
+	CopyText *CopyTextButton `json:"copy_text,omitempty"`
+}
+
+// Validate checks that the button has exactly one action,
+// such as URL or CallbackData.
+func (button InlineKeyboardButton) Validate() error {
+	actions := 0
+	for _, set := range []bool{
+		button.URL != nil,
+		button.CallbackData != nil,
+		button.WebApp != nil,
+		button.LoginURL != nil,
+		button.SwitchInlineQuery != nil,
+		button.SwitchInlineQueryCurrentChat != nil,
+		button.SwitchInlineQueryChosenChat != nil,
+		button.CopyText != nil,
+		button.CallbackGame != nil,
+		button.Pay,
+	} {
+		if set {
+			actions++
+		}
+	}
+
+	if actions != 1 {
+		return errors.New(ErrBadInlineButton)
+	}
+	return nil
+}
+
+// Validate checks that every button of the keyboard has exactly one action.
+func (markup InlineKeyboardMarkup) Validate() error {
+	for _, row := range markup.InlineKeyboard {
+		for _, button := range row {
+			if err := button.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// LoginURL represents a parameter of the inline keyboard button used to
+// automatically authorize a user. The data of the user is added to the URL
+// as with the Telegram Login Widget, see ValidateLoginWidgetData.
+type LoginURL struct {
+	// URL is an HTTPS URL to be opened with user authorization data added to
+	// the query string when the button is pressed
+	URL string `json:"url"`
+	// ForwardText is the new text of the button in forwarded messages
+	//
+	// optional
+	ForwardText string `json:"forward_text,omitempty"`
+	// BotUsername is the username of a bot, which will be used for user
+	// authorization. Defaults to the current bot
+	//
+	// optional
+	BotUsername string `json:"bot_username,omitempty"`
+	// RequestWriteAccess requests the permission for the bot
+	// to send messages to the user
+	//
+	// optional
+	RequestWriteAccess bool `json:"request_write_access,omitempty"`
+}
+
+// SwitchInlineQueryChosenChat represents an inline button that switches
+// the current user to inline mode in a chosen chat, with an optional
+// default inline query.
+type SwitchInlineQueryChosenChat struct {
+	// Query is the default inline query to be inserted in the input field.
+	// If left empty, only the bot's username will be inserted
+	//
+	// optional
+	Query string `json:"query,omitempty"`
+	// AllowUserChats is true, if private chats with users can be chosen
+	//
+	// optional
+	AllowUserChats bool `json:"allow_user_chats,omitempty"`
+	// AllowBotChats is true, if private chats with bots can be chosen
+	//
+	// optional
+	AllowBotChats bool `json:"allow_bot_chats,omitempty"`
+	// AllowGroupChats is true, if group and supergroup chats can be chosen
+	//
+	// optional
+	AllowGroupChats bool `json:"allow_group_chats,omitempty"`
+	// AllowChannelChats is true, if channel chats can be chosen
+	//
+	// optional
+	AllowChannelChats bool `json:"allow_channel_chats,omitempty"`
+}
+
+// CopyTextButton represents an inline keyboard button that copies
+// specified text to the clipboard.
+type CopyTextButton struct {
+	// Text to be copied to the clipboard; 1-256 characters
+	Text string `json:"text"`
 }
 
 // CallbackQuery is data sent when a keyboard button with callback data
@@ -1019,6 +2598,11 @@ type ForceReply struct {
 	//
 	// optional
 	Selective bool `json:"selective"`
+	// InputFieldPlaceholder is the placeholder to be shown in the input field
+	// when the reply is active; 1-64 characters.
+	//
+	// optional
+	InputFieldPlaceholder string `json:"input_field_placeholder,omitempty"`
 }
 
 // ChatMember is information about a member in a chat.
@@ -1112,6 +2696,244 @@ type ChatMember struct {
 	//
 	// optional
 	CanAddWebPagePreviews bool `json:"can_add_web_page_previews,omitempty"`
+	// IsAnonymous owner and administrators only.
+	// True, if the user's presence in the chat is hidden.
+	//
+	// optional
+	IsAnonymous bool `json:"is_anonymous,omitempty"`
+	// CanManageChat administrators only.
+	// True, if the administrator can access the chat event log, get boost
+	// list, see hidden supergroup and channel members, report spam messages
+	// and ignore slow mode.
+	//
+	// optional
+	CanManageChat bool `json:"can_manage_chat,omitempty"`
+	// CanManageVideoChats administrators only.
+	// True, if the administrator can manage video chats.
+	//
+	// optional
+	CanManageVideoChats bool `json:"can_manage_video_chats,omitempty"`
+	// CanPostStories administrators only.
+	// True, if the administrator can post stories to the chat.
+	//
+	// optional
+	CanPostStories bool `json:"can_post_stories,omitempty"`
+	// CanEditStories administrators only.
+	// True, if the administrator can edit stories posted by other users.
+	//
+	// optional
+	CanEditStories bool `json:"can_edit_stories,omitempty"`
+	// CanDeleteStories administrators only.
+	// True, if the administrator can delete stories posted by other users.
+	//
+	// optional
+	CanDeleteStories bool `json:"can_delete_stories,omitempty"`
+	// CanManageTopics administrators and restricted only.
+	// True, if the user is allowed to create, rename, close,
+	// and reopen forum topics; supergroups only.
+	//
+	// optional
+	CanManageTopics bool `json:"can_manage_topics,omitempty"`
+	// IsChatMember restricted only.
+	// True, if the user is a member of the chat at the moment of the request.
+	//
+	// optional
+	IsChatMember bool `json:"is_member,omitempty"`
+	// CanSendAudios restricted only.
+	// True, if the user is allowed to send audios.
+	//
+	// optional
+	CanSendAudios bool `json:"can_send_audios,omitempty"`
+	// CanSendDocuments restricted only.
+	// True, if the user is allowed to send documents.
+	//
+	// optional
+	CanSendDocuments bool `json:"can_send_documents,omitempty"`
+	// CanSendPhotos restricted only.
+	// True, if the user is allowed to send photos.
+	//
+	// optional
+	CanSendPhotos bool `json:"can_send_photos,omitempty"`
+	// CanSendVideos restricted only.
+	// True, if the user is allowed to send videos.
+	//
+	// optional
+	CanSendVideos bool `json:"can_send_videos,omitempty"`
+	// CanSendVideoNotes restricted only.
+	// True, if the user is allowed to send video notes.
+	//
+	// optional
+	CanSendVideoNotes bool `json:"can_send_video_notes,omitempty"`
+	// CanSendVoiceNotes restricted only.
+	// True, if the user is allowed to send voice notes.
+	//
+	// optional
+	CanSendVoiceNotes bool `json:"can_send_voice_notes,omitempty"`
+	// CanSendPolls restricted only.
+	// True, if the user is allowed to send polls.
+	//
+	// optional
+	CanSendPolls bool `json:"can_send_polls,omitempty"`
+}
+
+// ChatMemberOwner is a chat member that owns the chat and has all
+// administrator privileges.
+type ChatMemberOwner struct {
+	User        *User
+	IsAnonymous bool
+	CustomTitle string
+}
+
+// ChatMemberAdministrator is a chat member that has some additional
+// privileges.
+type ChatMemberAdministrator struct {
+	User *User
+	// CanBeEdited is true, if the bot is allowed to edit
+	// the privileges of the administrator.
+	CanBeEdited bool
+	CustomTitle string
+	ChatAdministratorRights
+}
+
+// ChatMemberMember is a chat member that has no additional privileges
+// or restrictions.
+type ChatMemberMember struct {
+	User *User
+	// UntilDate is when the subscription of the member will expire,
+	// as a Unix timestamp, or 0.
+	UntilDate int64
+}
+
+// ChatMemberRestricted is a chat member that is under certain restrictions
+// in the chat. Supergroups only.
+type ChatMemberRestricted struct {
+	User *User
+	// IsMember is true, if the user is a member of the chat
+	// at the moment of the request.
+	IsMember bool
+	// UntilDate is when the restrictions will be lifted, as a Unix
+	// timestamp. The user is restricted forever if it is 0.
+	UntilDate int64
+	ChatPermissions
+}
+
+// ChatMemberLeft is a chat member that isn't currently a member of the chat,
+// but may join it themselves.
+type ChatMemberLeft struct {
+	User *User
+}
+
+// ChatMemberBanned is a chat member that was banned in the chat and can't
+// return to the chat or view chat messages.
+type ChatMemberBanned struct {
+	User *User
+	// UntilDate is when the user will be unbanned, as a Unix timestamp.
+	// The user is banned forever if it is 0.
+	UntilDate int64
+}
+
+// Owner returns the ChatMember as the owner of the chat,
+// if it is the creator.
+func (chat ChatMember) Owner() (ChatMemberOwner, bool) {
+	if !chat.IsCreator() {
+		return ChatMemberOwner{}, false
+	}
+
+	return ChatMemberOwner{
+		User:        chat.User,
+		IsAnonymous: chat.IsAnonymous,
+		CustomTitle: chat.CustomTitle,
+	}, true
+}
+
+// Administrator returns the ChatMember as an administrator,
+// if it is one.
+func (chat ChatMember) Administrator() (ChatMemberAdministrator, bool) {
+	if !chat.IsAdministrator() {
+		return ChatMemberAdministrator{}, false
+	}
+
+	return ChatMemberAdministrator{
+		User:        chat.User,
+		CanBeEdited: chat.CanBeEdited,
+		CustomTitle: chat.CustomTitle,
+		ChatAdministratorRights: ChatAdministratorRights{
+			IsAnonymous:         chat.IsAnonymous,
+			CanManageChat:       chat.CanManageChat,
+			CanDeleteMessages:   chat.CanDeleteMessages,
+			CanManageVideoChats: chat.CanManageVideoChats,
+			CanRestrictMembers:  chat.CanRestrictMembers,
+			CanPromoteMembers:   chat.CanPromoteMembers,
+			CanChangeInfo:       chat.CanChangeInfo,
+			CanInviteUsers:      chat.CanInviteUsers,
+			CanPostStories:      chat.CanPostStories,
+			CanEditStories:      chat.CanEditStories,
+			CanDeleteStories:    chat.CanDeleteStories,
+			CanPostMessages:     chat.CanPostMessages,
+			CanEditMessages:     chat.CanEditMessages,
+			CanPinMessages:      chat.CanPinMessages,
+			CanManageTopics:     chat.CanManageTopics,
+		},
+	}, true
+}
+
+// Member returns the ChatMember as a member without privileges
+// or restrictions, if it is one.
+func (chat ChatMember) Member() (ChatMemberMember, bool) {
+	if !chat.IsMember() {
+		return ChatMemberMember{}, false
+	}
+
+	return ChatMemberMember{User: chat.User, UntilDate: chat.UntilDate}, true
+}
+
+// Restricted returns the ChatMember as a restricted member,
+// if it is one.
+func (chat ChatMember) Restricted() (ChatMemberRestricted, bool) {
+	if !chat.IsRestricted() {
+		return ChatMemberRestricted{}, false
+	}
+
+	return ChatMemberRestricted{
+		User:      chat.User,
+		IsMember:  chat.IsChatMember,
+		UntilDate: chat.UntilDate,
+		ChatPermissions: ChatPermissions{
+			CanSendMessages:       chat.CanSendMessages,
+			CanSendAudios:         chat.CanSendAudios,
+			CanSendDocuments:      chat.CanSendDocuments,
+			CanSendPhotos:         chat.CanSendPhotos,
+			CanSendVideos:         chat.CanSendVideos,
+			CanSendVideoNotes:     chat.CanSendVideoNotes,
+			CanSendVoiceNotes:     chat.CanSendVoiceNotes,
+			CanSendPolls:          chat.CanSendPolls,
+			CanSendOtherMessages:  chat.CanSendOtherMessages,
+			CanAddWebPagePreviews: chat.CanAddWebPagePreviews,
+			CanChangeInfo:         chat.CanChangeInfo,
+			CanInviteUsers:        chat.CanInviteUsers,
+			CanPinMessages:        chat.CanPinMessages,
+			CanManageTopics:       chat.CanManageTopics,
+		},
+	}, true
+}
+
+// Left returns the ChatMember as a user who isn't a member of the chat,
+// if they left it.
+func (chat ChatMember) Left() (ChatMemberLeft, bool) {
+	if !chat.HasLeft() {
+		return ChatMemberLeft{}, false
+	}
+
+	return ChatMemberLeft{User: chat.User}, true
+}
+
+// Banned returns the ChatMember as a banned user, if they were kicked.
+func (chat ChatMember) Banned() (ChatMemberBanned, bool) {
+	if !chat.WasKicked() {
+		return ChatMemberBanned{}, false
+	}
+
+	return ChatMemberBanned{User: chat.User, UntilDate: chat.UntilDate}, true
 }
 
 // IsCreator returns if the ChatMember was the creator of the chat.
@@ -1123,6 +2945,9 @@ func (chat ChatMember) IsAdministrator() bool { return chat.Status == "administr
 // IsMember returns if the ChatMember is a current member of the chat.
 func (chat ChatMember) IsMember() bool { return chat.Status == "member" }
 
+// IsRestricted returns if the ChatMember is restricted in the chat.
+func (chat ChatMember) IsRestricted() bool { return chat.Status == "restricted" }
+
 // HasLeft returns if the ChatMember left the chat.
 func (chat ChatMember) HasLeft() bool { return chat.Status == "left" }
 
@@ -1226,9 +3051,13 @@ type InputMediaPhoto struct {
 	// Media file to send. Pass a file_id to send a file that
 	// exists on the Telegram servers (recommended),
 	// pass an HTTP URL for Telegram to get a file from the Internet,
-	// or pass “attach://<file_attach_name>” to upload a new one
-	// using multipart/form-data under <file_attach_name> name.
+	// or set File to upload a new one.
 	Media string `json:"media"`
+	// File to upload in place of Media, as a file path, FileBytes,
+	// FileReader or RequestFileData.
+	//
+	// optional
+	File interface{} `json:"-"`
 	// Caption of the photo to be sent, 0-1024 characters after entities parsing.
 	//
 	// optional
@@ -1239,20 +3068,46 @@ type InputMediaPhoto struct {
 	//
 	// optional
 	ParseMode string `json:"parse_mode"`
+	// CaptionEntities are special entities that appear in the caption,
+	// which can be specified instead of ParseMode.
+	//
+	// optional
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+	// ShowCaptionAboveMedia shows the caption above the media.
+	//
+	// optional
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media,omitempty"`
+	// HasSpoiler covers the photo with a spoiler animation.
+	//
+	// optional
+	HasSpoiler bool `json:"has_spoiler,omitempty"`
+}
+
+// prepare replaces File with an attach:// reference in Media.
+func (media InputMediaPhoto) prepare(prefix string) (interface{}, []RequestFile, error) {
+	files, err := attachFile(&media.Media, prefix, media.File)
+	return media, files, err
 }
 
 // InputMediaVideo contains a video for displaying as part of a media group.
 type InputMediaVideo struct {
 	// Type of the result, must be video.
 	Type string `json:"type"`
-	// Media file to send. Pass a file_id to send a file
-	// that exists on the Telegram servers (recommended),
+	// Media file to send. Pass a file_id to send a file that
+	// exists on the Telegram servers (recommended),
 	// pass an HTTP URL for Telegram to get a file from the Internet,
-	// or pass “attach://<file_attach_name>” to upload a new one
-	// using multipart/form-data under <file_attach_name> name.
+	// or set File to upload a new one.
 	Media string `json:"media"`
-	// thumb intentionally missing as it is not currently compatible
-
+	// File to upload in place of Media, as a file path, FileBytes,
+	// FileReader or RequestFileData.
+	//
+	// optional
+	File interface{} `json:"-"`
+	// Thumbnail of the file, a JPEG under 200 kB and 320x320 pixels.
+	// It is given like File, and always uploaded as a new file.
+	//
+	// optional
+	Thumbnail interface{} `json:"-"`
 	// Caption of the video to be sent, 0-1024 characters after entities parsing.
 	//
 	// optional
@@ -1263,6 +3118,15 @@ type InputMediaVideo struct {
 	//
 	// optional
 	ParseMode string `json:"parse_mode"`
+	// CaptionEntities are special entities that appear in the caption,
+	// which can be specified instead of ParseMode.
+	//
+	// optional
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+	// ShowCaptionAboveMedia shows the caption above the media.
+	//
+	// optional
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media,omitempty"`
 	// Width video width
 	//
 	// optional
@@ -1279,79 +3143,384 @@ type InputMediaVideo struct {
 	//
 	// optional
 	SupportsStreaming bool `json:"supports_streaming"`
+	// HasSpoiler covers the video with a spoiler animation.
+	//
+	// optional
+	HasSpoiler bool `json:"has_spoiler,omitempty"`
 }
 
-// InlineQuery is a Query from Telegram for an inline request.
-type InlineQuery struct {
-	// ID unique identifier for this query
-	ID string `json:"id"`
-	// From sender
-	From *User `json:"from"`
-	// Location sender location, only for bots that request user location.
+// prepare replaces File and Thumbnail with attach:// references.
+func (media InputMediaVideo) prepare(prefix string) (interface{}, []RequestFile, error) {
+	files, err := attachFile(&media.Media, prefix, media.File)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var thumbnail string
+	thumbnailFiles, err := attachFile(&thumbnail, prefix+"-thumbnail", media.Thumbnail)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return struct {
+		InputMediaVideo
+		Thumbnail string `json:"thumbnail,omitempty"`
+	}{media, thumbnail}, append(files, thumbnailFiles...), nil
+}
+
+// InputPaidMediaPhoto contains a paid photo to send.
+type InputPaidMediaPhoto struct {
+	// Type of the media, must be photo.
+	Type string `json:"type"`
+	// Media file to send. Pass a file_id to send a file that
+	// exists on the Telegram servers (recommended),
+	// pass an HTTP URL for Telegram to get a file from the Internet,
+	// or set File to upload a new one.
+	Media string `json:"media"`
+	// File to upload in place of Media, as a file path, FileBytes,
+	// FileReader or RequestFileData.
 	//
 	// optional
-	Location *Location `json:"location"`
-	// Query text of the query (up to 256 characters).
-	Query string `json:"query"`
-	// Offset of the results to be returned, can be controlled by the bot.
-	Offset string `json:"offset"`
+	File interface{} `json:"-"`
 }
 
-// InlineQueryResultArticle is an inline query response article.
-type InlineQueryResultArticle struct {
-	// Type of the result, must be article.
-	//
-	// required
+// prepare replaces File with an attach:// reference in Media.
+func (media InputPaidMediaPhoto) prepare(prefix string) (interface{}, []RequestFile, error) {
+	files, err := attachFile(&media.Media, prefix, media.File)
+	return media, files, err
+}
+
+// InputPaidMediaVideo contains a paid video to send.
+type InputPaidMediaVideo struct {
+	// Type of the media, must be video.
 	Type string `json:"type"`
-	// ID unique identifier for this result, 1-64 Bytes.
+	// Media file to send. Pass a file_id to send a file that
+	// exists on the Telegram servers (recommended),
+	// pass an HTTP URL for Telegram to get a file from the Internet,
+	// or set File to upload a new one.
+	Media string `json:"media"`
+	// File to upload in place of Media, as a file path, FileBytes,
+	// FileReader or RequestFileData.
 	//
-	// required
-	ID string `json:"id"`
-	// Title of the result
+	// optional
+	File interface{} `json:"-"`
+	// Thumbnail of the file, a JPEG under 200 kB and 320x320 pixels.
+	// It is given like File, and always uploaded as a new file.
 	//
-	// required
-	Title string `json:"title"`
-	// InputMessageContent content of the message to be sent.
+	// optional
+	Thumbnail interface{} `json:"-"`
+	// Width video width
 	//
-	// required
-	InputMessageContent interface{} `json:"input_message_content,omitempty"`
-	// ReplyMarkup Inline keyboard attached to the message.
+	// optional
+	Width int `json:"width,omitempty"`
+	// Height video height
 	//
 	// optional
-	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
-	// URL of the result.
+	Height int `json:"height,omitempty"`
+	// Duration video duration
 	//
 	// optional
-	URL string `json:"url"`
-	// HideURL pass True, if you don't want the URL to be shown in the message.
+	Duration int `json:"duration,omitempty"`
+	// SupportsStreaming pass True, if the uploaded video is suitable for streaming.
 	//
 	// optional
-	HideURL bool `json:"hide_url"`
-	// Description short description of the result.
+	SupportsStreaming bool `json:"supports_streaming,omitempty"`
+}
+
+// prepare replaces File and Thumbnail with attach:// references.
+func (media InputPaidMediaVideo) prepare(prefix string) (interface{}, []RequestFile, error) {
+	files, err := attachFile(&media.Media, prefix, media.File)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var thumbnail string
+	thumbnailFiles, err := attachFile(&thumbnail, prefix+"-thumbnail", media.Thumbnail)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return struct {
+		InputPaidMediaVideo
+		Thumbnail string `json:"thumbnail,omitempty"`
+	}{media, thumbnail}, append(files, thumbnailFiles...), nil
+}
+
+// InputMediaAnimation contains an animation, a GIF or an H.264/MPEG-4 AVC
+// video without sound. Animations can't be part of a media group.
+type InputMediaAnimation struct {
+	// Type of the result, must be animation.
+	Type string `json:"type"`
+	// Media file to send. Pass a file_id to send a file that
+	// exists on the Telegram servers (recommended),
+	// pass an HTTP URL for Telegram to get a file from the Internet,
+	// or set File to upload a new one.
+	Media string `json:"media"`
+	// File to upload in place of Media, as a file path, FileBytes,
+	// FileReader or RequestFileData.
 	//
 	// optional
-	Description string `json:"description"`
-	// ThumbURL url of the thumbnail for the result
+	File interface{} `json:"-"`
+	// Thumbnail of the file, a JPEG under 200 kB and 320x320 pixels.
+	// It is given like File, and always uploaded as a new file.
 	//
 	// optional
-	ThumbURL string `json:"thumb_url"`
-	// ThumbWidth thumbnail width
+	Thumbnail interface{} `json:"-"`
+	// Caption of the animation to be sent, 0-1024 characters after entities parsing.
 	//
 	// optional
-	ThumbWidth int `json:"thumb_width"`
-	// ThumbHeight thumbnail height
+	Caption string `json:"caption"`
+	// ParseMode mode for parsing entities in the animation caption.
+	// See formatting options for more details
+	// (https://core.telegram.org/bots/api#formatting-options).
 	//
 	// optional
-	ThumbHeight int `json:"thumb_height"`
-}
-
-// InlineQueryResultPhoto is an inline query response photo.
-type InlineQueryResultPhoto struct {
-	// Type of the result, must be article.
+	ParseMode string `json:"parse_mode"`
+	// CaptionEntities are special entities that appear in the caption,
+	// which can be specified instead of ParseMode.
 	//
-	// required
-	Type string `json:"type"`
-	// ID unique identifier for this result, 1-64 Bytes.
+	// optional
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+	// ShowCaptionAboveMedia shows the caption above the media.
+	//
+	// optional
+	ShowCaptionAboveMedia bool `json:"show_caption_above_media,omitempty"`
+	// Width animation width
+	//
+	// optional
+	Width int `json:"width,omitempty"`
+	// Height animation height
+	//
+	// optional
+	Height int `json:"height,omitempty"`
+	// Duration animation duration
+	//
+	// optional
+	Duration int `json:"duration,omitempty"`
+	// HasSpoiler covers the animation with a spoiler animation.
+	//
+	// optional
+	HasSpoiler bool `json:"has_spoiler,omitempty"`
+}
+
+// prepare replaces File and Thumbnail with attach:// references.
+func (media InputMediaAnimation) prepare(prefix string) (interface{}, []RequestFile, error) {
+	files, err := attachFile(&media.Media, prefix, media.File)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var thumbnail string
+	thumbnailFiles, err := attachFile(&thumbnail, prefix+"-thumbnail", media.Thumbnail)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return struct {
+		InputMediaAnimation
+		Thumbnail string `json:"thumbnail,omitempty"`
+	}{media, thumbnail}, append(files, thumbnailFiles...), nil
+}
+
+// InputMediaAudio contains an audio for displaying as part of a media group.
+type InputMediaAudio struct {
+	// Type of the result, must be audio.
+	Type string `json:"type"`
+	// Media file to send. Pass a file_id to send a file that
+	// exists on the Telegram servers (recommended),
+	// pass an HTTP URL for Telegram to get a file from the Internet,
+	// or set File to upload a new one.
+	Media string `json:"media"`
+	// File to upload in place of Media, as a file path, FileBytes,
+	// FileReader or RequestFileData.
+	//
+	// optional
+	File interface{} `json:"-"`
+	// Thumbnail of the file, a JPEG under 200 kB and 320x320 pixels.
+	// It is given like File, and always uploaded as a new file.
+	//
+	// optional
+	Thumbnail interface{} `json:"-"`
+	// Caption of the audio to be sent, 0-1024 characters after entities parsing.
+	//
+	// optional
+	Caption string `json:"caption"`
+	// ParseMode mode for parsing entities in the audio caption.
+	// See formatting options for more details
+	// (https://core.telegram.org/bots/api#formatting-options).
+	//
+	// optional
+	ParseMode string `json:"parse_mode"`
+	// CaptionEntities are special entities that appear in the caption,
+	// which can be specified instead of ParseMode.
+	//
+	// optional
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+	// Duration of the audio in seconds
+	//
+	// optional
+	Duration int `json:"duration"`
+	// Performer of the audio
+	//
+	// optional
+	Performer string `json:"performer"`
+	// Title of the audio
+	//
+	// optional
+	Title string `json:"title"`
+}
+
+// prepare replaces File and Thumbnail with attach:// references.
+func (media InputMediaAudio) prepare(prefix string) (interface{}, []RequestFile, error) {
+	files, err := attachFile(&media.Media, prefix, media.File)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var thumbnail string
+	thumbnailFiles, err := attachFile(&thumbnail, prefix+"-thumbnail", media.Thumbnail)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return struct {
+		InputMediaAudio
+		Thumbnail string `json:"thumbnail,omitempty"`
+	}{media, thumbnail}, append(files, thumbnailFiles...), nil
+}
+
+// InputMediaDocument contains a document for displaying as part of a media group.
+type InputMediaDocument struct {
+	// Type of the result, must be document.
+	Type string `json:"type"`
+	// Media file to send. Pass a file_id to send a file that
+	// exists on the Telegram servers (recommended),
+	// pass an HTTP URL for Telegram to get a file from the Internet,
+	// or set File to upload a new one.
+	Media string `json:"media"`
+	// File to upload in place of Media, as a file path, FileBytes,
+	// FileReader or RequestFileData.
+	//
+	// optional
+	File interface{} `json:"-"`
+	// Thumbnail of the file, a JPEG under 200 kB and 320x320 pixels.
+	// It is given like File, and always uploaded as a new file.
+	//
+	// optional
+	Thumbnail interface{} `json:"-"`
+	// Caption of the document to be sent, 0-1024 characters after entities parsing.
+	//
+	// optional
+	Caption string `json:"caption"`
+	// ParseMode mode for parsing entities in the document caption.
+	// See formatting options for more details
+	// (https://core.telegram.org/bots/api#formatting-options).
+	//
+	// optional
+	ParseMode string `json:"parse_mode"`
+	// CaptionEntities are special entities that appear in the caption,
+	// which can be specified instead of ParseMode.
+	//
+	// optional
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+	// DisableContentTypeDetection disables automatic server-side content
+	// type detection for uploaded files. It is always true for documents
+	// sent as part of an album.
+	//
+	// optional
+	DisableContentTypeDetection bool `json:"disable_content_type_detection,omitempty"`
+}
+
+// prepare replaces File and Thumbnail with attach:// references.
+func (media InputMediaDocument) prepare(prefix string) (interface{}, []RequestFile, error) {
+	files, err := attachFile(&media.Media, prefix, media.File)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var thumbnail string
+	thumbnailFiles, err := attachFile(&thumbnail, prefix+"-thumbnail", media.Thumbnail)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return struct {
+		InputMediaDocument
+		Thumbnail string `json:"thumbnail,omitempty"`
+	}{media, thumbnail}, append(files, thumbnailFiles...), nil
+}
+
+// InlineQuery is a Query from Telegram for an inline request.
+type InlineQuery struct {
+	// ID unique identifier for this query
+	ID string `json:"id"`
+	// From sender
+	From *User `json:"from"`
+	// Location sender location, only for bots that request user location.
+	//
+	// optional
+	Location *Location `json:"location"`
+	// Query text of the query (up to 256 characters).
+	Query string `json:"query"`
+	// Offset of the results to be returned, can be controlled by the bot.
+	Offset string `json:"offset"`
+}
+
+// InlineQueryResultArticle is an inline query response article.
+type InlineQueryResultArticle struct {
+	// Type of the result, must be article.
+	//
+	// required
+	Type string `json:"type"`
+	// ID unique identifier for this result, 1-64 Bytes.
+	//
+	// required
+	ID string `json:"id"`
+	// Title of the result
+	//
+	// required
+	Title string `json:"title"`
+	// InputMessageContent content of the message to be sent.
+	//
+	// required
+	InputMessageContent interface{} `json:"input_message_content,omitempty"`
+	// ReplyMarkup Inline keyboard attached to the message.
+	//
+	// optional
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	// URL of the result.
+	//
+	// optional
+	URL string `json:"url"`
+	// HideURL pass True, if you don't want the URL to be shown in the message.
+	//
+	// optional
+	HideURL bool `json:"hide_url"`
+	// Description short description of the result.
+	//
+	// optional
+	Description string `json:"description"`
+	// ThumbURL url of the thumbnail for the result
+	//
+	// optional
+	ThumbURL string `json:"thumb_url"`
+	// ThumbWidth thumbnail width
+	//
+	// optional
+	ThumbWidth int `json:"thumb_width"`
+	// ThumbHeight thumbnail height
+	//
+	// optional
+	ThumbHeight int `json:"thumb_height"`
+}
+
+// InlineQueryResultPhoto is an inline query response photo.
+type InlineQueryResultPhoto struct {
+	// Type of the result, must be article.
+	//
+	// required
+	Type string `json:"type"`
+	// ID unique identifier for this result, 1-64 Bytes.
 	//
 	// required
 	ID string `json:"id"`
@@ -2139,10 +4308,11 @@ type InputTextMessageContent struct {
 	//
 	// optional
 	ParseMode string `json:"parse_mode"`
-	// DisableWebPagePreview disables link previews for links in the sent message
+	// LinkPreviewOptions are the link preview generation options
+	// for the message
 	//
 	// optional
-	DisableWebPagePreview bool `json:"disable_web_page_preview"`
+	LinkPreviewOptions *LinkPreviewOptions `json:"link_preview_options,omitempty"`
 }
 
 // InputLocationMessageContent contains a location for displaying
@@ -2277,6 +4447,19 @@ type SuccessfulPayment struct {
 	TotalAmount int `json:"total_amount"`
 	// InvoicePayload bot specified invoice payload
 	InvoicePayload string `json:"invoice_payload"`
+	// SubscriptionExpirationDate expiration date of the subscription
+	// in Unix time, for recurring payments only
+	//
+	// optional
+	SubscriptionExpirationDate int `json:"subscription_expiration_date,omitempty"`
+	// IsRecurring true, if the payment is a recurring payment for a subscription
+	//
+	// optional
+	IsRecurring bool `json:"is_recurring,omitempty"`
+	// IsFirstRecurring true, if the payment is the first payment for a subscription
+	//
+	// optional
+	IsFirstRecurring bool `json:"is_first_recurring,omitempty"`
 	// ShippingOptionID identifier of the shipping option chosen by the user
 	//
 	// optional
@@ -2350,3 +4533,1235 @@ type BotCommand struct {
 	// Description of the command, 3-256 characters.
 	Description string `json:"description"`
 }
+
+// ChatInviteLink represents an invite link for a chat.
+type ChatInviteLink struct {
+	// InviteLink is the invite link. If the link was created by another
+	// chat administrator, then the second part of the link will be
+	// replaced with “…”
+	InviteLink string `json:"invite_link"`
+	// Creator of the link
+	Creator User `json:"creator"`
+	// CreatesJoinRequest is true, if users joining the chat via the link
+	// need to be approved by chat administrators
+	CreatesJoinRequest bool `json:"creates_join_request"`
+	// IsPrimary is true, if the link is primary
+	IsPrimary bool `json:"is_primary"`
+	// IsRevoked is true, if the link is revoked
+	IsRevoked bool `json:"is_revoked"`
+	// Name is the invite link name
+	//
+	// optional
+	Name string `json:"name,omitempty"`
+	// ExpireDate is the point in time (Unix timestamp) when the link
+	// will expire or has been expired
+	//
+	// optional
+	ExpireDate int `json:"expire_date,omitempty"`
+	// MemberLimit is the maximum number of users that can be members
+	// of the chat simultaneously after joining the chat via this invite
+	// link; 1-99999
+	//
+	// optional
+	MemberLimit int `json:"member_limit,omitempty"`
+	// PendingJoinRequestCount is the number of pending join requests
+	// created using this link
+	//
+	// optional
+	PendingJoinRequestCount int `json:"pending_join_request_count,omitempty"`
+
+	// SubscriptionPeriod is the number of seconds the subscription will be
+	// active for before the next payment
+	//
+	// optional
+	SubscriptionPeriod int `json:"subscription_period,omitempty"`
+	// SubscriptionPrice is the amount of Telegram Stars a user must pay
+	// initially and after each subsequent subscription period to be
+	// a member of the chat using the link
+	//
+	// optional
+	SubscriptionPrice int `json:"subscription_price,omitempty"`
+}
+
+// ChatMemberUpdated represents changes in the status of a chat member.
+type ChatMemberUpdated struct {
+	// Chat the user belongs to
+	Chat Chat `json:"chat"`
+	// From is the performer of the action, which resulted in the change
+	From User `json:"from"`
+	// Date the change was done in Unix time
+	Date int `json:"date"`
+	// OldChatMember is the previous information about the chat member
+	OldChatMember ChatMember `json:"old_chat_member"`
+	// NewChatMember is the new information about the chat member
+	NewChatMember ChatMember `json:"new_chat_member"`
+	// InviteLink is the chat invite link, which was used by the user to
+	// join the chat; for joining by invite link events only
+	//
+	// optional
+	InviteLink *ChatInviteLink `json:"invite_link,omitempty"`
+	// ViaJoinRequest is true, if the user joined the chat after sending
+	// a direct join request without using an invite link and being approved
+	// by an administrator
+	//
+	// optional
+	ViaJoinRequest bool `json:"via_join_request,omitempty"`
+	// ViaChatFolderInviteLink is true, if the user joined the chat
+	// via a chat folder invite link
+	//
+	// optional
+	ViaChatFolderInviteLink bool `json:"via_chat_folder_invite_link,omitempty"`
+}
+
+// Joined returns true if the member joined the chat or was added to it.
+func (u ChatMemberUpdated) Joined() bool {
+	return !inChat(u.OldChatMember) && inChat(u.NewChatMember)
+}
+
+// Left returns true if the member left the chat, or was removed from it.
+func (u ChatMemberUpdated) Left() bool {
+	return inChat(u.OldChatMember) && !inChat(u.NewChatMember)
+}
+
+// WasKicked returns true if the member was banned from the chat.
+func (u ChatMemberUpdated) WasKicked() bool {
+	return !u.OldChatMember.WasKicked() && u.NewChatMember.WasKicked()
+}
+
+// JoinedViaLink returns true if the member joined the chat with
+// the invite link in InviteLink.
+func (u ChatMemberUpdated) JoinedViaLink() bool {
+	return u.InviteLink != nil && u.Joined()
+}
+
+// BecameAdmin returns true if the member was promoted to an administrator.
+func (u ChatMemberUpdated) BecameAdmin() bool {
+	return !isAdmin(u.OldChatMember) && isAdmin(u.NewChatMember)
+}
+
+// LostAdmin returns true if the member stopped being an administrator.
+func (u ChatMemberUpdated) LostAdmin() bool {
+	return isAdmin(u.OldChatMember) && !isAdmin(u.NewChatMember)
+}
+
+// inChat returns true if member is in the chat.
+func inChat(member ChatMember) bool {
+	switch member.Status {
+	case "creator", "administrator", "member":
+		return true
+	case "restricted":
+		return member.IsChatMember
+	default:
+		return false
+	}
+}
+
+// isAdmin returns true if member is the creator or an administrator.
+func isAdmin(member ChatMember) bool {
+	return member.IsCreator() || member.IsAdministrator()
+}
+
+// ChatJoinRequest represents a join request sent to a chat.
+type ChatJoinRequest struct {
+	// Chat to which the request was sent
+	Chat Chat `json:"chat"`
+	// From is the user that sent the join request
+	From User `json:"from"`
+	// UserChatID is the identifier of a private chat with the user who sent
+	// the join request. The bot can use it for 5 minutes to send messages
+	// until the join request is processed, assuming no other administrator
+	// contacted the user
+	UserChatID int64 `json:"user_chat_id"`
+	// Date the request was sent in Unix time
+	Date int `json:"date"`
+	// Bio of the user
+	//
+	// optional
+	Bio string `json:"bio,omitempty"`
+	// InviteLink that was used by the user to send the join request
+	//
+	// optional
+	InviteLink *ChatInviteLink `json:"invite_link,omitempty"`
+}
+
+// ForumTopic represents a forum topic.
+type ForumTopic struct {
+	// MessageThreadID is the unique identifier of the forum topic
+	MessageThreadID int `json:"message_thread_id"`
+	// Name of the topic
+	Name string `json:"name"`
+	// IconColor is the color of the topic icon in RGB format
+	IconColor int `json:"icon_color"`
+	// IconCustomEmojiID is the unique identifier of the custom emoji
+	// shown as the topic icon
+	//
+	// optional
+	IconCustomEmojiID string `json:"icon_custom_emoji_id,omitempty"`
+}
+
+// ForumTopicCreated represents a service message about a new forum topic
+// created in the chat.
+type ForumTopicCreated struct {
+	// Name of the topic
+	Name string `json:"name"`
+	// IconColor is the color of the topic icon in RGB format
+	IconColor int `json:"icon_color"`
+	// IconCustomEmojiID is the unique identifier of the custom emoji
+	// shown as the topic icon
+	//
+	// optional
+	IconCustomEmojiID string `json:"icon_custom_emoji_id,omitempty"`
+}
+
+// ForumTopicEdited represents a service message about an edited forum topic.
+type ForumTopicEdited struct {
+	// Name is the new name of the topic, if it was edited
+	//
+	// optional
+	Name string `json:"name,omitempty"`
+	// IconCustomEmojiID is the new identifier of the custom emoji shown as
+	// the topic icon, if it was edited; an empty string if the icon was removed
+	//
+	// optional
+	IconCustomEmojiID *string `json:"icon_custom_emoji_id,omitempty"`
+}
+
+// ForumTopicClosed represents a service message about a forum topic
+// closed in the chat. Currently holds no information.
+type ForumTopicClosed struct{}
+
+// ForumTopicReopened represents a service message about a forum topic
+// reopened in the chat. Currently holds no information.
+type ForumTopicReopened struct{}
+
+// GeneralForumTopicHidden represents a service message about the General
+// forum topic hidden in the chat. Currently holds no information.
+type GeneralForumTopicHidden struct{}
+
+// GeneralForumTopicUnhidden represents a service message about the General
+// forum topic unhidden in the chat. Currently holds no information.
+type GeneralForumTopicUnhidden struct{}
+
+// WebAppInfo describes a Web App.
+type WebAppInfo struct {
+	// URL is an HTTPS URL of a Web App to be opened
+	// with additional data as specified in Initializing Web Apps
+	URL string `json:"url"`
+}
+
+// WebAppData describes data sent from a Web App to the bot.
+type WebAppData struct {
+	// Data is the data. Be aware that a bad client can send arbitrary data
+	// in this field
+	Data string `json:"data"`
+	// ButtonText is the text of the web_app keyboard button from which the
+	// Web App was opened. Be aware that a bad client can send arbitrary data
+	// in this field
+	ButtonText string `json:"button_text"`
+}
+
+// SentWebAppMessage describes an inline message sent by a Web App
+// on behalf of a user.
+type SentWebAppMessage struct {
+	// InlineMessageID is the identifier of the sent inline message, available
+	// only if there is an inline keyboard attached to the message
+	//
+	// optional
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+}
+
+// MenuButton describes the bot's menu button in a private chat.
+//
+// The type of the button can be:
+//
+//	“commands”, opening the bot's list of commands,
+//	“web_app”, launching a Web App,
+//	“default”, when no specific button is set.
+type MenuButton struct {
+	// Type of the button
+	Type string `json:"type"`
+	// Text on the button, for the “web_app” type
+	//
+	// optional
+	Text string `json:"text,omitempty"`
+	// WebApp is the Web App launched when the user presses the button,
+	// for the “web_app” type
+	//
+	// optional
+	WebApp *WebAppInfo `json:"web_app,omitempty"`
+}
+
+// ChatPermissions describes actions that a non-administrator user
+// is allowed to take in a chat.
+type ChatPermissions struct {
+	// CanSendMessages is true, if the user is allowed to send text messages,
+	// contacts, giveaways, giveaway winners, invoices, locations and venues
+	//
+	// optional
+	CanSendMessages bool `json:"can_send_messages,omitempty"`
+	// CanSendAudios is true, if the user is allowed to send audios
+	//
+	// optional
+	CanSendAudios bool `json:"can_send_audios,omitempty"`
+	// CanSendDocuments is true, if the user is allowed to send documents
+	//
+	// optional
+	CanSendDocuments bool `json:"can_send_documents,omitempty"`
+	// CanSendPhotos is true, if the user is allowed to send photos
+	//
+	// optional
+	CanSendPhotos bool `json:"can_send_photos,omitempty"`
+	// CanSendVideos is true, if the user is allowed to send videos
+	//
+	// optional
+	CanSendVideos bool `json:"can_send_videos,omitempty"`
+	// CanSendVideoNotes is true, if the user is allowed to send video notes
+	//
+	// optional
+	CanSendVideoNotes bool `json:"can_send_video_notes,omitempty"`
+	// CanSendVoiceNotes is true, if the user is allowed to send voice notes
+	//
+	// optional
+	CanSendVoiceNotes bool `json:"can_send_voice_notes,omitempty"`
+	// CanSendPolls is true, if the user is allowed to send polls
+	//
+	// optional
+	CanSendPolls bool `json:"can_send_polls,omitempty"`
+	// CanSendOtherMessages is true, if the user is allowed to send animations,
+	// games, stickers and use inline bots
+	//
+	// optional
+	CanSendOtherMessages bool `json:"can_send_other_messages,omitempty"`
+	// CanAddWebPagePreviews is true, if the user is allowed to add web page
+	// previews to their messages
+	//
+	// optional
+	CanAddWebPagePreviews bool `json:"can_add_web_page_previews,omitempty"`
+	// CanChangeInfo is true, if the user is allowed to change the chat title,
+	// photo and other settings. Ignored in public supergroups
+	//
+	// optional
+	CanChangeInfo bool `json:"can_change_info,omitempty"`
+	// CanInviteUsers is true, if the user is allowed to invite new users to
+	// the chat
+	//
+	// optional
+	CanInviteUsers bool `json:"can_invite_users,omitempty"`
+	// CanPinMessages is true, if the user is allowed to pin messages. Ignored
+	// in public supergroups
+	//
+	// optional
+	CanPinMessages bool `json:"can_pin_messages,omitempty"`
+	// CanManageTopics is true, if the user is allowed to create forum topics
+	//
+	// optional
+	CanManageTopics bool `json:"can_manage_topics,omitempty"`
+}
+
+// ChatAdministratorRights represents the rights of an administrator
+// in a chat.
+type ChatAdministratorRights struct {
+	// IsAnonymous is true, if the user's presence in the chat is hidden
+	IsAnonymous bool `json:"is_anonymous"`
+	// CanManageChat is true, if the administrator can access the chat event
+	// log, get boost list, see hidden supergroup and channel members, report
+	// spam messages and ignore slow mode
+	CanManageChat bool `json:"can_manage_chat"`
+	// CanDeleteMessages is true, if the administrator can delete messages of
+	// other users
+	CanDeleteMessages bool `json:"can_delete_messages"`
+	// CanManageVideoChats is true, if the administrator can manage video chats
+	CanManageVideoChats bool `json:"can_manage_video_chats"`
+	// CanRestrictMembers is true, if the administrator can restrict, ban or
+	// unban chat members, or access supergroup statistics
+	CanRestrictMembers bool `json:"can_restrict_members"`
+	// CanPromoteMembers is true, if the administrator can add new
+	// administrators with a subset of their own privileges or demote
+	// administrators that they have promoted
+	CanPromoteMembers bool `json:"can_promote_members"`
+	// CanChangeInfo is true, if the user is allowed to change the chat title,
+	// photo and other settings
+	CanChangeInfo bool `json:"can_change_info"`
+	// CanInviteUsers is true, if the user is allowed to invite new users to
+	// the chat
+	CanInviteUsers bool `json:"can_invite_users"`
+	// CanPostStories is true, if the administrator can post stories to the
+	// chat
+	CanPostStories bool `json:"can_post_stories"`
+	// CanEditStories is true, if the administrator can edit stories posted by
+	// other users
+	CanEditStories bool `json:"can_edit_stories"`
+	// CanDeleteStories is true, if the administrator can delete stories posted
+	// by other users
+	CanDeleteStories bool `json:"can_delete_stories"`
+	// CanPostMessages is true, if the administrator can post messages in the
+	// channel; channels only
+	//
+	// optional
+	CanPostMessages bool `json:"can_post_messages,omitempty"`
+	// CanEditMessages is true, if the administrator can edit messages of other
+	// users and can pin messages; channels only
+	//
+	// optional
+	CanEditMessages bool `json:"can_edit_messages,omitempty"`
+	// CanPinMessages is true, if the user is allowed to pin messages; groups
+	// and supergroups only
+	//
+	// optional
+	CanPinMessages bool `json:"can_pin_messages,omitempty"`
+	// CanManageTopics is true, if the user is allowed to create, rename,
+	// close, and reopen forum topics; supergroups only
+	//
+	// optional
+	CanManageTopics bool `json:"can_manage_topics,omitempty"`
+}
+
+// BotName represents the bot's name.
+type BotName struct {
+	// Name is the bot's name
+	Name string `json:"name"`
+}
+
+// BotDescription represents the bot's description,
+// shown in the chat with the bot if the chat is empty.
+type BotDescription struct {
+	// Description is the bot's description
+	Description string `json:"description"`
+}
+
+// BotShortDescription represents the bot's short description,
+// shown on the bot's profile page.
+type BotShortDescription struct {
+	// ShortDescription is the bot's short description
+	ShortDescription string `json:"short_description"`
+}
+
+// BotCommandScope represents the scope to which bot commands are applied.
+//
+// The scopes are, from the most specific:
+//
+//	“chat_member”, for a member of a group or supergroup chat,
+//	“chat_administrators”, for the administrators of a chat,
+//	“chat”, for a chat,
+//	“all_chat_administrators”, for the administrators of all groups,
+//	“all_group_chats”, for all group and supergroup chats,
+//	“all_private_chats”, for all private chats,
+//	“default”, when no narrower scope applies.
+type BotCommandScope struct {
+	// Type of the scope
+	Type string `json:"type"`
+	// ChatID is the target chat, for the “chat”, “chat_administrators”
+	// and “chat_member” scopes
+	//
+	// optional
+	ChatID int64 `json:"chat_id,omitempty"`
+	// UserID is the target user, for the “chat_member” scope
+	//
+	// optional
+	UserID int64 `json:"user_id,omitempty"`
+}
+
+// ReactionType describes the type of a reaction.
+//
+// The types are:
+//
+//	“emoji”, a reaction with an emoji, set in Emoji,
+//	“custom_emoji”, a reaction with a custom emoji, set in CustomEmojiID,
+//	“paid”, the paid reaction.
+type ReactionType struct {
+	// Type of the reaction
+	Type string `json:"type"`
+	// Emoji of the reaction, for the “emoji” type
+	//
+	// optional
+	Emoji string `json:"emoji,omitempty"`
+	// CustomEmojiID is the identifier of the custom emoji,
+	// for the “custom_emoji” type
+	//
+	// optional
+	CustomEmojiID string `json:"custom_emoji_id,omitempty"`
+}
+
+// ReactionCount represents a reaction added to a message
+// along with the number of times it was added.
+type ReactionCount struct {
+	// Type of the reaction
+	Type ReactionType `json:"type"`
+	// TotalCount is the number of times the reaction was added
+	TotalCount int `json:"total_count"`
+}
+
+// MessageReactionUpdated represents a change of a reaction
+// on a message performed by a user.
+type MessageReactionUpdated struct {
+	// Chat containing the message the user reacted to
+	Chat Chat `json:"chat"`
+	// MessageID is the unique identifier of the message inside the chat
+	MessageID int `json:"message_id"`
+	// User that changed the reaction, if the user isn't anonymous
+	//
+	// optional
+	User *User `json:"user,omitempty"`
+	// ActorChat is the chat on behalf of which the reaction was changed,
+	// if the user is anonymous
+	//
+	// optional
+	ActorChat *Chat `json:"actor_chat,omitempty"`
+	// Date of the change in Unix time
+	Date int `json:"date"`
+	// OldReaction is the previous list of reaction types set by the user
+	OldReaction []ReactionType `json:"old_reaction"`
+	// NewReaction is the new list of reaction types set by the user
+	NewReaction []ReactionType `json:"new_reaction"`
+}
+
+// MessageReactionCountUpdated represents reaction changes
+// on a message with anonymous reactions.
+type MessageReactionCountUpdated struct {
+	// Chat containing the message
+	Chat Chat `json:"chat"`
+	// MessageID is the unique identifier of the message inside the chat
+	MessageID int `json:"message_id"`
+	// Date of the change in Unix time
+	Date int `json:"date"`
+	// Reactions is the list of reactions that are present on the message
+	Reactions []ReactionCount `json:"reactions"`
+}
+
+// ChatBoostSource describes the source of a chat boost.
+//
+// The sources are:
+//
+//	“premium”, the boost of a user with a Telegram Premium subscription,
+//	“gift_code”, a Telegram Premium subscription gifted by the chat,
+//	“giveaway”, a Telegram Premium subscription or stars won in a giveaway.
+type ChatBoostSource struct {
+	// Source of the boost
+	Source string `json:"source"`
+	// User that boosted the chat, or that received the gift code or
+	// won the giveaway
+	//
+	// optional, empty for an unclaimed giveaway prize
+	User *User `json:"user,omitempty"`
+	// GiveawayMessageID is the identifier of the message in the chat with
+	// the giveaway, for the “giveaway” source. It may be 0 if the message
+	// isn't sent yet
+	//
+	// optional
+	GiveawayMessageID int `json:"giveaway_message_id,omitempty"`
+	// PrizeStarCount is the number of stars split between the winners,
+	// for giveaways of stars
+	//
+	// optional
+	PrizeStarCount int `json:"prize_star_count,omitempty"`
+	// IsUnclaimed is true if the giveaway was completed,
+	// but there was no user to win the prize
+	//
+	// optional
+	IsUnclaimed bool `json:"is_unclaimed,omitempty"`
+}
+
+// ChatBoost contains information about a chat boost.
+type ChatBoost struct {
+	// BoostID is the unique identifier of the boost
+	BoostID string `json:"boost_id"`
+	// AddDate is the point in time (Unix timestamp) when the chat was boosted
+	AddDate int `json:"add_date"`
+	// ExpirationDate is the point in time (Unix timestamp) when the boost
+	// will automatically expire, unless the booster's Telegram Premium
+	// subscription is prolonged
+	ExpirationDate int `json:"expiration_date"`
+	// Source of the added boost
+	Source ChatBoostSource `json:"source"`
+}
+
+// ChatBoostUpdated represents a boost added to a chat or changed.
+type ChatBoostUpdated struct {
+	// Chat which was boosted
+	Chat Chat `json:"chat"`
+	// Boost information
+	Boost ChatBoost `json:"boost"`
+}
+
+// ChatBoostRemoved represents a boost removed from a chat.
+type ChatBoostRemoved struct {
+	// Chat which was boosted
+	Chat Chat `json:"chat"`
+	// BoostID is the unique identifier of the boost
+	BoostID string `json:"boost_id"`
+	// RemoveDate is the point in time (Unix timestamp) when the boost
+	// was removed
+	RemoveDate int `json:"remove_date"`
+	// Source of the removed boost
+	Source ChatBoostSource `json:"source"`
+}
+
+// UserChatBoosts represents a list of boosts added to a chat by a user.
+type UserChatBoosts struct {
+	// Boosts is the list of boosts added to the chat by the user
+	Boosts []ChatBoost `json:"boosts"`
+}
+
+// BusinessConnection describes the connection of the bot
+// with a business account.
+type BusinessConnection struct {
+	// ID is the unique identifier of the business connection
+	ID string `json:"id"`
+	// User is the business account user that created the business connection
+	User User `json:"user"`
+	// UserChatID is the identifier of a private chat with the user
+	// who created the business connection
+	UserChatID int64 `json:"user_chat_id"`
+	// Date the connection was established in Unix time
+	Date int `json:"date"`
+	// CanReply is true, if the bot can act on behalf of the business account
+	// in chats that were active in the last 24 hours
+	//
+	// optional
+	CanReply bool `json:"can_reply,omitempty"`
+	// Rights of the business bot
+	//
+	// optional
+	Rights *BusinessBotRights `json:"rights,omitempty"`
+	// IsEnabled is true, if the connection is active
+	IsEnabled bool `json:"is_enabled"`
+}
+
+// BusinessMessagesDeleted is received when messages are deleted
+// from a connected business account.
+type BusinessMessagesDeleted struct {
+	// BusinessConnectionID is the unique identifier of the business connection
+	BusinessConnectionID string `json:"business_connection_id"`
+	// Chat is the private chat in the business account
+	Chat Chat `json:"chat"`
+	// MessageIDs is the list of identifiers of the deleted messages
+	MessageIDs []int `json:"message_ids"`
+}
+
+// BusinessBotRights represents the rights of a business bot.
+type BusinessBotRights struct {
+	// CanReply is true, if the bot can send and edit messages in the private
+	// chats that had incoming messages in the last 24 hours
+	CanReply bool `json:"can_reply,omitempty"`
+	// CanReadMessages is true, if the bot can mark incoming private messages
+	// as read
+	CanReadMessages bool `json:"can_read_messages,omitempty"`
+	// CanDeleteSentMessages is true, if the bot can delete messages
+	// sent by the bot
+	CanDeleteSentMessages bool `json:"can_delete_sent_messages,omitempty"`
+	// CanDeleteAllMessages is true, if the bot can delete all private messages
+	// in managed chats
+	CanDeleteAllMessages bool `json:"can_delete_all_messages,omitempty"`
+	// CanEditName is true, if the bot can edit the first and last name
+	// of the business account
+	CanEditName bool `json:"can_edit_name,omitempty"`
+	// CanEditBio is true, if the bot can edit the bio of the business account
+	CanEditBio bool `json:"can_edit_bio,omitempty"`
+	// CanEditProfilePhoto is true, if the bot can edit the profile photo
+	// of the business account
+	CanEditProfilePhoto bool `json:"can_edit_profile_photo,omitempty"`
+	// CanEditUsername is true, if the bot can edit the username
+	// of the business account
+	CanEditUsername bool `json:"can_edit_username,omitempty"`
+	// CanChangeGiftSettings is true, if the bot can change the privacy
+	// settings pertaining to gifts for the business account
+	CanChangeGiftSettings bool `json:"can_change_gift_settings,omitempty"`
+	// CanViewGiftsAndStars is true, if the bot can view gifts and the amount
+	// of stars owned by the business account
+	CanViewGiftsAndStars bool `json:"can_view_gifts_and_stars,omitempty"`
+	// CanConvertGiftsToStars is true, if the bot can convert regular gifts
+	// owned by the business account to stars
+	CanConvertGiftsToStars bool `json:"can_convert_gifts_to_stars,omitempty"`
+	// CanTransferAndUpgradeGifts is true, if the bot can transfer and upgrade
+	// gifts owned by the business account
+	CanTransferAndUpgradeGifts bool `json:"can_transfer_and_upgrade_gifts,omitempty"`
+	// CanTransferStars is true, if the bot can transfer stars received
+	// by the business account to its own account, or use them to upgrade
+	// and transfer gifts
+	CanTransferStars bool `json:"can_transfer_stars,omitempty"`
+	// CanManageStories is true, if the bot can post, edit and delete stories
+	// on behalf of the business account
+	CanManageStories bool `json:"can_manage_stories,omitempty"`
+}
+
+// Constant values for the types of InputProfilePhoto.
+const (
+	InputProfilePhotoStatic   = "static"
+	InputProfilePhotoAnimated = "animated"
+)
+
+// InputProfilePhoto describes a profile photo to set.
+type InputProfilePhoto struct {
+	// Type of the profile photo, “static” or “animated”
+	Type string `json:"type"`
+	// Photo is the reference to the uploaded static photo, set from File
+	Photo string `json:"photo,omitempty"`
+	// Animation is the reference to the uploaded animation, set from File
+	Animation string `json:"animation,omitempty"`
+	// File to upload, as a file path, FileBytes, FileReader or
+	// RequestFileData. Profile photos can't be reused by file_id or URL,
+	// so the file is always uploaded
+	File interface{} `json:"-"`
+	// MainFrameTimestamp is the timestamp in seconds of the frame that
+	// will be used as the static profile photo, for animated photos
+	//
+	// optional
+	MainFrameTimestamp float64 `json:"main_frame_timestamp,omitempty"`
+}
+
+// prepare replaces File with an attach:// reference in Photo or Animation.
+func (photo InputProfilePhoto) prepare(prefix string) (interface{}, []RequestFile, error) {
+	ref := &photo.Photo
+	if photo.Type == InputProfilePhotoAnimated {
+		ref = &photo.Animation
+	}
+
+	files, err := attachFile(ref, prefix, photo.File)
+	return photo, files, err
+}
+
+// StarAmount describes an amount of Telegram Stars.
+type StarAmount struct {
+	// Amount is the integer amount of stars, which can be negative
+	Amount int `json:"amount"`
+	// NanostarAmount is the number of 1/1000000000 shares of stars,
+	// from -999999999 to 999999999, with the same sign as Amount
+	//
+	// optional
+	NanostarAmount int `json:"nanostar_amount,omitempty"`
+}
+
+// AcceptedGiftTypes describes the types of gifts that can be gifted
+// to a user or a chat.
+type AcceptedGiftTypes struct {
+	// UnlimitedGifts is true, if unlimited regular gifts are accepted
+	UnlimitedGifts bool `json:"unlimited_gifts"`
+	// LimitedGifts is true, if limited regular gifts are accepted
+	LimitedGifts bool `json:"limited_gifts"`
+	// UniqueGifts is true, if unique gifts or gifts that can be upgraded
+	// to unique for free are accepted
+	UniqueGifts bool `json:"unique_gifts"`
+	// PremiumSubscription is true, if a Telegram Premium subscription
+	// is accepted
+	PremiumSubscription bool `json:"premium_subscription"`
+}
+
+// Gift represents a regular gift that can be sent by the bot.
+type Gift struct {
+	// ID is the unique identifier of the gift
+	ID string `json:"id"`
+	// Sticker that represents the gift
+	Sticker Sticker `json:"sticker"`
+	// StarCount is the number of stars that must be paid to send the sticker
+	StarCount int `json:"star_count"`
+	// UpgradeStarCount is the number of stars that must be paid to upgrade
+	// the gift to a unique one
+	//
+	// optional
+	UpgradeStarCount int `json:"upgrade_star_count,omitempty"`
+	// TotalCount is the total number of the gifts of this type that can be
+	// sent, for limited gifts only
+	//
+	// optional
+	TotalCount int `json:"total_count,omitempty"`
+	// RemainingCount is the number of remaining gifts of this type that can
+	// be sent, for limited gifts only
+	//
+	// optional
+	RemainingCount int `json:"remaining_count,omitempty"`
+}
+
+// Gifts represents a list of gifts.
+type Gifts struct {
+	// Gifts is the list of gifts
+	Gifts []Gift `json:"gifts"`
+}
+
+// GiftInfo describes a service message about a regular gift
+// that was sent or received.
+type GiftInfo struct {
+	// Gift is information about the gift
+	Gift Gift `json:"gift"`
+	// OwnedGiftID is the unique identifier of the received gift for the bot,
+	// only present for gifts received on behalf of business accounts
+	//
+	// optional
+	OwnedGiftID string `json:"owned_gift_id,omitempty"`
+	// ConvertStarCount is the number of stars that can be claimed
+	// by the receiver by converting the gift
+	//
+	// optional
+	ConvertStarCount int `json:"convert_star_count,omitempty"`
+	// PrepaidUpgradeStarCount is the number of stars that were prepaid
+	// by the sender for the ability to upgrade the gift
+	//
+	// optional
+	PrepaidUpgradeStarCount int `json:"prepaid_upgrade_star_count,omitempty"`
+	// CanBeUpgraded is true, if the gift can be upgraded to a unique gift
+	//
+	// optional
+	CanBeUpgraded bool `json:"can_be_upgraded,omitempty"`
+	// Text that was added to the gift
+	//
+	// optional
+	Text string `json:"text,omitempty"`
+	// Entities that appear in the text
+	//
+	// optional
+	Entities []MessageEntity `json:"entities,omitempty"`
+	// IsPrivate is true, if the sender and text are shown only to the
+	// receiver of the gift
+	//
+	// optional
+	IsPrivate bool `json:"is_private,omitempty"`
+}
+
+// UniqueGift describes a unique gift that was upgraded from a regular gift.
+type UniqueGift struct {
+	// BaseName is the human-readable name of the regular gift
+	// from which this unique gift was upgraded
+	BaseName string `json:"base_name"`
+	// Name is the unique name of the gift, which can be used
+	// in https://t.me/nft/... links
+	Name string `json:"name"`
+	// Number is the unique number of the upgraded gift among gifts
+	// upgraded from the same regular gift
+	Number int `json:"number"`
+}
+
+// Constant values for the types of OwnedGift.
+const (
+	OwnedGiftRegular = "regular"
+	OwnedGiftUnique  = "unique"
+)
+
+// OwnedGift describes a gift received and owned by a user or a chat.
+type OwnedGift struct {
+	// Type of the gift, “regular” or “unique”
+	Type string `json:"type"`
+	// Gift is the regular gift, for the “regular” type
+	//
+	// optional
+	Gift *Gift `json:"-"`
+	// UniqueGift is the unique gift, for the “unique” type
+	//
+	// optional
+	UniqueGift *UniqueGift `json:"-"`
+	// OwnedGiftID is the unique identifier of the received gift
+	// for the bot, for gifts received on behalf of business accounts
+	//
+	// optional
+	OwnedGiftID string `json:"owned_gift_id,omitempty"`
+	// SenderUser that sent the gift, if known
+	//
+	// optional
+	SenderUser *User `json:"sender_user,omitempty"`
+	// SendDate is the date the gift was sent in Unix time
+	SendDate int `json:"send_date"`
+	// Text of the message that was added to a regular gift
+	//
+	// optional
+	Text string `json:"text,omitempty"`
+	// Entities that appear in the text
+	//
+	// optional
+	Entities []MessageEntity `json:"entities,omitempty"`
+	// IsPrivate is true, if the sender and text of a regular gift
+	// are shown only to the gift receiver
+	//
+	// optional
+	IsPrivate bool `json:"is_private,omitempty"`
+	// IsSaved is true, if the gift is displayed on the account's profile page
+	//
+	// optional
+	IsSaved bool `json:"is_saved,omitempty"`
+	// CanBeUpgraded is true, if a regular gift can be upgraded
+	// to a unique gift
+	//
+	// optional
+	CanBeUpgraded bool `json:"can_be_upgraded,omitempty"`
+	// WasRefunded is true, if the payment for a regular gift was refunded
+	//
+	// optional
+	WasRefunded bool `json:"was_refunded,omitempty"`
+	// ConvertStarCount is the number of stars that can be claimed by
+	// the receiver instead of a regular gift
+	//
+	// optional
+	ConvertStarCount int `json:"convert_star_count,omitempty"`
+	// PrepaidUpgradeStarCount is the number of stars that were paid by the
+	// sender for the ability to upgrade a regular gift
+	//
+	// optional
+	PrepaidUpgradeStarCount int `json:"prepaid_upgrade_star_count,omitempty"`
+	// CanBeTransferred is true, if a unique gift can be transferred
+	// to another owner
+	//
+	// optional
+	CanBeTransferred bool `json:"can_be_transferred,omitempty"`
+	// TransferStarCount is the number of stars that must be paid
+	// to transfer a unique gift
+	//
+	// optional
+	TransferStarCount int `json:"transfer_star_count,omitempty"`
+}
+
+// MarshalJSON encodes Gift or UniqueGift as the gift of the owned gift.
+func (gift OwnedGift) MarshalJSON() ([]byte, error) {
+	type ownedGift OwnedGift
+	aux := struct {
+		ownedGift
+		Gift interface{} `json:"gift,omitempty"`
+	}{ownedGift: ownedGift(gift)}
+
+	if gift.UniqueGift != nil {
+		aux.Gift = gift.UniqueGift
+	} else if gift.Gift != nil {
+		aux.Gift = gift.Gift
+	}
+
+	return json.Marshal(aux)
+}
+
+// UnmarshalJSON decodes the gift into Gift or UniqueGift,
+// depending on the type of the owned gift.
+func (gift *OwnedGift) UnmarshalJSON(data []byte) error {
+	type ownedGift OwnedGift
+	aux := struct {
+		*ownedGift
+		Gift json.RawMessage `json:"gift"`
+	}{ownedGift: (*ownedGift)(gift)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	if len(aux.Gift) == 0 {
+		return nil
+	}
+
+	if gift.Type == OwnedGiftUnique {
+		gift.UniqueGift = new(UniqueGift)
+		return json.Unmarshal(aux.Gift, gift.UniqueGift)
+	}
+
+	gift.Gift = new(Gift)
+	return json.Unmarshal(aux.Gift, gift.Gift)
+}
+
+// OwnedGifts contains the list of gifts received and owned by a user or a chat.
+type OwnedGifts struct {
+	// TotalCount is the total number of gifts owned by the user or the chat
+	TotalCount int `json:"total_count"`
+	// Gifts is the list of gifts
+	Gifts []OwnedGift `json:"gifts"`
+	// NextOffset is the offset of the next page of gifts,
+	// empty if there are no more gifts
+	//
+	// optional
+	NextOffset string `json:"next_offset,omitempty"`
+}
+
+// Constant values for the types of TransactionPartner.
+const (
+	TransactionPartnerUser             = "user"
+	TransactionPartnerChat             = "chat"
+	TransactionPartnerAffiliateProgram = "affiliate_program"
+	TransactionPartnerFragment         = "fragment"
+	TransactionPartnerTelegramAds      = "telegram_ads"
+	TransactionPartnerTelegramAPI      = "telegram_api"
+	TransactionPartnerOther            = "other"
+)
+
+// TransactionPartner describes the source of a transaction,
+// or its recipient for outgoing transactions.
+//
+// The types are:
+//
+//	“user”, a transaction with a user,
+//	“chat”, a transaction with a chat,
+//	“affiliate_program”, a commission of an affiliate program,
+//	“fragment”, a withdrawal transaction with Fragment,
+//	“telegram_ads”, a withdrawal transaction to the Telegram Ads platform,
+//	“telegram_api”, a payment for paid broadcasting,
+//	“other”, a transaction with an unknown source or recipient.
+type TransactionPartner struct {
+	// Type of the transaction partner
+	Type string `json:"type"`
+	// TransactionType is the type of the transaction with a user,
+	// such as “invoice_payment”, “paid_media_payment” or “gift_purchase”
+	//
+	// optional
+	TransactionType string `json:"transaction_type,omitempty"`
+	// User is the user, for the “user” type
+	//
+	// optional
+	User *User `json:"user,omitempty"`
+	// Chat is the chat, for the “chat” type
+	//
+	// optional
+	Chat *Chat `json:"chat,omitempty"`
+	// SponsorUser is the bot or user that sponsored the affiliate program,
+	// for the “affiliate_program” type
+	//
+	// optional
+	SponsorUser *User `json:"sponsor_user,omitempty"`
+	// CommissionPerMille is the number of stars received by the bot for each
+	// 1000 stars received by the affiliate program sponsor from referred users
+	//
+	// optional
+	CommissionPerMille int `json:"commission_per_mille,omitempty"`
+	// InvoicePayload is the bot-specified invoice payload,
+	// for invoice payments
+	//
+	// optional
+	InvoicePayload string `json:"invoice_payload,omitempty"`
+	// SubscriptionPeriod is the duration of the paid subscription,
+	// in seconds
+	//
+	// optional
+	SubscriptionPeriod int `json:"subscription_period,omitempty"`
+	// PaidMediaPayload is the bot-specified paid media payload,
+	// for paid media payments
+	//
+	// optional
+	PaidMediaPayload string `json:"paid_media_payload,omitempty"`
+	// Gift sent to the user or the chat by the bot
+	//
+	// optional
+	Gift *Gift `json:"gift,omitempty"`
+	// PremiumSubscriptionDuration is the number of months the gifted
+	// Telegram Premium subscription will be active for
+	//
+	// optional
+	PremiumSubscriptionDuration int `json:"premium_subscription_duration,omitempty"`
+	// WithdrawalState is the state of the transaction,
+	// for the “fragment” type
+	//
+	// optional
+	WithdrawalState *RevenueWithdrawalState `json:"withdrawal_state,omitempty"`
+	// RequestCount is the number of successful requests that exceeded
+	// regular limits and were therefore billed, for the “telegram_api” type
+	//
+	// optional
+	RequestCount int `json:"request_count,omitempty"`
+}
+
+// RevenueWithdrawalState describes the state of a revenue withdrawal
+// operation, “pending”, “succeeded” or “failed”.
+type RevenueWithdrawalState struct {
+	// Type of the state
+	Type string `json:"type"`
+	// Date the withdrawal was completed in Unix time,
+	// for the “succeeded” state
+	//
+	// optional
+	Date int `json:"date,omitempty"`
+	// URL that can be used to see transaction details,
+	// for the “succeeded” state
+	//
+	// optional
+	URL string `json:"url,omitempty"`
+}
+
+// StarTransaction describes a Telegram Star transaction.
+type StarTransaction struct {
+	// ID is the unique identifier of the transaction. It coincides with the
+	// TelegramPaymentChargeID of SuccessfulPayment for successful incoming
+	// payments from users
+	ID string `json:"id"`
+	// Amount is the integer amount of stars transferred by the transaction
+	Amount int `json:"amount"`
+	// NanostarAmount is the number of 1/1000000000 shares of stars
+	// transferred by the transaction, from 0 to 999999999
+	//
+	// optional
+	NanostarAmount int `json:"nanostar_amount,omitempty"`
+	// Date the transaction was created in Unix time
+	Date int `json:"date"`
+	// Source of an incoming transaction
+	//
+	// optional
+	Source *TransactionPartner `json:"source,omitempty"`
+	// Receiver of an outgoing transaction
+	//
+	// optional
+	Receiver *TransactionPartner `json:"receiver,omitempty"`
+}
+
+// StarTransactions contains a list of Telegram Star transactions.
+type StarTransactions struct {
+	// Transactions is the list of transactions
+	Transactions []StarTransaction `json:"transactions"`
+}
+
+// PaidMediaInfo describes the paid media added to a message.
+type PaidMediaInfo struct {
+	// StarCount is the number of stars that must be paid
+	// to buy access to the media
+	StarCount int `json:"star_count"`
+	// PaidMedia is information about the paid media
+	PaidMedia []PaidMedia `json:"paid_media"`
+}
+
+// PaidMedia describes paid media.
+//
+// The types are:
+//
+//	“preview”, the media isn't available before the payment,
+//	“photo”, a photo, set in Photo,
+//	“video”, a video, set in Video.
+type PaidMedia struct {
+	// Type of the paid media
+	Type string `json:"type"`
+	// Width of the media, for the “preview” type, if known
+	//
+	// optional
+	Width int `json:"width,omitempty"`
+	// Height of the media, for the “preview” type, if known
+	//
+	// optional
+	Height int `json:"height,omitempty"`
+	// Duration of the media in seconds, for the “preview” type, if known
+	//
+	// optional
+	Duration int `json:"duration,omitempty"`
+	// Photo is the available sizes of the photo, for the “photo” type
+	//
+	// optional
+	Photo []PhotoSize `json:"photo,omitempty"`
+	// Video is the video, for the “video” type
+	//
+	// optional
+	Video *Video `json:"video,omitempty"`
+}
+
+// InputChecklistTask describes a task to add to a checklist.
+type InputChecklistTask struct {
+	// ID is the unique identifier of the task, a positive number
+	// unique among the tasks of the checklist
+	ID int `json:"id"`
+	// Text of the task, 1-100 characters after entities parsing
+	Text string `json:"text"`
+	// ParseMode mode for parsing entities in the text
+	//
+	// optional
+	ParseMode string `json:"parse_mode,omitempty"`
+	// TextEntities are special entities that appear in the text, which can
+	// be specified instead of ParseMode. Only the bold, italic, underline,
+	// strikethrough, spoiler and custom_emoji entities are allowed
+	//
+	// optional
+	TextEntities []MessageEntity `json:"text_entities,omitempty"`
+}
+
+// InputChecklist describes a checklist to create.
+type InputChecklist struct {
+	// Title of the checklist, 1-255 characters after entities parsing
+	Title string `json:"title"`
+	// ParseMode mode for parsing entities in the title
+	//
+	// optional
+	ParseMode string `json:"parse_mode,omitempty"`
+	// TitleEntities are special entities that appear in the title,
+	// which can be specified instead of ParseMode
+	//
+	// optional
+	TitleEntities []MessageEntity `json:"title_entities,omitempty"`
+	// Tasks are the 1-30 tasks of the checklist
+	Tasks []InputChecklistTask `json:"tasks"`
+	// OthersCanAddTasks allows other users to add tasks to the checklist
+	//
+	// optional
+	OthersCanAddTasks bool `json:"others_can_add_tasks,omitempty"`
+	// OthersCanMarkTasksAsDone allows other users to mark tasks as done
+	// or not done
+	//
+	// optional
+	OthersCanMarkTasksAsDone bool `json:"others_can_mark_tasks_as_done,omitempty"`
+}
+
+// ChecklistTask describes a task in a checklist.
+type ChecklistTask struct {
+	// ID is the unique identifier of the task
+	ID int `json:"id"`
+	// Text of the task
+	Text string `json:"text"`
+	// TextEntities are special entities that appear in the text
+	//
+	// optional
+	TextEntities []MessageEntity `json:"text_entities,omitempty"`
+	// CompletedByUser is the user that completed the task,
+	// if the task is completed
+	//
+	// optional
+	CompletedByUser *User `json:"completed_by_user,omitempty"`
+	// CompletionDate is the point in time (Unix timestamp) when the task
+	// was completed, or 0 if it isn't completed
+	//
+	// optional
+	CompletionDate int `json:"completion_date,omitempty"`
+}
+
+// Checklist describes a checklist.
+type Checklist struct {
+	// Title of the checklist
+	Title string `json:"title"`
+	// TitleEntities are special entities that appear in the title
+	//
+	// optional
+	TitleEntities []MessageEntity `json:"title_entities,omitempty"`
+	// Tasks is the list of tasks of the checklist
+	Tasks []ChecklistTask `json:"tasks"`
+	// OthersCanAddTasks is true, if users other than the creator
+	// can add tasks to the checklist
+	//
+	// optional
+	OthersCanAddTasks bool `json:"others_can_add_tasks,omitempty"`
+	// OthersCanMarkTasksAsDone is true, if users other than the creator
+	// can mark tasks as done or not done
+	//
+	// optional
+	OthersCanMarkTasksAsDone bool `json:"others_can_mark_tasks_as_done,omitempty"`
+}
+
+// ChecklistTasksDone describes a service message about checklist tasks
+// marked as done or not done.
+type ChecklistTasksDone struct {
+	// ChecklistMessage is the message containing the checklist. It doesn't
+	// contain ReplyToMessage, even if it is itself a reply
+	//
+	// optional
+	ChecklistMessage *Message `json:"checklist_message,omitempty"`
+	// MarkedAsDoneTaskIDs are the identifiers of the tasks
+	// that were marked as done
+	//
+	// optional
+	MarkedAsDoneTaskIDs []int `json:"marked_as_done_task_ids,omitempty"`
+	// MarkedAsNotDoneTaskIDs are the identifiers of the tasks
+	// that were marked as not done
+	//
+	// optional
+	MarkedAsNotDoneTaskIDs []int `json:"marked_as_not_done_task_ids,omitempty"`
+}
+
+// ChecklistTasksAdded describes a service message about tasks
+// added to a checklist.
+type ChecklistTasksAdded struct {
+	// ChecklistMessage is the message containing the checklist. It doesn't
+	// contain ReplyToMessage, even if it is itself a reply
+	//
+	// optional
+	ChecklistMessage *Message `json:"checklist_message,omitempty"`
+	// Tasks is the list of tasks added to the checklist
+	Tasks []ChecklistTask `json:"tasks"`
+}
+
+// PreparedInlineMessage describes an inline message to be sent by a user
+// of a Mini App.
+type PreparedInlineMessage struct {
+	// ID is the unique identifier of the prepared message
+	ID string `json:"id"`
+	// ExpirationDate is the point in time (Unix timestamp) when the prepared
+	// message expires and can no longer be used
+	ExpirationDate int `json:"expiration_date"`
+}
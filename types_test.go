@@ -1,6 +1,7 @@
 package tgbotapi_test
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -271,6 +272,14 @@ func TestMessageEntityIsTextLink(t *testing.T) {
 	}
 }
 
+func TestMessageEntityIsCustomEmoji(t *testing.T) {
+	entity := tgbotapi.MessageEntity{Type: "custom_emoji", CustomEmojiID: "5368324170671202286"}
+
+	if !entity.IsCustomEmoji() {
+		t.Fail()
+	}
+}
+
 func TestFileLink(t *testing.T) {
 	file := tgbotapi.File{FilePath: "test/test.txt"}
 
@@ -278,3 +287,242 @@ func TestFileLink(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestUpdateFromChat(t *testing.T) {
+	chat := &tgbotapi.Chat{ID: 10}
+
+	update := tgbotapi.Update{CallbackQuery: &tgbotapi.CallbackQuery{
+		Message: &tgbotapi.Message{Chat: chat},
+	}}
+	if update.FromChat() != chat {
+		t.Fail()
+	}
+
+	update = tgbotapi.Update{ChatJoinRequest: &tgbotapi.ChatJoinRequest{Chat: *chat}}
+	if update.FromChat().ID != chat.ID {
+		t.Fail()
+	}
+
+	update = tgbotapi.Update{MyChatMember: &tgbotapi.ChatMemberUpdated{Chat: *chat}}
+	if update.FromChat().ID != chat.ID {
+		t.Fail()
+	}
+
+	update = tgbotapi.Update{MessageReaction: &tgbotapi.MessageReactionUpdated{Chat: *chat}}
+	if update.FromChat().ID != chat.ID {
+		t.Fail()
+	}
+
+	update = tgbotapi.Update{RemovedChatBoost: &tgbotapi.ChatBoostRemoved{Chat: *chat}}
+	if update.FromChat().ID != chat.ID {
+		t.Fail()
+	}
+
+	update = tgbotapi.Update{BusinessMessage: &tgbotapi.Message{Chat: chat}}
+	if update.FromChat() != chat {
+		t.Fail()
+	}
+
+	update = tgbotapi.Update{InlineQuery: &tgbotapi.InlineQuery{}}
+	if update.FromChat() != nil {
+		t.Fail()
+	}
+}
+
+func TestFileLinkLocal(t *testing.T) {
+	file := tgbotapi.File{FilePath: "/var/lib/telegram-bot-api/token/photos/file_1.jpg"}
+
+	if file.Link("token") != "file:///var/lib/telegram-bot-api/token/photos/file_1.jpg" {
+		t.Fail()
+	}
+}
+
+func TestForumTopicServiceMessages(t *testing.T) {
+	var message tgbotapi.Message
+	err := json.Unmarshal([]byte(`{"message_id":1,"forum_topic_edited":{"icon_custom_emoji_id":""},"forum_topic_closed":{}}`), &message)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	edited := message.ForumTopicEdited
+	if edited == nil || edited.IconCustomEmojiID == nil || *edited.IconCustomEmojiID != "" {
+		t.Error("removed icon not decoded")
+	}
+	if message.ForumTopicClosed == nil || message.ForumTopicReopened != nil {
+		t.Error("empty service messages not decoded")
+	}
+}
+
+func TestChecklistServiceMessages(t *testing.T) {
+	var message tgbotapi.Message
+	err := json.Unmarshal([]byte(`{"message_id":2,"checklist_tasks_done":{"checklist_message":{"message_id":1},"marked_as_done_task_ids":[1,2]}}`), &message)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	done := message.ChecklistTasksDone
+	if done == nil || done.ChecklistMessage.MessageID != 1 || len(done.MarkedAsDoneTaskIDs) != 2 {
+		t.Error("checklist_tasks_done not decoded")
+	}
+	if message.ChecklistTasksAdded != nil {
+		t.Error("checklist_tasks_added decoded from nothing")
+	}
+}
+
+func TestWebAppDataMessage(t *testing.T) {
+	var message tgbotapi.Message
+	err := json.Unmarshal([]byte(`{"message_id":1,"web_app_data":{"data":"{\"item\":42}","button_text":"Shop"}}`), &message)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if message.WebAppData == nil || message.WebAppData.Data != `{"item":42}` || message.WebAppData.ButtonText != "Shop" {
+		t.Error("web_app_data not decoded")
+	}
+}
+
+func TestChatMemberUpdate(t *testing.T) {
+	var update tgbotapi.Update
+	err := json.Unmarshal([]byte(`{"update_id":1,"chat_member":{
+		"chat":{"id":-100,"type":"supergroup"},
+		"from":{"id":7,"first_name":"Ada"},
+		"date":1700000000,
+		"old_chat_member":{"user":{"id":8,"first_name":"Bob"},"status":"left"},
+		"new_chat_member":{"user":{"id":8,"first_name":"Bob"},"status":"member"},
+		"invite_link":{"invite_link":"https://t.me/+abc","creator":{"id":7,"first_name":"Ada"}}
+	}}`), &update)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	member := update.ChatMember
+	if member == nil || member.OldChatMember.Status != "left" || member.NewChatMember.Status != "member" {
+		t.Fatal("chat_member not decoded")
+	}
+	if member.InviteLink == nil || member.InviteLink.InviteLink != "https://t.me/+abc" {
+		t.Error("invite_link not decoded")
+	}
+	if update.FromChat().ID != -100 {
+		t.Error("wrong chat of chat_member")
+	}
+}
+
+func TestChatMemberUpdatedPredicates(t *testing.T) {
+	member := func(status string) tgbotapi.ChatMember {
+		return tgbotapi.ChatMember{Status: status}
+	}
+
+	joined := tgbotapi.ChatMemberUpdated{
+		OldChatMember: member("left"),
+		NewChatMember: member("member"),
+		InviteLink:    &tgbotapi.ChatInviteLink{InviteLink: "https://t.me/+abc"},
+	}
+	if !joined.Joined() || !joined.JoinedViaLink() || joined.Left() || joined.BecameAdmin() {
+		t.Error("wrong predicates for a join via link")
+	}
+
+	restricted := tgbotapi.ChatMember{Status: "restricted", IsChatMember: true}
+	kicked := tgbotapi.ChatMemberUpdated{OldChatMember: restricted, NewChatMember: member("kicked")}
+	if !kicked.WasKicked() || !kicked.Left() || kicked.Joined() || kicked.JoinedViaLink() {
+		t.Error("wrong predicates for a ban")
+	}
+
+	promoted := tgbotapi.ChatMemberUpdated{OldChatMember: member("member"), NewChatMember: member("administrator")}
+	if !promoted.BecameAdmin() || promoted.LostAdmin() || promoted.Joined() || promoted.Left() {
+		t.Error("wrong predicates for a promotion")
+	}
+
+	demoted := tgbotapi.ChatMemberUpdated{OldChatMember: member("administrator"), NewChatMember: member("member")}
+	if !demoted.LostAdmin() || demoted.BecameAdmin() {
+		t.Error("wrong predicates for a demotion")
+	}
+}
+
+func TestChatMemberViews(t *testing.T) {
+	var admin tgbotapi.ChatMember
+	err := json.Unmarshal([]byte(`{"user":{"id":7,"first_name":"Ada"},"status":"administrator",
+		"can_be_edited":true,"custom_title":"Boss","can_manage_chat":true,"can_delete_messages":true}`), &admin)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	view, ok := admin.Administrator()
+	if !ok || !view.CanBeEdited || view.CustomTitle != "Boss" || !view.CanManageChat || !view.CanDeleteMessages || view.CanPromoteMembers {
+		t.Errorf("wrong administrator view %+v", view)
+	}
+	if _, ok := admin.Restricted(); ok {
+		t.Error("administrator viewed as restricted")
+	}
+
+	var restricted tgbotapi.ChatMember
+	err = json.Unmarshal([]byte(`{"user":{"id":8,"first_name":"Bob"},"status":"restricted",
+		"is_member":true,"until_date":1700000000,"can_send_messages":true}`), &restricted)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	limits, ok := restricted.Restricted()
+	if !ok || !limits.IsMember || limits.UntilDate != 1700000000 || !limits.CanSendMessages || limits.CanSendPhotos {
+		t.Errorf("wrong restricted view %+v", limits)
+	}
+
+	banned, ok := tgbotapi.ChatMember{Status: "kicked", UntilDate: 1700000000}.Banned()
+	if !ok || banned.UntilDate != 1700000000 {
+		t.Errorf("wrong banned view %+v", banned)
+	}
+
+	if _, ok := (tgbotapi.ChatMember{Status: "creator"}).Owner(); !ok {
+		t.Error("creator not viewed as owner")
+	}
+	if _, ok := (tgbotapi.ChatMember{Status: "left"}).Left(); !ok {
+		t.Error("left member not viewed as left")
+	}
+	if _, ok := (tgbotapi.ChatMember{Status: "member"}).Member(); !ok {
+		t.Error("member not viewed as member")
+	}
+}
+
+func TestMessageOriginAndReplies(t *testing.T) {
+	var message tgbotapi.Message
+	err := json.Unmarshal([]byte(`{
+		"message_id":3,"date":1700000000,"chat":{"id":1,"type":"private"},
+		"forward_origin":{"type":"channel","date":1690000000,"chat":{"id":-100,"type":"channel"},"message_id":42,"author_signature":"Ada"},
+		"external_reply":{"origin":{"type":"hidden_user","date":1680000000,"sender_user_name":"Bob"},"photo":[{"file_id":"photo"}]},
+		"quote":{"text":"quoted","position":5,"is_manual":true},
+		"link_preview_options":{"is_disabled":true},
+		"has_protected_content":true,
+		"effect_id":"5104841245755180586",
+		"boost_added":{"boost_count":2},
+		"reply_markup":{"inline_keyboard":[[{"text":"Open","url":"https://example.com"}]]}
+	}`), &message)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	origin := message.ForwardOrigin
+	if origin == nil || origin.Type != tgbotapi.MessageOriginChannel || origin.Chat.ID != -100 || origin.MessageID != 42 {
+		t.Errorf("wrong forward_origin %+v", origin)
+	}
+
+	reply := message.ExternalReply
+	if reply == nil || reply.Origin.SenderUserName != "Bob" || len(reply.Photo) != 1 {
+		t.Errorf("wrong external_reply %+v", reply)
+	}
+
+	if message.Quote == nil || message.Quote.Text != "quoted" || message.Quote.Position != 5 || !message.Quote.IsManual {
+		t.Errorf("wrong quote %+v", message.Quote)
+	}
+
+	if message.LinkPreviewOptions == nil || !message.LinkPreviewOptions.IsDisabled {
+		t.Error("link_preview_options not decoded")
+	}
+	if !message.HasProtectedContent || message.EffectID != "5104841245755180586" {
+		t.Error("flags not decoded")
+	}
+	if message.BoostAdded == nil || message.BoostAdded.BoostCount != 2 {
+		t.Error("boost_added not decoded")
+	}
+	if message.ReplyMarkup == nil || len(message.ReplyMarkup.InlineKeyboard) != 1 {
+		t.Error("reply_markup not decoded")
+	}
+}
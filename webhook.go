@@ -0,0 +1,172 @@
+package tgbotapi
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+)
+
+// SecretTokenHeader is the header holding the secret token
+// of the webhook in the requests sent by Telegram.
+const SecretTokenHeader = "X-Telegram-Bot-Api-Secret-Token"
+
+// TelegramNetworks are the networks Telegram sends webhook requests from.
+var TelegramNetworks = []string{
+	"149.154.160.0/20",
+	"91.108.4.0/22",
+}
+
+// errBadSecretToken is returned by HandleUpdate for requests
+// without the secret token of the webhook.
+var errBadSecretToken = errors.New(ErrBadSecretToken)
+
+// GenerateSecretToken returns a cryptographically random token
+// for WebhookConfig.SecretToken.
+func GenerateSecretToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	// The URL alphabet matches the characters allowed by the API.
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// checkSecretToken checks that the webhook request has the secret
+// token of the bot, if it has one.
+func (bot *BotAPI) checkSecretToken(r *http.Request) error {
+	if bot.WebhookSecretToken == "" {
+		return nil
+	}
+
+	token := r.Header.Get(SecretTokenHeader)
+	if subtle.ConstantTimeCompare([]byte(token), []byte(bot.WebhookSecretToken)) != 1 {
+		return errBadSecretToken
+	}
+
+	return nil
+}
+
+// writeWebhookError answers a webhook request that couldn't be handled.
+func writeWebhookError(w http.ResponseWriter, err error) {
+	status := http.StatusBadRequest
+	if errors.Is(err, errBadSecretToken) {
+		status = http.StatusUnauthorized
+	}
+
+	errMsg, _ := json.Marshal(map[string]string{"error": err.Error()})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(errMsg)
+}
+
+// WriteToHTTPResponse answers a webhook request with the method call
+// of c, saving a separate request to the API. Telegram doesn't report
+// whether the call succeeded.
+//
+// Files can't be uploaded this way, only sent by ID or URL.
+func (bot *BotAPI) WriteToHTTPResponse(w http.ResponseWriter, c Chattable) error {
+	switch config := c.(type) {
+	case MultiFileable:
+		files, err := config.files()
+		if err != nil {
+			return err
+		}
+		if needsUpload(files) {
+			return errors.New(ErrWebhookUpload)
+		}
+	case Fileable:
+		if !config.useExistingFile() {
+			return errors.New(ErrWebhookUpload)
+		}
+	}
+
+	params, err := c.params()
+	if err != nil {
+		return err
+	}
+	params["method"] = c.method()
+
+	data, err := bot.codec().Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, err = w.Write(data)
+	return err
+}
+
+// WebhookIPFilter rejects webhook requests which don't come from
+// the allowed networks, by default the networks of Telegram.
+type WebhookIPFilter struct {
+	// ClientIP returns the IP address of the client that sent the request.
+	// If the bot works behind a reverse proxy, it should return the
+	// address passed by the proxy, such as in X-Forwarded-For.
+	//
+	// optional, the host of RemoteAddr is used if nil
+	ClientIP func(r *http.Request) string
+
+	networks []*net.IPNet
+}
+
+// NewWebhookIPFilter creates a WebhookIPFilter allowing requests from the
+// networks in CIDR notation. TelegramNetworks are allowed if none are given.
+func NewWebhookIPFilter(networks ...string) (*WebhookIPFilter, error) {
+	if len(networks) == 0 {
+		networks = TelegramNetworks
+	}
+
+	filter := &WebhookIPFilter{}
+	for _, cidr := range networks {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		filter.networks = append(filter.networks, network)
+	}
+
+	return filter, nil
+}
+
+// Allowed returns true if the request comes from an allowed network.
+func (f *WebhookIPFilter) Allowed(r *http.Request) bool {
+	var addr string
+	if f.ClientIP != nil {
+		addr = f.ClientIP(r)
+	} else if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		addr = host
+	} else {
+		addr = r.RemoteAddr
+	}
+
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+
+	for _, network := range f.networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Middleware wraps a webhook handler, answering requests which don't
+// come from an allowed network with 403 Forbidden.
+func (f *WebhookIPFilter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !f.Allowed(r) {
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
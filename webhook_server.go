@@ -0,0 +1,232 @@
+package tgbotapi
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DefaultWebhookAddr is the address the webhook server listens on
+// if none is set. Telegram sends webhooks to ports 443, 80, 88 and 8443.
+const DefaultWebhookAddr = ":8443"
+
+// WebhookServerConfig contains information for starting a webhook server.
+type WebhookServerConfig struct {
+	// Webhook is passed to SetWebhook. Its URL is the public URL of the
+	// server, and its path is the path the updates are served on.
+	// The Certificate is set by the server when the certificate
+	// is self-signed.
+	Webhook WebhookConfig
+	// Addr is the address to listen on.
+	//
+	// optional, DefaultWebhookAddr is used if empty
+	Addr string
+	// CertFile and KeyFile are the paths to the TLS certificate and key.
+	// If they are empty, a self-signed certificate is generated for the
+	// host of the webhook URL and uploaded to Telegram.
+	//
+	// optional
+	CertFile string
+	KeyFile  string
+}
+
+// WebhookServer is an HTTPS server receiving updates from a webhook.
+type WebhookServer struct {
+	server   *http.Server
+	listener net.Listener
+	updates  chan Update
+	done     chan struct{}
+
+	mu       sync.Mutex
+	closing  bool
+	handlers sync.WaitGroup
+}
+
+// StartWebhookServer starts an HTTPS server receiving updates into the
+// returned channel and sets the webhook to it. The channel is closed
+// after the server is shut down.
+func (bot *BotAPI) StartWebhookServer(config WebhookServerConfig) (*WebhookServer, UpdatesChannel, error) {
+	if config.Webhook.URL == nil {
+		return nil, nil, errors.New(ErrBadURL)
+	}
+
+	tlsConfig, selfSigned, err := webhookTLSConfig(config)
+	if err != nil {
+		return nil, nil, err
+	}
+	if selfSigned != nil {
+		config.Webhook.Certificate = FileBytes{Name: "cert.pem", Bytes: selfSigned}
+	}
+
+	addr := config.Addr
+	if addr == "" {
+		addr = DefaultWebhookAddr
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	path := config.Webhook.URL.Path
+	if path == "" {
+		path = "/"
+	}
+
+	updates := make(chan Update, bot.Buffer)
+	s := &WebhookServer{
+		listener: listener,
+		updates:  updates,
+		done:     make(chan struct{}),
+	}
+
+	handler := bot.webhookHandler(updates)
+	mux := http.NewServeMux()
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if !s.startHandler() {
+			http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+			return
+		}
+		defer s.handlers.Done()
+		handler.ServeHTTP(w, r)
+	})
+
+	s.server = &http.Server{
+		Handler:           mux,
+		TLSConfig:         tlsConfig,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go s.serve(bot)
+
+	if _, err := bot.SetWebhook(config.Webhook); err != nil {
+		_ = s.Shutdown(context.Background())
+		return nil, nil, err
+	}
+
+	return s, updates, nil
+}
+
+// Addr returns the address the server listens on.
+func (s *WebhookServer) Addr() net.Addr {
+	return s.listener.Addr()
+}
+
+// Shutdown gracefully stops the server, waiting until the requests being
+// handled are finished or ctx is done. If ctx is done first, the remaining
+// requests are aborted and their updates are sent again by Telegram.
+// The webhook is left set, so the updates are kept by Telegram until
+// the server is started again.
+func (s *WebhookServer) Shutdown(ctx context.Context) error {
+	err := s.server.Shutdown(ctx)
+	if err != nil {
+		// Closing the connections cancels the requests, so the handlers
+		// blocked on a full channel return.
+		_ = s.server.Close()
+	}
+
+	<-s.done
+	return err
+}
+
+func (s *WebhookServer) serve(bot *BotAPI) {
+	defer close(s.done)
+
+	err := s.server.ServeTLS(s.listener, "", "")
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		bot.logger().Error("Webhook server failed", "error", err)
+	}
+
+	// Serving stops as soon as the server starts shutting down, while
+	// the handlers may still be sending updates.
+	s.mu.Lock()
+	s.closing = true
+	s.mu.Unlock()
+	s.handlers.Wait()
+	close(s.updates)
+}
+
+// startHandler counts a handler as running, unless the updates channel
+// is about to be closed.
+func (s *WebhookServer) startHandler() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closing {
+		return false
+	}
+	s.handlers.Add(1)
+	return true
+}
+
+// webhookTLSConfig loads the certificate of the webhook server, or generates
+// a self-signed one, returned in PEM format to be uploaded to Telegram.
+func webhookTLSConfig(config WebhookServerConfig) (*tls.Config, []byte, error) {
+	if config.CertFile != "" || config.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(config.CertFile, config.KeyFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &tls.Config{Certificates: []tls.Certificate{cert}}, nil, nil
+	}
+
+	certPEM, keyPEM, err := GenerateSelfSignedCert(config.Webhook.URL.Hostname())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &tls.Config{Certificates: []tls.Certificate{cert}}, certPEM, nil
+}
+
+// GenerateSelfSignedCert generates a self-signed certificate valid for
+// a year for the host name or IP address, and its key, in PEM format.
+func GenerateSelfSignedCert(host string) (certPEM, keyPEM []byte, err error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	template := x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: host},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.AddDate(1, 0, 0),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		template.IPAddresses = []net.IP{ip}
+	} else {
+		template.DNSNames = []string{host}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM = pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	return certPEM, keyPEM, nil
+}
@@ -0,0 +1,99 @@
+package tgbotapi_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/stretchr/testify/require"
+)
+
+// startWebhookServer starts a webhook server with a self-signed certificate,
+// returning it with the URL of the webhook and a client trusting the
+// certificate, like Telegram does.
+func startWebhookServer(t *testing.T, buffer int) (*tgbotapi.WebhookServer, tgbotapi.UpdatesChannel, *http.Client, string) {
+	var certPEM []byte
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		require.Equal(t, "https://127.0.0.1/hook", r.FormValue("url"))
+
+		file, _, err := r.FormFile("certificate")
+		require.NoError(t, err)
+		certPEM, err = ioutil.ReadAll(file)
+		require.NoError(t, err)
+
+		_, _ = w.Write([]byte(`{"ok":true,"result":true}`))
+	})
+	bot.Buffer = buffer
+
+	server, updates, err := bot.StartWebhookServer(tgbotapi.WebhookServerConfig{
+		Webhook: tgbotapi.NewWebhook("https://127.0.0.1/hook"),
+		Addr:    "127.0.0.1:0",
+	})
+	require.NoError(t, err)
+
+	roots := x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM(certPEM))
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: roots}}}
+
+	hook := url.URL{Scheme: "https", Host: server.Addr().String(), Path: "/hook"}
+	return server, updates, client, hook.String()
+}
+
+func TestWebhookServer(t *testing.T) {
+	server, updates, client, hook := startWebhookServer(t, 100)
+
+	resp, err := client.Post(hook, "application/json", strings.NewReader(`{"update_id":3}`))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	require.Equal(t, 3, (<-updates).UpdateID)
+
+	require.NoError(t, server.Shutdown(context.Background()))
+	_, ok := <-updates
+	require.False(t, ok)
+}
+
+func TestWebhookServerShutdownUnread(t *testing.T) {
+	server, updates, client, hook := startWebhookServer(t, 0)
+
+	posted := make(chan error, 1)
+	go func() {
+		resp, err := client.Post(hook, "application/json", strings.NewReader(`{"update_id":3}`))
+		if err == nil {
+			resp.Body.Close()
+		}
+		posted <- err
+	}()
+
+	// Let the handler block on sending the update nobody reads.
+	time.Sleep(100 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, server.Shutdown(ctx), context.DeadlineExceeded)
+
+	_, ok := <-updates
+	require.False(t, ok)
+	require.Error(t, <-posted)
+}
+
+func TestGenerateSelfSignedCert(t *testing.T) {
+	certPEM, keyPEM, err := tgbotapi.GenerateSelfSignedCert("example.com")
+	require.NoError(t, err)
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	require.NoError(t, err)
+	require.NoError(t, leaf.VerifyHostname("example.com"))
+}
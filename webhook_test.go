@@ -0,0 +1,139 @@
+package tgbotapi_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	tgbotapi "github.com/Feresey/telegram-bot-api/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateSecretToken(t *testing.T) {
+	token, err := tgbotapi.GenerateSecretToken()
+	require.NoError(t, err)
+	require.Regexp(t, regexp.MustCompile(`^[A-Za-z0-9_-]{1,256}$`), token)
+
+	other, err := tgbotapi.GenerateSecretToken()
+	require.NoError(t, err)
+	require.NotEqual(t, token, other)
+}
+
+func TestHandleUpdateSecretToken(t *testing.T) {
+	var secret string
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		secret = r.FormValue("secret_token")
+		_, _ = w.Write([]byte(`{"ok":true,"result":true}`))
+	})
+
+	webhook := tgbotapi.NewWebhook("https://example.com/hook")
+	webhook.SecretToken = "s3cret"
+	_, err := bot.SetWebhook(webhook)
+	require.NoError(t, err)
+	require.Equal(t, "s3cret", secret)
+	require.Equal(t, "s3cret", bot.WebhookSecretToken)
+
+	newRequest := func(token string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/hook", strings.NewReader(`{"update_id":1}`))
+		if token != "" {
+			r.Header.Set(tgbotapi.SecretTokenHeader, token)
+		}
+		return r
+	}
+
+	update, err := bot.HandleUpdate(newRequest("s3cret"))
+	require.NoError(t, err)
+	require.Equal(t, 1, update.UpdateID)
+
+	_, err = bot.HandleUpdate(newRequest("wrong"))
+	require.EqualError(t, err, tgbotapi.ErrBadSecretToken)
+
+	_, err = bot.HandleUpdate(newRequest(""))
+	require.EqualError(t, err, tgbotapi.ErrBadSecretToken)
+}
+
+func TestWebhookIPFilter(t *testing.T) {
+	filter, err := tgbotapi.NewWebhookIPFilter()
+	require.NoError(t, err)
+
+	handler := filter.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(remoteAddr string) int {
+		r := httptest.NewRequest(http.MethodPost, "/hook", nil)
+		r.RemoteAddr = remoteAddr
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	require.Equal(t, http.StatusOK, serve("149.154.167.220:443"))
+	require.Equal(t, http.StatusOK, serve("91.108.6.1:443"))
+	require.Equal(t, http.StatusForbidden, serve("203.0.113.5:443"))
+	require.Equal(t, http.StatusForbidden, serve("garbage"))
+
+	filter.ClientIP = func(r *http.Request) string {
+		return r.Header.Get("X-Real-IP")
+	}
+	r := httptest.NewRequest(http.MethodPost, "/hook", nil)
+	r.Header.Set("X-Real-IP", "149.154.160.1")
+	require.True(t, filter.Allowed(r))
+
+	_, err = tgbotapi.NewWebhookIPFilter("not a network")
+	require.Error(t, err)
+}
+
+func TestSetWebhookIPAddress(t *testing.T) {
+	var ip string
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		ip = r.FormValue("ip_address")
+		_, _ = w.Write([]byte(`{"ok":true,"result":true}`))
+	})
+
+	webhook := tgbotapi.NewWebhook("https://example.com/hook")
+	webhook.IPAddress = "203.0.113.10"
+	_, err := bot.SetWebhook(webhook)
+	require.NoError(t, err)
+	require.Equal(t, "203.0.113.10", ip)
+}
+
+func TestWebhookHandler(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {})
+
+	handler, updates := bot.WebhookHandler()
+
+	mux := http.NewServeMux()
+	mux.Handle("/bot/hook", handler)
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/bot/hook", strings.NewReader(`{"update_id":5}`)))
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, 5, (<-updates).UpdateID)
+
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/bot/hook", nil))
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	bot.WebhookSecretToken = "s3cret"
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/bot/hook", strings.NewReader(`{"update_id":6}`)))
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestWriteToHTTPResponse(t *testing.T) {
+	bot := newTestBot(t, nil)
+
+	w := httptest.NewRecorder()
+	msg := tgbotapi.NewMessage(ChatID, "pong")
+	require.NoError(t, bot.WriteToHTTPResponse(w, msg))
+	require.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	require.JSONEq(t, `{"method":"sendMessage","chat_id":"76918703","text":"pong"}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	photo := tgbotapi.NewPhotoUpload(ChatID, tgbotapi.FileBytes{Name: "image.jpg", Bytes: []byte("jpeg")})
+	require.EqualError(t, bot.WriteToHTTPResponse(w, photo), tgbotapi.ErrWebhookUpload)
+	require.Zero(t, w.Body.Len())
+}